@@ -39,6 +39,9 @@ func init() {
 	accountsCmd.AddCommand(signMS)
 	accountsCmd.AddCommand(signNexMS)
 	accountsCmd.AddCommand(buildMultisig)
+	accountsCmd.AddCommand(buildMultiMsgTx)
+	accountsCmd.AddCommand(grantFeeAllowanceCmd)
+	accountsCmd.AddCommand(revokeFeeAllowanceCmd)
 	accountsCmd.AddCommand(unsafeDeleteCmd)
 }
 
@@ -54,8 +57,11 @@ var pwd, oldPwd, decryptPwd, encryptPwd string
 
 func init() {
 	buildMultisig.Flags().StringVar(&pwd, "pwd", "", "passphrase used by the cmd, non empty usage bypass interactive prompt")
+	buildMultiMsgTx.Flags().StringVar(&pwd, "pwd", "", "passphrase used by the cmd, non empty usage bypass interactive prompt")
 	createCmd.Flags().StringVar(&pwd, "pwd", "", "passphrase used by the cmd, non empty usage bypass interactive prompt")
 	deleteCmd.Flags().StringVar(&pwd, "pwd", "", "passphrase used by the cmd, non empty usage bypass interactive prompt")
+	grantFeeAllowanceCmd.Flags().StringVar(&pwd, "pwd", "", "passphrase used by the cmd, non empty usage bypass interactive prompt")
+	revokeFeeAllowanceCmd.Flags().StringVar(&pwd, "pwd", "", "passphrase used by the cmd, non empty usage bypass interactive prompt")
 	sendTxCmd.Flags().StringVar(&pwd, "pwd", "", "passphrase used by the cmd, non empty usage bypass interactive prompt")
 	setValidator.Flags().StringVar(&pwd, "pwd", "", "passphrase used by the cmd, non empty usage bypass interactive prompt")
 	signCmd.Flags().StringVar(&pwd, "pwd", "", "passphrase used by the cmd, non empty usage bypass interactive prompt")
@@ -601,6 +607,108 @@ var buildMultisig = &cobra.Command{
 	},
 }
 
+var buildMultiMsgTx = &cobra.Command{
+	Use:   "build-multi-msg-tx <signer-address> <json-messages> <networkID> <fees> <memo>",
+	Short: "Build and sign a multi message tx",
+	Args:  cobra.ExactArgs(5),
+	Long: `Build and sign a transaction of several messages that are executed atomically: if any message fails none is applied.
+<json-messages> is a json array of messages, all of them must be signable by <signer-address>.
+The fee must cover the sum of the fees of the messages. Result is hex encoded std tx object, that can be sent with send-raw-tx.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		app.InitConfig(datadir, tmNode, persistentPeers, seeds, remoteCLIURL)
+		fees, err := strconv.Atoi(args[3])
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		fmt.Println("Enter passphrase: ")
+		bz, err := app.BuildMultiMsgTx(args[0], args[1], app.Credentials(pwd), args[2], int64(fees), args[4], false)
+		if err != nil {
+			fmt.Println(fmt.Errorf("error building the multi message tx: %v", err))
+			return
+		}
+		fmt.Println("Multi message transaction: \n" + hex.EncodeToString(bz))
+	},
+}
+
+var grantFeeAllowanceCmd = &cobra.Command{
+	Use:   "grant-fee-allowance <granter> <grantee> <spendLimit> <expirationHeight> <networkID> <fee>",
+	Short: "Allow an account to pay its fees from the granter account",
+	Long: `Grants <grantee> an allowance to pay the fees of its transactions from the <granter> account, replacing any previous allowance.
+The grantee sets the granter as the fee payer of its transactions. <spendLimit> is the uPOKT the grantee can spend on fees (0 is unlimited)
+and the allowance can be used until <expirationHeight> (0 never expires).
+Prompts the user for <granter> account passphrase.`,
+	Args: cobra.ExactArgs(6),
+	Run: func(cmd *cobra.Command, args []string) {
+		app.InitConfig(datadir, tmNode, persistentPeers, seeds, remoteCLIURL)
+		spendLimit, ok := types.NewIntFromString(args[2])
+		if !ok {
+			fmt.Println("invalid spend limit: " + args[2])
+			return
+		}
+		expirationHeight, err := strconv.ParseInt(args[3], 10, 64)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		fees, err := strconv.Atoi(args[5])
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		fmt.Println("Enter passphrase: ")
+		res, err := GrantFeeAllowance(args[0], args[1], app.Credentials(pwd), args[4], spendLimit, expirationHeight, int64(fees))
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		j, err := json.Marshal(res)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		resp, err := QueryRPC(SendRawTxPath, j)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		fmt.Println(resp)
+	},
+}
+
+var revokeFeeAllowanceCmd = &cobra.Command{
+	Use:   "revoke-fee-allowance <granter> <grantee> <networkID> <fee>",
+	Short: "Revoke a fee allowance",
+	Long: `Revokes the fee allowance of <granter> to <grantee>.
+Prompts the user for <granter> account passphrase.`,
+	Args: cobra.ExactArgs(4),
+	Run: func(cmd *cobra.Command, args []string) {
+		app.InitConfig(datadir, tmNode, persistentPeers, seeds, remoteCLIURL)
+		fees, err := strconv.Atoi(args[3])
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		fmt.Println("Enter passphrase: ")
+		res, err := RevokeFeeAllowance(args[0], args[1], app.Credentials(pwd), args[2], int64(fees))
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		j, err := json.Marshal(res)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		resp, err := QueryRPC(SendRawTxPath, j)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		fmt.Println(resp)
+	},
+}
+
 var signMS = &cobra.Command{
 	Use:   "sign-ms-tx <signer-address> <hex-amino-stdtx> <hex-pubkeys> <networkID> ",
 	Short: "sign a multisig tx",
@@ -20,6 +20,12 @@ func init() {
 	govCmd.AddCommand(govChangeParam)
 	govCmd.AddCommand(govUpgrade)
 	govCmd.AddCommand(govFeatureEnable)
+	govCmd.AddCommand(govProposeChangeParam)
+	govCmd.AddCommand(govProposeDAOTransfer)
+	govCmd.AddCommand(govProposeUpgrade)
+	govCmd.AddCommand(govVote)
+	govCmd.AddCommand(govScheduleParam)
+	govCmd.AddCommand(govCancelParam)
 }
 
 var govCmd = &cobra.Command{
@@ -34,6 +40,12 @@ func init() {
 	govDAOBurn.Flags().StringVar(&pwd, "pwd", "", "defines the passphrase used by the cmd non empty usage bypass interactive prompt ")
 	govChangeParam.Flags().StringVar(&pwd, "pwd", "", "defines the passphrase used by the cmd non empty usage bypass interactive prompt ")
 	govUpgrade.Flags().StringVar(&pwd, "pwd", "", "defines the passphrase used by the cmd non empty usage bypass interactive prompt ")
+	govProposeChangeParam.Flags().StringVar(&pwd, "pwd", "", "defines the passphrase used by the cmd non empty usage bypass interactive prompt ")
+	govProposeDAOTransfer.Flags().StringVar(&pwd, "pwd", "", "defines the passphrase used by the cmd non empty usage bypass interactive prompt ")
+	govProposeUpgrade.Flags().StringVar(&pwd, "pwd", "", "defines the passphrase used by the cmd non empty usage bypass interactive prompt ")
+	govVote.Flags().StringVar(&pwd, "pwd", "", "defines the passphrase used by the cmd non empty usage bypass interactive prompt ")
+	govScheduleParam.Flags().StringVar(&pwd, "pwd", "", "defines the passphrase used by the cmd non empty usage bypass interactive prompt ")
+	govCancelParam.Flags().StringVar(&pwd, "pwd", "", "defines the passphrase used by the cmd non empty usage bypass interactive prompt ")
 }
 
 var govDAOTransfer = &cobra.Command{
@@ -249,3 +261,271 @@ Will prompt the user for the <fromAddr> account passphrase.`,
 		fmt.Println(resp)
 	},
 }
+
+var govProposeChangeParam = &cobra.Command{
+	Use:   "propose_change_param <fromAddr> <networkID> <paramKey module/param> <paramValue (jsonObj)> <fees>",
+	Short: "Propose to edit a param in the network",
+	Long: `Submit a proposal to change any param from any module, the change is made if the staked validators vote for it.
+Will prompt the user for the <fromAddr> account passphrase.`,
+	Args: cobra.ExactArgs(5),
+	Run: func(cmd *cobra.Command, args []string) {
+		app.InitConfig(datadir, tmNode, persistentPeers, seeds, remoteCLIURL)
+		fees, err := strconv.Atoi(args[4])
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		fa, err := types.AddressFromHex(args[0])
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		valueBytes, err := app.Codec().MarshalJSON(json.RawMessage(args[3]))
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		content := govTypes.MsgChangeParam{
+			FromAddress: fa,
+			ParamKey:    args[2],
+			ParamVal:    valueBytes,
+		}
+		fmt.Println("Enter Password: ")
+		res, err := SubmitProposal(args[0], &content, app.Credentials(pwd), args[1], int64(fees), false)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		j, err := json.Marshal(res)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		resp, err := QueryRPC(SendRawTxPath, j)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		fmt.Println(resp)
+	},
+}
+
+var govProposeDAOTransfer = &cobra.Command{
+	Use:   "propose_transfer <amount> <fromAddr> <toAddr> <networkID> <fees>",
+	Short: "Propose to transfer from DAO",
+	Long: `Submit a proposal to move funds from the DAO, the funds are moved if the staked validators vote for it.
+Will prompt the user for the <fromAddr> account passphrase.`,
+	Args: cobra.ExactArgs(5),
+	Run: func(cmd *cobra.Command, args []string) {
+		app.InitConfig(datadir, tmNode, persistentPeers, seeds, remoteCLIURL)
+		amount, err := strconv.Atoi(args[0])
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		fa, err := types.AddressFromHex(args[1])
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		ta, err := types.AddressFromHex(args[2])
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		fees, err := strconv.Atoi(args[4])
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		content := govTypes.MsgDAOTransfer{
+			FromAddress: fa,
+			ToAddress:   ta,
+			Amount:      types.NewInt(int64(amount)),
+			Action:      govTypes.DAOTransferString,
+		}
+		fmt.Println("Enter Password: ")
+		res, err := SubmitProposal(args[1], &content, app.Credentials(pwd), args[3], int64(fees), false)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		j, err := json.Marshal(res)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		resp, err := QueryRPC(SendRawTxPath, j)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		fmt.Println(resp)
+	},
+}
+
+var govProposeUpgrade = &cobra.Command{
+	Use:   "propose_upgrade <fromAddr> <atHeight> <version> <networkID> <fees>",
+	Short: "Propose to upgrade the protocol",
+	Long: `Submit a proposal to upgrade the protocol, the upgrade is made if the staked validators vote for it.
+Will prompt the user for the <fromAddr> account passphrase.`,
+	Args: cobra.ExactArgs(5),
+	Run: func(cmd *cobra.Command, args []string) {
+		app.InitConfig(datadir, tmNode, persistentPeers, seeds, remoteCLIURL)
+		i, err := strconv.Atoi(args[1])
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		fa, err := types.AddressFromHex(args[0])
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		fees, err := strconv.Atoi(args[4])
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		content := govTypes.MsgUpgrade{
+			Address: fa,
+			Upgrade: govTypes.Upgrade{
+				Height:  int64(i),
+				Version: dropTag(args[2]),
+			},
+		}
+		fmt.Println("Enter Password: ")
+		res, err := SubmitProposal(args[0], &content, app.Credentials(pwd), args[3], int64(fees), false)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		j, err := json.Marshal(res)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		resp, err := QueryRPC(SendRawTxPath, j)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		fmt.Println(resp)
+	},
+}
+
+var govVote = &cobra.Command{
+	Use:   "vote <fromAddr> <proposalID> <option> <networkID> <fees>",
+	Short: "Vote on a proposal",
+	Long: `If the account is a staked validator, vote on a proposal in its voting period with the stake of the validator.
+Options: [yes, no, abstain]
+Will prompt the user for the <fromAddr> account passphrase.`,
+	Args: cobra.ExactArgs(5),
+	Run: func(cmd *cobra.Command, args []string) {
+		app.InitConfig(datadir, tmNode, persistentPeers, seeds, remoteCLIURL)
+		id, err := strconv.Atoi(args[1])
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		fees, err := strconv.Atoi(args[4])
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		fmt.Println("Enter Password: ")
+		res, err := Vote(args[0], int64(id), args[2], app.Credentials(pwd), args[3], int64(fees), false)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		j, err := json.Marshal(res)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		resp, err := QueryRPC(SendRawTxPath, j)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		fmt.Println(resp)
+	},
+}
+
+var govScheduleParam = &cobra.Command{
+	Use:   "schedule_param <fromAddr> <networkID> <paramKey module/param> <paramValue (jsonObj)> <atHeight> <fees>",
+	Short: "Schedule a param change at a future height",
+	Long: `If authorized, submit a tx to change any param from any module at the beginning of the block at <atHeight>.
+The change can be cancelled with cancel_param before <atHeight>.
+Will prompt the user for the <fromAddr> account passphrase.`,
+	Args: cobra.ExactArgs(6),
+	Run: func(cmd *cobra.Command, args []string) {
+		app.InitConfig(datadir, tmNode, persistentPeers, seeds, remoteCLIURL)
+		height, err := strconv.Atoi(args[4])
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		fees, err := strconv.Atoi(args[5])
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		fmt.Println("Enter Password: ")
+		res, err := ScheduleParamChange(args[0], args[2], []byte(args[3]), int64(height), app.Credentials(pwd), args[1], int64(fees), false)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		j, err := json.Marshal(res)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		resp, err := QueryRPC(SendRawTxPath, j)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		fmt.Println(resp)
+	},
+}
+
+var govCancelParam = &cobra.Command{
+	Use:   "cancel_param <fromAddr> <networkID> <paramKey module/param> <atHeight> <fees>",
+	Short: "Cancel a scheduled param change",
+	Long: `If authorized, cancel the change of the param scheduled at <atHeight>.
+Will prompt the user for the <fromAddr> account passphrase.`,
+	Args: cobra.ExactArgs(5),
+	Run: func(cmd *cobra.Command, args []string) {
+		app.InitConfig(datadir, tmNode, persistentPeers, seeds, remoteCLIURL)
+		height, err := strconv.Atoi(args[3])
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		fees, err := strconv.Atoi(args[4])
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		fmt.Println("Enter Password: ")
+		res, err := CancelParamChange(args[0], args[2], int64(height), app.Credentials(pwd), args[1], int64(fees), false)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		j, err := json.Marshal(res)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		resp, err := QueryRPC(SendRawTxPath, j)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		fmt.Println(resp)
+	},
+}
@@ -21,9 +21,12 @@ var nodesCmd = &cobra.Command{
 from staking and unstaking; to unjailing.`,
 }
 
+var feePayer string
+
 func init() {
 	nodeUnstakeCmd.Flags().StringVar(&pwd, "pwd", "", "passphrase used by the cmd, non empty usage bypass interactive prompt")
 	nodeUnjailCmd.Flags().StringVar(&pwd, "pwd", "", "passphrase used by the cmd, non empty usage bypass interactive prompt")
+	nodeUnjailCmd.Flags().StringVar(&feePayer, "fee-payer", "", "address paying the fee out of its fee allowance to <fromAddr>, empty usage makes <fromAddr> pay it")
 }
 
 var nodeUnstakeCmd = &cobra.Command{
@@ -68,7 +71,8 @@ var nodeUnjailCmd = &cobra.Command{
 	Use:   "unjail <operatorAddr> <fromAddr> <networkID> <fee> <isBefore8.0>",
 	Short: "Unjails a node in the network",
 	Long: `Unjails a node from the network, allowing it to participate in service and consensus again.
-Will prompt the user for the <fromAddr> account passphrase.`,
+Will prompt the user for the <fromAddr> account passphrase.
+With --fee-payer the fee is paid by the fee payer, which must have granted a fee allowance to <fromAddr>.`,
 	Args: cobra.ExactArgs(5),
 	Run: func(cmd *cobra.Command, args []string) {
 		app.InitConfig(datadir, tmNode, persistentPeers, seeds, remoteCLIURL)
@@ -83,7 +87,7 @@ Will prompt the user for the <fromAddr> account passphrase.`,
 			return
 		}
 		fmt.Println("Enter Password: ")
-		res, err := UnjailNode(args[0], args[1], app.Credentials(pwd), args[2], int64(fee), isBefore8)
+		res, err := UnjailNode(args[0], args[1], feePayer, app.Credentials(pwd), args[2], int64(fee), isBefore8)
 		if err != nil {
 			fmt.Println(err)
 			return
@@ -3,6 +3,7 @@ package cli
 import (
 	"encoding/json"
 	"fmt"
+	"io/ioutil"
 	"strconv"
 	"strings"
 
@@ -12,6 +13,7 @@ import (
 
 	"github.com/pokt-network/pocket-core/app"
 	nodeTypes "github.com/pokt-network/pocket-core/x/nodes/types"
+	pocketTypes "github.com/pokt-network/pocket-core/x/pocketcore/types"
 	"github.com/spf13/cobra"
 )
 
@@ -25,6 +27,7 @@ func init() {
 	queryCmd.AddCommand(queryNodes)
 	queryCmd.AddCommand(queryBalance)
 	queryCmd.AddCommand(queryAccount)
+	queryCmd.AddCommand(queryAllowances)
 	queryCmd.AddCommand(queryNode)
 	queryCmd.AddCommand(queryApps)
 	queryCmd.AddCommand(queryApp)
@@ -32,6 +35,8 @@ func init() {
 	queryCmd.AddCommand(queryAppParams)
 	queryCmd.AddCommand(queryNodeClaims)
 	queryCmd.AddCommand(queryNodeClaim)
+	queryCmd.AddCommand(queryEarnings)
+	queryCmd.AddCommand(querySimulateStake)
 	queryCmd.AddCommand(queryPocketParams)
 	queryCmd.AddCommand(queryPocketSupportedChains)
 	queryCmd.AddCommand(querySupply)
@@ -40,7 +45,11 @@ func init() {
 	queryCmd.AddCommand(queryAllParams)
 	queryCmd.AddCommand(queryParam)
 	queryCmd.AddCommand(queryDAOOwner)
+	queryCmd.AddCommand(queryProposals)
+	queryCmd.AddCommand(queryVotes)
+	queryCmd.AddCommand(queryScheduledParams)
 	queryCmd.AddCommand(querySigningInfo)
+	queryCmd.AddCommand(queryRelayLedger)
 }
 
 var queryCmd = &cobra.Command{
@@ -692,6 +701,131 @@ var queryNodeClaim = &cobra.Command{
 	},
 }
 
+var allowancesGranter string
+var allowancesGrantee string
+var allowancesHeight int64
+var allowancesPage int
+var allowancesPerPage int
+
+func init() {
+	queryAllowances.Flags().StringVar(&allowancesGranter, "granter", "", "only the allowances granted by the address")
+	queryAllowances.Flags().StringVar(&allowancesGrantee, "grantee", "", "only the allowances granted to the address")
+	queryAllowances.Flags().Int64Var(&allowancesHeight, "height", 0, "the height of the world state, the latest by default")
+	queryAllowances.Flags().IntVar(&allowancesPage, "page", 1, "mark the page you want")
+	queryAllowances.Flags().IntVar(&allowancesPerPage, "per-page", 10000, "reduce the amount of results")
+}
+
+var queryAllowances = &cobra.Command{
+	Use:   "allowances",
+	Short: "Gets the active fee allowances",
+	Long:  `Retrieves the fee allowances that are not expired at --height, of the --granter and to the --grantee if set.`,
+	Args:  cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		app.InitConfig(datadir, tmNode, persistentPeers, seeds, remoteCLIURL)
+		params := rpc.PaginatedAllowancesParams{
+			Height:  allowancesHeight,
+			Granter: allowancesGranter,
+			Grantee: allowancesGrantee,
+			Page:    allowancesPage,
+			PerPage: allowancesPerPage,
+		}
+		j, err := json.Marshal(params)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		res, err := QueryRPC(GetAllowancesPath, j)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		fmt.Println(res)
+	},
+}
+
+var earningsStake int64
+var earningsRelays int64
+var earningsHeight int64
+
+func init() {
+	queryEarnings.Flags().Int64Var(&earningsStake, "stake", 0, "a hypothetical stake in uPOKT, the stake of the node by default")
+	queryEarnings.Flags().Int64Var(&earningsRelays, "relays", 0, "the number of relays to project")
+	queryEarnings.Flags().Int64Var(&earningsHeight, "height", 0, "the height of the params, the latest by default")
+}
+
+var queryEarnings = &cobra.Command{
+	Use:   "earnings [<nodeAddr>]",
+	Short: "Estimates the rewards of a servicer",
+	Long: `Estimates the uPOKT minted for the pending claims and the unclaimed evidence of <nodeAddr>, and for the number of --relays,
+split into the servicer, DAO and proposer shares with the params at --height.
+Without <nodeAddr> only the --relays are projected, with the --stake.`,
+	Args: cobra.MaximumNArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		app.InitConfig(datadir, tmNode, persistentPeers, seeds, remoteCLIURL)
+		params := rpc.EarningsParams{
+			Height: earningsHeight,
+			Stake:  earningsStake,
+			Relays: earningsRelays,
+		}
+		if len(args) == 1 {
+			params.Address = args[0]
+		}
+		j, err := json.Marshal(params)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		res, err := QueryRPC(GetEarningsPath, j)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		fmt.Println(res)
+	},
+}
+
+var simulateStakeOutput string
+var simulateStakeSigner string
+var simulateStakeHeight int64
+
+func init() {
+	querySimulateStake.Flags().StringVar(&simulateStakeOutput, "output", "", "the output address of a non-custodial stake, custodial by default")
+	querySimulateStake.Flags().StringVar(&simulateStakeSigner, "signer", "", "the address that would sign the message, the output (or operator) address by default")
+	querySimulateStake.Flags().Int64Var(&simulateStakeHeight, "height", 0, "the height of the world state, the latest by default")
+}
+
+var querySimulateStake = &cobra.Command{
+	Use:   "simulate-stake <operatorPublicKey> <amount> <relayChainIDs> <serviceURI>",
+	Short: "Dry-runs a node stake or edit stake",
+	Long: `Runs the stake (or edit stake, if the node is already staked) of <operatorPublicKey> against the world state at --height
+without a signature, and reports every rule it breaks along with the resulting stake weight.
+<relayChainIDs> is a comma separated list, the stake is custodial unless an --output address is given.`,
+	Args: cobra.ExactArgs(4),
+	Run: func(cmd *cobra.Command, args []string) {
+		app.InitConfig(datadir, tmNode, persistentPeers, seeds, remoteCLIURL)
+		params := rpc.SimulateStakeParams{
+			Height:     simulateStakeHeight,
+			PublicKey:  args[0],
+			Value:      args[1],
+			Chains:     strings.Split(args[2], ","),
+			ServiceURL: args[3],
+			Output:     simulateStakeOutput,
+			Signer:     simulateStakeSigner,
+		}
+		j, err := json.Marshal(params)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		res, err := QueryRPC(GetSimulateStakePath, j)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		fmt.Println(res)
+	},
+}
+
 var queryPocketParams = &cobra.Command{
 	Use:   "pocket-params [<height>]",
 	Short: "Gets pocket parameters",
@@ -862,6 +996,123 @@ var queryACL = &cobra.Command{
 	},
 }
 
+var proposalsStatus string
+
+func init() {
+	queryProposals.Flags().StringVar(&proposalsStatus, "status", "", "only the proposals with the status [voting, passed, rejected, failed]")
+}
+
+var queryProposals = &cobra.Command{
+	Use:   "proposals [<height>]",
+	Short: "Gets the gov proposals",
+	Long:  `Retrieves the governance proposals at <height>, only the ones with the --status if set.`,
+	Args:  cobra.MaximumNArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		app.InitConfig(datadir, tmNode, persistentPeers, seeds, remoteCLIURL)
+		var height int
+		if len(args) == 0 {
+			height = 0 // latest
+		} else {
+			var err error
+			height, err = strconv.Atoi(args[0])
+			if err != nil {
+				fmt.Println(err)
+				return
+			}
+		}
+		params := rpc.HeightAndStatusParams{
+			Height: int64(height),
+			Status: proposalsStatus,
+		}
+		j, err := json.Marshal(params)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		res, err := QueryRPC(GetProposalsPath, j)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		fmt.Println(res)
+	},
+}
+
+var queryVotes = &cobra.Command{
+	Use:   "votes <proposalID> [<height>]",
+	Short: "Gets the votes of a gov proposal",
+	Long:  `Retrieves the votes of the staked validators on the proposal at <height>.`,
+	Args:  cobra.RangeArgs(1, 2),
+	Run: func(cmd *cobra.Command, args []string) {
+		app.InitConfig(datadir, tmNode, persistentPeers, seeds, remoteCLIURL)
+		id, err := strconv.Atoi(args[0])
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		var height int
+		if len(args) == 1 {
+			height = 0 // latest
+		} else {
+			height, err = strconv.Atoi(args[1])
+			if err != nil {
+				fmt.Println(err)
+				return
+			}
+		}
+		params := rpc.HeightAndProposalIDParams{
+			Height:     int64(height),
+			ProposalID: int64(id),
+		}
+		j, err := json.Marshal(params)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		res, err := QueryRPC(GetVotesPath, j)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		fmt.Println(res)
+	},
+}
+
+var queryScheduledParams = &cobra.Command{
+	Use:   "scheduled-params [<height>]",
+	Short: "Gets the scheduled param changes",
+	Long:  `Retrieves the param changes scheduled by the param owners that are not applied yet at <height>, sorted by the height they are applied at.`,
+	Args:  cobra.MaximumNArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		app.InitConfig(datadir, tmNode, persistentPeers, seeds, remoteCLIURL)
+		var height int
+		if len(args) == 0 {
+			height = 0 // latest
+		} else {
+			var err error
+			height, err = strconv.Atoi(args[0])
+			if err != nil {
+				fmt.Println(err)
+				return
+			}
+		}
+		params := rpc.HeightParams{
+			Height: int64(height),
+		}
+		j, err := json.Marshal(params)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		res, err := QueryRPC(GetScheduledParamsPath, j)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		fmt.Println(res)
+	},
+}
+
 var queryAllParams = &cobra.Command{
 	Use:   "params [<height>]",
 	Short: "Gets all parameters",
@@ -1003,3 +1254,58 @@ var querySigningInfo = &cobra.Command{
 		fmt.Println(res)
 	},
 }
+
+var ledgerServicer string
+var ledgerApp string
+var ledgerChain string
+var ledgerFromHeight int64
+var ledgerToHeight int64
+var ledgerFormat string
+var ledgerOutput string
+
+func init() {
+	queryRelayLedger.Flags().StringVar(&ledgerServicer, "servicer", "", "only the sessions of the servicer address")
+	queryRelayLedger.Flags().StringVar(&ledgerApp, "app", "", "only the sessions of the application public key")
+	queryRelayLedger.Flags().StringVar(&ledgerChain, "chain", "", "only the sessions of the relay chain identifier")
+	queryRelayLedger.Flags().Int64Var(&ledgerFromHeight, "from-height", 0, "the first session height (inclusive)")
+	queryRelayLedger.Flags().Int64Var(&ledgerToHeight, "to-height", 0, "the last session height (inclusive), 0 for no limit")
+	queryRelayLedger.Flags().StringVar(&ledgerFormat, "format", "json", "the export format: json or csv")
+	queryRelayLedger.Flags().StringVar(&ledgerOutput, "output", "", "write the export to this file instead of stdout")
+}
+
+var queryRelayLedger = &cobra.Command{
+	Use:   "relay-ledger",
+	Short: "Gets the relay ledger of the node",
+	Long: `Retrieves the relays served, the claim and proof transactions and the uPOKT minted per session from the local relay ledger.
+Requires relay_ledger to be enabled in the config of the node.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		app.InitConfig(datadir, tmNode, persistentPeers, seeds, remoteCLIURL)
+		params := rpc.RelayLedgerParams{
+			LedgerFilter: pocketTypes.LedgerFilter{
+				Servicer:          ledgerServicer,
+				ApplicationPubKey: ledgerApp,
+				Chain:             ledgerChain,
+				FromHeight:        ledgerFromHeight,
+				ToHeight:          ledgerToHeight,
+			},
+			Format: ledgerFormat,
+		}
+		j, err := json.Marshal(params)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		res, err := QuerySecuredRPC(GetRelayLedgerPath, j, app.GetAuthTokenFromFile())
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		if ledgerOutput == "" {
+			fmt.Println(res)
+			return
+		}
+		if err := ioutil.WriteFile(ledgerOutput, []byte(res), 0644); err != nil {
+			fmt.Println(err)
+		}
+	},
+}
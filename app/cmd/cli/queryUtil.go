@@ -21,8 +21,12 @@ var (
 	GetACLPath,
 	GetUpgradePath,
 	GetDAOOwnerPath,
+	GetProposalsPath,
+	GetVotesPath,
+	GetScheduledParamsPath,
 	GetHeightPath,
 	GetAccountPath,
+	GetAllowancesPath,
 	GetAppPath,
 	GetTxPath,
 	GetBlockPath,
@@ -37,12 +41,17 @@ var (
 	GetPocketParamsPath,
 	GetNodeClaimsPath,
 	GetNodeClaimPath,
+	GetEarningsPath,
+	GetSimulateStakePath,
 	GetBlockTxsPath,
 	GetSupplyPath,
 	GetAllParamsPath,
 	GetParamPath,
 	GetStopPath,
 	GetQueryChains,
+	GetRelayLedgerPath,
+	GetExportEvidencePath,
+	GetImportEvidencePath,
 	GetAccountsPath string
 )
 
@@ -60,12 +69,20 @@ func init() {
 			GetUpgradePath = route.Path
 		case "QueryDAOOwner":
 			GetDAOOwnerPath = route.Path
+		case "QueryProposals":
+			GetProposalsPath = route.Path
+		case "QueryVotes":
+			GetVotesPath = route.Path
+		case "QueryScheduledParams":
+			GetScheduledParamsPath = route.Path
 		case "QueryHeight":
 			GetHeightPath = route.Path
 		case "QueryAccount":
 			GetAccountPath = route.Path
 		case "QueryAccounts":
 			GetAccountsPath = route.Path
+		case "QueryAllowances":
+			GetAllowancesPath = route.Path
 		case "QueryApp":
 			GetAppPath = route.Path
 		case "QueryTX":
@@ -98,6 +115,10 @@ func init() {
 			GetNodeClaimPath = route.Path
 		case "QueryNodeClaims":
 			GetNodeClaimsPath = route.Path
+		case "QueryEarnings":
+			GetEarningsPath = route.Path
+		case "QuerySimulateStake":
+			GetSimulateStakePath = route.Path
 		case "QueryAllParams":
 			GetAllParamsPath = route.Path
 		case "QueryParam":
@@ -106,6 +127,12 @@ func init() {
 			GetStopPath = route.Path
 		case "QueryChains":
 			GetQueryChains = route.Path
+		case "QueryRelayLedger":
+			GetRelayLedgerPath = route.Path
+		case "ExportEvidence":
+			GetExportEvidencePath = route.Path
+		case "ImportEvidence":
+			GetImportEvidencePath = route.Path
 		default:
 			continue
 		}
@@ -248,8 +248,8 @@ func UnstakeNode(operatorAddr, fromAddr, passphrase, chainID string, fees int64,
 	}, nil
 }
 
-// UnjailNode - Remove node from jail
-func UnjailNode(operatorAddr, fromAddr, passphrase, chainID string, fees int64, isBefore8 bool) (*rpc.SendRawTxParams, error) {
+// UnjailNode - Remove node from jail, the fees are paid by the fee payer out of its fee allowance if not empty
+func UnjailNode(operatorAddr, fromAddr, feePayer, passphrase, chainID string, fees int64, isBefore8 bool) (*rpc.SendRawTxParams, error) {
 	fa, err := sdk.AddressFromHex(fromAddr)
 	if err != nil {
 		return nil, err
@@ -258,6 +258,13 @@ func UnjailNode(operatorAddr, fromAddr, passphrase, chainID string, fees int64,
 	if err != nil {
 		return nil, err
 	}
+	var fp sdk.Address
+	if feePayer != "" {
+		fp, err = sdk.AddressFromHex(feePayer)
+		if err != nil {
+			return nil, err
+		}
+	}
 	var msg sdk.ProtoMsg
 	if isBefore8 {
 		msg = &nodeTypes.LegacyMsgUnjail{
@@ -277,7 +284,7 @@ func UnjailNode(operatorAddr, fromAddr, passphrase, chainID string, fees int64,
 	if err != nil {
 		return nil, err
 	}
-	txBz, err := newTxBz(app.Codec(), msg, fa, chainID, kb, passphrase, fees, "", false)
+	txBz, err := newTxBzWithFeePayer(app.Codec(), msg, fa, chainID, kb, passphrase, fees, "", fp, false)
 	if err != nil {
 		return nil, err
 	}
@@ -449,12 +456,198 @@ func Upgrade(fromAddr string, upgrade govTypes.Upgrade, passphrase, chainID stri
 	}, nil
 }
 
+// SubmitProposal - Submits the param change, dao transfer or upgrade to the vote of the staked validators
+func SubmitProposal(fromAddr string, content sdk.Msg, passphrase, chainID string, fees int64, legacyCodec bool) (*rpc.SendRawTxParams, error) {
+	fa, err := sdk.AddressFromHex(fromAddr)
+	if err != nil {
+		return nil, err
+	}
+	kb, err := app.GetKeybase()
+	if err != nil {
+		return nil, err
+	}
+	msg := govTypes.MsgSubmitProposal{
+		Proposer: fa,
+		Content:  content,
+	}
+	err = msg.ValidateBasic()
+	if err != nil {
+		return nil, err
+	}
+	txBz, err := newTxBz(app.Codec(), &msg, fa, chainID, kb, passphrase, fees, "", legacyCodec)
+	if err != nil {
+		return nil, err
+	}
+	return &rpc.SendRawTxParams{
+		Addr:        fromAddr,
+		RawHexBytes: hex.EncodeToString(txBz),
+	}, nil
+}
+
+// Vote - Votes on a proposal with the stake of the validator
+func Vote(fromAddr string, proposalID int64, option, passphrase, chainID string, fees int64, legacyCodec bool) (*rpc.SendRawTxParams, error) {
+	fa, err := sdk.AddressFromHex(fromAddr)
+	if err != nil {
+		return nil, err
+	}
+	kb, err := app.GetKeybase()
+	if err != nil {
+		return nil, err
+	}
+	msg := govTypes.MsgVote{
+		Voter:      fa,
+		ProposalID: proposalID,
+		Option:     option,
+	}
+	err = msg.ValidateBasic()
+	if err != nil {
+		return nil, err
+	}
+	txBz, err := newTxBz(app.Codec(), &msg, fa, chainID, kb, passphrase, fees, "", legacyCodec)
+	if err != nil {
+		return nil, err
+	}
+	return &rpc.SendRawTxParams{
+		Addr:        fromAddr,
+		RawHexBytes: hex.EncodeToString(txBz),
+	}, nil
+}
+
+// ScheduleParamChange - Schedules a param change at the beginning of the block at the height
+func ScheduleParamChange(fromAddr, paramACLKey string, paramValue json.RawMessage, height int64, passphrase, chainID string, fees int64, legacyCodec bool) (*rpc.SendRawTxParams, error) {
+	fa, err := sdk.AddressFromHex(fromAddr)
+	if err != nil {
+		return nil, err
+	}
+	kb, err := app.GetKeybase()
+	if err != nil {
+		return nil, err
+	}
+	valueBytes, err := app.Codec().MarshalJSON(paramValue)
+	if err != nil {
+		return nil, err
+	}
+	msg := govTypes.MsgScheduleParamChange{
+		FromAddress: fa,
+		ParamKey:    paramACLKey,
+		ParamVal:    valueBytes,
+		Height:      height,
+	}
+	err = msg.ValidateBasic()
+	if err != nil {
+		return nil, err
+	}
+	txBz, err := newTxBz(app.Codec(), &msg, fa, chainID, kb, passphrase, fees, "", legacyCodec)
+	if err != nil {
+		return nil, err
+	}
+	return &rpc.SendRawTxParams{
+		Addr:        fromAddr,
+		RawHexBytes: hex.EncodeToString(txBz),
+	}, nil
+}
+
+// CancelParamChange - Cancels the param change scheduled at the height
+func CancelParamChange(fromAddr, paramACLKey string, height int64, passphrase, chainID string, fees int64, legacyCodec bool) (*rpc.SendRawTxParams, error) {
+	fa, err := sdk.AddressFromHex(fromAddr)
+	if err != nil {
+		return nil, err
+	}
+	kb, err := app.GetKeybase()
+	if err != nil {
+		return nil, err
+	}
+	msg := govTypes.MsgCancelParamChange{
+		FromAddress: fa,
+		ParamKey:    paramACLKey,
+		Height:      height,
+	}
+	err = msg.ValidateBasic()
+	if err != nil {
+		return nil, err
+	}
+	txBz, err := newTxBz(app.Codec(), &msg, fa, chainID, kb, passphrase, fees, "", legacyCodec)
+	if err != nil {
+		return nil, err
+	}
+	return &rpc.SendRawTxParams{
+		Addr:        fromAddr,
+		RawHexBytes: hex.EncodeToString(txBz),
+	}, nil
+}
+
+// GrantFeeAllowance - Allows the grantee to pay the fees of its transactions from the granter account
+func GrantFeeAllowance(granter, grantee, passphrase, chainID string, spendLimit sdk.BigInt, expirationHeight, fees int64) (*rpc.SendRawTxParams, error) {
+	ga, err := sdk.AddressFromHex(granter)
+	if err != nil {
+		return nil, err
+	}
+	ge, err := sdk.AddressFromHex(grantee)
+	if err != nil {
+		return nil, err
+	}
+	var limit sdk.Coins
+	if spendLimit.IsPositive() {
+		limit = sdk.NewCoins(sdk.NewCoin(sdk.DefaultStakeDenom, spendLimit))
+	}
+	kb, err := app.GetKeybase()
+	if err != nil {
+		return nil, err
+	}
+	msg := authTypes.NewMsgGrantFeeAllowance(ga, ge, limit, expirationHeight)
+	err = msg.ValidateBasic()
+	if err != nil {
+		return nil, err
+	}
+	txBz, err := newTxBz(app.Codec(), &msg, ga, chainID, kb, passphrase, fees, "", false)
+	if err != nil {
+		return nil, err
+	}
+	return &rpc.SendRawTxParams{
+		Addr:        granter,
+		RawHexBytes: hex.EncodeToString(txBz),
+	}, nil
+}
+
+// RevokeFeeAllowance - Removes the fee allowance of the granter to the grantee
+func RevokeFeeAllowance(granter, grantee, passphrase, chainID string, fees int64) (*rpc.SendRawTxParams, error) {
+	ga, err := sdk.AddressFromHex(granter)
+	if err != nil {
+		return nil, err
+	}
+	ge, err := sdk.AddressFromHex(grantee)
+	if err != nil {
+		return nil, err
+	}
+	kb, err := app.GetKeybase()
+	if err != nil {
+		return nil, err
+	}
+	msg := authTypes.NewMsgRevokeFeeAllowance(ga, ge)
+	err = msg.ValidateBasic()
+	if err != nil {
+		return nil, err
+	}
+	txBz, err := newTxBz(app.Codec(), &msg, ga, chainID, kb, passphrase, fees, "", false)
+	if err != nil {
+		return nil, err
+	}
+	return &rpc.SendRawTxParams{
+		Addr:        granter,
+		RawHexBytes: hex.EncodeToString(txBz),
+	}, nil
+}
+
 func newTxBz(cdc *codec.Codec, msg sdk.ProtoMsg, fromAddr sdk.Address, chainID string, keybase keys.Keybase, passphrase string, fee int64, memo string, legacyCodec bool) (transactionBz []byte, err error) {
+	return newTxBzWithFeePayer(cdc, msg, fromAddr, chainID, keybase, passphrase, fee, memo, nil, legacyCodec)
+}
+
+func newTxBzWithFeePayer(cdc *codec.Codec, msg sdk.ProtoMsg, fromAddr sdk.Address, chainID string, keybase keys.Keybase, passphrase string, fee int64, memo string, feePayer sdk.Address, legacyCodec bool) (transactionBz []byte, err error) {
 	// fees
 	fees := sdk.NewCoins(sdk.NewCoin(sdk.DefaultStakeDenom, sdk.NewInt(fee)))
 	// entroyp
 	entropy := rand.Int64()
-	signBytes, err := auth.StdSignBytes(chainID, entropy, fees, msg, memo)
+	signBytes, err := auth.StdSignBytesWithFeePayer(chainID, entropy, fees, msg, memo, feePayer)
 	if err != nil {
 		return nil, err
 	}
@@ -463,7 +656,7 @@ func newTxBz(cdc *codec.Codec, msg sdk.ProtoMsg, fromAddr sdk.Address, chainID s
 		return nil, err
 	}
 	s := authTypes.StdSignature{PublicKey: pubKey, Signature: sig}
-	tx := authTypes.NewTx(msg, fees, s, memo, entropy)
+	tx := authTypes.NewTx(msg, fees, s, memo, entropy).(authTypes.StdTx).WithFeePayer(feePayer)
 	if legacyCodec {
 		return auth.DefaultTxEncoder(cdc)(tx, 0)
 	}
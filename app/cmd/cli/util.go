@@ -1,12 +1,15 @@
 package cli
 
 import (
+	"encoding/json"
 	"fmt"
 	"github.com/pokt-network/pocket-core/x/pocketcore/types"
+	"io/ioutil"
 	"os"
 	"strconv"
 
 	"github.com/pokt-network/pocket-core/app"
+	"github.com/pokt-network/pocket-core/app/cmd/rpc"
 	"github.com/spf13/cobra"
 	"github.com/tendermint/tendermint/libs/log"
 	"github.com/tendermint/tendermint/state"
@@ -19,6 +22,8 @@ func init() {
 	utilCmd.AddCommand(decodeTxCmd)
 	utilCmd.AddCommand(exportGenesisForReset)
 	utilCmd.AddCommand(convertPocketEvidenceDB)
+	utilCmd.AddCommand(exportEvidenceCmd)
+	utilCmd.AddCommand(importEvidenceCmd)
 	utilCmd.AddCommand(completionCmd)
 	utilCmd.AddCommand(updateConfigsCmd)
 	utilCmd.AddCommand(printDefaultConfigCmd)
@@ -154,6 +159,101 @@ var convertPocketEvidenceDB = &cobra.Command{
 	},
 }
 
+var (
+	evidenceServicer string
+	evidenceOffline  bool
+)
+
+func init() {
+	exportEvidenceCmd.Flags().StringVar(&evidenceServicer, "servicer", "", "only the evidence of the servicer address")
+	exportEvidenceCmd.Flags().BoolVar(&evidenceOffline, "offline", false, "read the evidence databases of the stopped node instead of querying the running node")
+}
+
+var exportEvidenceCmd = &cobra.Command{
+	Use:   "export-evidence <file>",
+	Short: "export the evidence and the session cache of the node to a file",
+	Long: `Exports the sealed and unsealed evidence of the servicers hosted by the running node, and its session cache, to a checksummed file.
+Use import-evidence on the node the servicer is moved to, so the relays that were not claimed yet are not lost.
+With --offline the evidence is read from the databases of a stopped node, the session cache is not exported.`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		app.InitConfig(datadir, tmNode, persistentPeers, seeds, remoteCLIURL)
+		if evidenceOffline {
+			snapshot, err := app.ExportEvidenceOffline(evidenceServicer)
+			if err != nil {
+				fmt.Println(err)
+				return
+			}
+			bz, err := json.Marshal(snapshot)
+			if err != nil {
+				fmt.Println(err)
+				return
+			}
+			if err := ioutil.WriteFile(args[0], bz, 0600); err != nil {
+				fmt.Println(err)
+				return
+			}
+			fmt.Printf("Exported %d pieces of evidence to %s (checksum %s)\n", len(snapshot.Evidence), args[0], snapshot.Checksum)
+			return
+		}
+		j, err := json.Marshal(rpc.ExportEvidenceParams{Servicer: evidenceServicer})
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		res, err := QuerySecuredRPC(GetExportEvidencePath, j, app.GetAuthTokenFromFile())
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		var snapshot types.EvidenceSnapshot
+		if err := json.Unmarshal([]byte(res), &snapshot); err != nil {
+			fmt.Println(res)
+			return
+		}
+		if err := snapshot.ValidateBasic(); err != nil {
+			fmt.Println(err)
+			return
+		}
+		if err := ioutil.WriteFile(args[0], []byte(res), 0600); err != nil {
+			fmt.Println(err)
+			return
+		}
+		fmt.Printf("Exported %d pieces of evidence and %d sessions at height %d to %s (checksum %s)\n", len(snapshot.Evidence), len(snapshot.Sessions), snapshot.Height, args[0], snapshot.Checksum)
+	},
+}
+
+var importEvidenceCmd = &cobra.Command{
+	Use:   "import-evidence <file>",
+	Short: "import the evidence and the session cache exported from another node",
+	Long: `Imports a file made by export-evidence into the running node. The servicer keys of the evidence must be hosted by this node.
+Each piece of evidence is validated against the current state before it is merged with the evidence of the node.`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		app.InitConfig(datadir, tmNode, persistentPeers, seeds, remoteCLIURL)
+		bz, err := ioutil.ReadFile(args[0])
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		var snapshot types.EvidenceSnapshot
+		if err := json.Unmarshal(bz, &snapshot); err != nil {
+			fmt.Println("error decoding the evidence file: ", err)
+			return
+		}
+		if err := snapshot.ValidateBasic(); err != nil {
+			fmt.Println(err)
+			return
+		}
+		res, err := QuerySecuredRPC(GetImportEvidencePath, bz, app.GetAuthTokenFromFile())
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		fmt.Println(res)
+	},
+}
+
 var (
 	blocks bool
 )
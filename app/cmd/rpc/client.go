@@ -7,6 +7,7 @@ import (
 	"fmt"
 	nodesTypes "github.com/pokt-network/pocket-core/x/nodes/types"
 	"io/ioutil"
+	"net"
 	"net/http"
 	"os"
 	"strings"
@@ -14,6 +15,7 @@ import (
 
 	"github.com/julienschmidt/httprouter"
 	"github.com/pokt-network/pocket-core/app"
+	sdk "github.com/pokt-network/pocket-core/types"
 	"github.com/pokt-network/pocket-core/x/pocketcore/types"
 )
 
@@ -27,6 +29,11 @@ func Dispatch(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
 		WriteErrorResponse(w, 400, err.Error())
 		return
 	}
+	// the app of a dispatch is not authenticated, so only the ip is limited
+	if err := types.CheckIPRateLimit(d.Chain, sourceIP(r)); err != nil {
+		WriteErrorResponse(w, http.StatusTooManyRequests, err.Error())
+		return
+	}
 	res, err := app.PCA.HandleDispatch(d)
 	if err != nil {
 		WriteErrorResponse(w, 400, err.Error())
@@ -57,7 +64,7 @@ func Relay(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
 	if cors(&w, r) {
 		return
 	}
-	if err := PopModel(w, r, ps, &relay); err != nil {
+	if err := PopModelWithLimit(w, r, ps, &relay, app.PCA.GetHostedBlockchains().MaxRequestLimit()); err != nil {
 		response := RPCRelayErrorResponse{
 			Error: err,
 		}
@@ -65,14 +72,27 @@ func Relay(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
 		WriteJSONResponseWithCode(w, string(j), r.URL.Path, r.Host, 400)
 		return
 	}
+	// reject early by ip, the app and the client are limited once the relay is validated
+	if err := types.CheckIPRateLimit(relay.Proof.Blockchain, sourceIP(r)); err != nil {
+		response := RPCRelayErrorResponse{
+			Error: err,
+		}
+		j, _ := json.Marshal(response)
+		WriteJSONResponseWithCode(w, string(j), r.URL.Path, r.Host, http.StatusTooManyRequests)
+		return
+	}
 	res, dispatch, err := app.PCA.HandleRelay(relay)
 	if err != nil {
 		response := RPCRelayErrorResponse{
 			Error:    err,
 			Dispatch: dispatch,
 		}
+		code := 400
+		if e, ok := err.(sdk.Error); ok && e.Code() == types.CodeRateLimitError {
+			code = http.StatusTooManyRequests
+		}
 		j, _ := json.Marshal(response)
-		WriteJSONResponseWithCode(w, string(j), r.URL.Path, r.Host, 400)
+		WriteJSONResponseWithCode(w, string(j), r.URL.Path, r.Host, code)
 		return
 	}
 	response := RPCRelayResponse{
@@ -87,6 +107,15 @@ func Relay(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
 	WriteJSONResponse(w, string(j), r.URL.Path, r.Host)
 }
 
+// "sourceIP" - Returns the ip of the remote address of the request, forwarding headers are not trusted
+func sourceIP(r *http.Request) string {
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return r.RemoteAddr
+	}
+	return host
+}
+
 // UpdateChains
 func UpdateChains(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
 	value := r.URL.Query().Get("authtoken")
@@ -191,6 +220,34 @@ func SendRawTx(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
 	WriteJSONResponse(w, string(j), r.URL.Path, r.Host)
 }
 
+type SimulateTxParams struct {
+	RawHexBytes string `json:"raw_hex_bytes"`
+}
+
+func SimulateTx(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	var params = SimulateTxParams{}
+	if err := PopModel(w, r, ps, &params); err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return
+	}
+	bz, err := hex.DecodeString(params.RawHexBytes)
+	if err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return
+	}
+	res, err := app.PCA.SimulateTx(bz)
+	if err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return
+	}
+	j, er := app.Codec().MarshalJSON(res)
+	if er != nil {
+		WriteErrorResponse(w, 400, er.Error())
+		return
+	}
+	WriteJSONResponse(w, string(j), r.URL.Path, r.Host)
+}
+
 type simRelayParams struct {
 	RelayNetworkID string        `json:"relay_network_id"` // RelayNetworkID
 	Payload        types.Payload `json:"payload"`          // the data payload of the request
@@ -209,12 +266,13 @@ func SimRequest(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
 		WriteErrorResponse(w, 400, err.Error())
 		return
 	}
-	url := strings.Trim(chain.URL, `/`)
+	endpoint := types.OrderEndpoints(chain)[0]
+	url := strings.Trim(endpoint.URL, `/`)
 	if len(params.Payload.Path) > 0 {
 		url = url + "/" + strings.Trim(params.Payload.Path, `/`)
 	}
 	// do basic http request on the relay
-	res, er := executeHTTPRequest(params.Payload.Data, url, types.GlobalPocketConfig.UserAgent, chain.BasicAuth, params.Payload.Method, params.Payload.Headers)
+	res, er := executeHTTPRequest(params.Payload.Data, url, types.GlobalPocketConfig.UserAgent, endpoint.BasicAuth, params.Payload.Method, params.Payload.Headers)
 	if er != nil {
 		WriteErrorResponse(w, 400, er.Error())
 		return
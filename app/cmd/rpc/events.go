@@ -0,0 +1,370 @@
+package rpc
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/julienschmidt/httprouter"
+	"github.com/pokt-network/pocket-core/app"
+	sdk "github.com/pokt-network/pocket-core/types"
+	appsTypes "github.com/pokt-network/pocket-core/x/apps/types"
+	govTypes "github.com/pokt-network/pocket-core/x/gov/types"
+	nodesTypes "github.com/pokt-network/pocket-core/x/nodes/types"
+	pocketTypes "github.com/pokt-network/pocket-core/x/pocketcore/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+	core_types "github.com/tendermint/tendermint/rpc/core/types"
+)
+
+const EventsWebsocketPath = "/v1/events/ws"
+
+// the kinds of message of the event stream
+const (
+	StreamedBlock = "block"
+	StreamedTx    = "tx"
+	StreamedEvent = "event"
+	StreamedError = "error"
+)
+
+// the stage of the block a module event was emitted at
+const (
+	BeginBlockStage = "begin_block"
+	TxStage         = "tx"
+	EndBlockStage   = "end_block"
+)
+
+var (
+	// used to name the new block subscription of every stream
+	eventStreamCount int64
+	// the number of clients of the event stream, capped by the max event subscribers
+	eventSubscribers int64
+)
+
+// EventsSubscription - The first message sent by the client of the event stream, selects what is streamed
+type EventsSubscription struct {
+	FromHeight   int64    `json:"from_height"`   // the height to resume from (inclusive), the next block if 0
+	Blocks       bool     `json:"blocks"`        // include the block in the block message of every height
+	Txs          bool     `json:"txs"`           // stream the txs, filtered by the fields below
+	Signer       string   `json:"signer"`        // only the txs signed by the address
+	Recipient    string   `json:"recipient"`     // only the txs received by the address
+	MessageTypes []string `json:"message_types"` // only the txs of the message types, e.g. send, claim, proof, stake_validator
+	Events       []string `json:"events"`        // the module events streamed, e.g. claim, proof, jail, slash, stake, unstake, param_change
+}
+
+// StreamedMessage - A message of the event stream. Every height starts with a block message, followed by the txs and
+// the module events of the block
+type StreamedMessage struct {
+	Type   string          `json:"type"`
+	Height int64           `json:"height"`
+	Block  json.RawMessage `json:"block,omitempty"`
+	Tx     json.RawMessage `json:"tx,omitempty"` // an RPCResultTx, marshalled with the app codec
+	Event  *ModuleEvent    `json:"event,omitempty"`
+	Error  string          `json:"error,omitempty"`
+}
+
+// ModuleEvent - An event emitted by a module while the block was executed
+type ModuleEvent struct {
+	Stage      string          `json:"stage"`
+	TxHash     string          `json:"tx_hash,omitempty"` // the tx that emitted the event, in the tx stage
+	Type       string          `json:"type"`
+	Attributes []sdk.Attribute `json:"attributes"`
+	Data       json.RawMessage `json:"data,omitempty"` // the typed payload of the event, marshalled with the app codec
+}
+
+// EventsStream upgrades the connection and streams the blocks, txs and module events of every height, starting from
+// the height of the subscription sent by the client as its first message. The subscription must be sent before the
+// deadline of the request, if any
+func EventsStream(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	subscribers := atomic.AddInt64(&eventSubscribers, 1)
+	defer atomic.AddInt64(&eventSubscribers, -1)
+	if max := app.GlobalConfig.PocketConfig.MaxEventSubscribers; max > 0 && subscribers > int64(max) {
+		WriteErrorResponse(w, http.StatusServiceUnavailable, fmt.Sprintf("the event stream is limited to %d subscribers", max))
+		return
+	}
+	conn, err := upgrader.Upgrade(w, r, nil)
+	if err != nil {
+		// the upgrader already replied with an http error
+		return
+	}
+	s := &eventStream{conn: conn}
+	defer s.close()
+	if deadline, ok := r.Context().Deadline(); ok {
+		_ = conn.SetReadDeadline(deadline)
+	}
+	if err := conn.ReadJSON(&s.sub); err != nil {
+		s.writeError(fmt.Errorf("invalid subscription: %v", err), 0)
+		return
+	}
+	_ = conn.SetReadDeadline(time.Time{})
+	if err := s.sub.ValidateBasic(app.PCA.LastBlockHeight()); err != nil {
+		s.writeError(err, 0)
+		return
+	}
+	s.serve()
+}
+
+// ValidateBasic - Checks the addresses and the height of the subscription
+func (sub EventsSubscription) ValidateBasic(latestHeight int64) error {
+	if sub.FromHeight < 0 || sub.FromHeight > latestHeight+1 {
+		return fmt.Errorf("invalid from_height %d, the latest height is %d", sub.FromHeight, latestHeight)
+	}
+	for _, addr := range []string{sub.Signer, sub.Recipient} {
+		if addr == "" {
+			continue
+		}
+		if _, err := sdk.AddressFromHex(addr); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// "matchTx" - Returns true if the tx passes the filters of the subscription
+func (sub EventsSubscription) matchTx(res *abci.ResponseDeliverTx) bool {
+	if !sub.Txs {
+		return false
+	}
+	if sub.Signer != "" && !equalHexAddress(sub.Signer, res.Signer) {
+		return false
+	}
+	if sub.Recipient != "" && !equalHexAddress(sub.Recipient, res.Recipient) {
+		return false
+	}
+	return len(sub.MessageTypes) == 0 || containsString(sub.MessageTypes, res.MessageType)
+}
+
+// "matchEvent" - Returns true if the module event is streamed
+func (sub EventsSubscription) matchEvent(eventType string) bool {
+	return containsString(sub.Events, eventType)
+}
+
+// "eventStream" - the state of a single client of the event stream
+type eventStream struct {
+	conn     *websocket.Conn
+	sub      EventsSubscription
+	l        sync.Mutex // guards the writes to the client
+	closeOne sync.Once
+}
+
+func (s *eventStream) serve() {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	// the client doesn't send anything after the subscription, reading only detects that it went away
+	go func() {
+		defer cancel()
+		for {
+			if _, _, err := s.conn.ReadMessage(); err != nil {
+				return
+			}
+		}
+	}()
+	notify, err := app.PCA.SubscribeNewBlocks(ctx, fmt.Sprintf("events-stream-%d", atomic.AddInt64(&eventStreamCount, 1)))
+	if err != nil {
+		s.writeError(err, 0)
+		return
+	}
+	height := s.sub.FromHeight
+	if height == 0 {
+		height = app.PCA.LastBlockHeight() + 1
+	}
+	for {
+		// catch up with the latest height before waiting for the next block
+		for ; height <= app.PCA.LastBlockHeight(); height++ {
+			if err := s.streamHeight(height); err != nil {
+				s.writeError(err, height)
+				return
+			}
+			if ctx.Err() != nil {
+				return
+			}
+		}
+		select {
+		case _, ok := <-notify:
+			if !ok {
+				return
+			}
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// "streamHeight" - Sends the block message, the txs and the module events of the height
+func (s *eventStream) streamHeight(height int64) error {
+	b, results, err := app.PCA.QueryBlockResults(height)
+	if err != nil {
+		return err
+	}
+	msg := StreamedMessage{Type: StreamedBlock, Height: height}
+	if s.sub.Blocks {
+		if msg.Block, err = app.Codec().MarshalJSON(b); err != nil {
+			return err
+		}
+	}
+	if err := s.write(msg); err != nil {
+		return err
+	}
+	if err := s.streamEvents(height, BeginBlockStage, nil, results.BeginBlockEvents); err != nil {
+		return err
+	}
+	for i, res := range results.TxsResults {
+		tx := b.Block.Txs[i]
+		resultTx := &core_types.ResultTx{
+			Hash:     tx.Hash(),
+			Height:   height,
+			Index:    uint32(i),
+			TxResult: *res,
+			Tx:       tx,
+		}
+		if s.sub.matchTx(res) {
+			bz, err := marshalStreamedTx(resultTx)
+			if err != nil {
+				return err
+			}
+			if err := s.write(StreamedMessage{Type: StreamedTx, Height: height, Tx: bz}); err != nil {
+				return err
+			}
+		}
+		if err := s.streamEvents(height, TxStage, resultTx, res.Events); err != nil {
+			return err
+		}
+	}
+	return s.streamEvents(height, EndBlockStage, nil, results.EndBlockEvents)
+}
+
+// "marshalStreamedTx" - Decodes the tx with the codec of its height and marshals the result with the app codec
+func marshalStreamedTx(res *core_types.ResultTx) (json.RawMessage, error) {
+	resultTx := ResultTxToRPC(res)
+	// a tx that can't be decoded has no message
+	if resultTx.StdTx.Msg == nil {
+		return nil, fmt.Errorf("unable to decode the tx %X at height %d", res.Tx.Hash(), res.Height)
+	}
+	return app.Codec().MarshalJSON(resultTx)
+}
+
+// "streamEvents" - Sends the module events of the stage, tx is the tx that emitted them in the tx stage
+func (s *eventStream) streamEvents(height int64, stage string, tx *core_types.ResultTx, events []abci.Event) error {
+	for _, e := range events {
+		if !s.sub.matchEvent(e.Type) {
+			continue
+		}
+		event := sdk.StringifyEvent(e)
+		data, err := decodeEventData(height, tx, event)
+		if err != nil {
+			return err
+		}
+		moduleEvent := &ModuleEvent{
+			Stage:      stage,
+			Type:       event.Type,
+			Attributes: event.Attributes,
+			Data:       data,
+		}
+		if tx != nil {
+			moduleEvent.TxHash = fmt.Sprintf("%X", tx.Hash)
+		}
+		if err := s.write(StreamedMessage{Type: StreamedEvent, Height: height, Event: moduleEvent}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// "decodeEventData" - Decodes the module event into its typed payload, marshalled with the app codec: the message of
+// the tx for the claims and proofs, the node or app at the height for the jailing, slashing and stake changes and the
+// param at the height for the param changes. The other events have no payload
+func decodeEventData(height int64, tx *core_types.ResultTx, event sdk.StringEvent) (json.RawMessage, error) {
+	attributes := make(map[string]string, len(event.Attributes))
+	for _, a := range event.Attributes {
+		attributes[a.Key] = a.Value
+	}
+	var data interface{}
+	var err error
+	switch event.Type {
+	case pocketTypes.EventTypeClaim, pocketTypes.EventTypeProof:
+		if tx == nil {
+			return nil, nil
+		}
+		resultTx := ResultTxToRPC(tx)
+		if resultTx.StdTx.Msg == nil {
+			return nil, fmt.Errorf("unable to decode the tx %X at height %d", tx.Tx.Hash(), height)
+		}
+		data = resultTx.StdTx.Msg
+	case nodesTypes.EventTypeJail, nodesTypes.EventTypeSlash:
+		data, err = queryStakeChange(height, nodesTypes.ModuleName, attributes[nodesTypes.AttributeKeyAddress])
+	case nodesTypes.EventTypeStake, nodesTypes.EventTypeBeginUnstake, nodesTypes.EventTypeUnstake,
+		nodesTypes.EventTypeWaitingToBeginUnstaking, nodesTypes.EventTypeCompleteUnstaking:
+		if addr, ok := attributes[appsTypes.AttributeKeyApplication]; ok {
+			data, err = queryStakeChange(height, appsTypes.ModuleName, addr)
+			break
+		}
+		data, err = queryStakeChange(height, attributes[sdk.AttributeKeyModule], attributes[sdk.AttributeKeySender])
+	case govTypes.EventParamChange:
+		// the action of the change is "modified: <param key> to: <value>"
+		key := strings.TrimPrefix(attributes[sdk.AttributeKeyAction], "modified: ")
+		if i := strings.Index(key, " to: "); i >= 0 {
+			key = key[:i]
+		}
+		data, err = app.PCA.QueryParam(height, key)
+	default:
+		return nil, nil
+	}
+	if err != nil || data == nil {
+		return nil, err
+	}
+	return app.Codec().MarshalJSON(data)
+}
+
+// "queryStakeChange" - Returns the node or app of the module at the height. An unstaked one is deleted at the height,
+// so its state is the one of the previous height
+func queryStakeChange(height int64, module, addr string) (interface{}, error) {
+	var query func(addr string, height int64) (interface{}, error)
+	switch module {
+	case nodesTypes.ModuleName:
+		query = func(addr string, height int64) (interface{}, error) { return app.PCA.QueryNode(addr, height) }
+	case appsTypes.ModuleName:
+		query = func(addr string, height int64) (interface{}, error) { return app.PCA.QueryApp(addr, height) }
+	default:
+		return nil, nil
+	}
+	res, err := query(addr, height)
+	if err != nil && height > 1 {
+		res, err = query(addr, height-1)
+	}
+	return res, err
+}
+
+func (s *eventStream) writeError(err error, height int64) {
+	_ = s.write(StreamedMessage{Type: StreamedError, Height: height, Error: err.Error()})
+}
+
+func (s *eventStream) write(msg StreamedMessage) error {
+	s.l.Lock()
+	defer s.l.Unlock()
+	return s.conn.WriteJSON(msg)
+}
+
+func (s *eventStream) close() {
+	s.closeOne.Do(func() {
+		_ = s.conn.Close()
+	})
+}
+
+func equalHexAddress(hexAddr string, addr []byte) bool {
+	a, err := sdk.AddressFromHex(hexAddr)
+	return err == nil && a.Equals(sdk.Address(addr))
+}
+
+func containsString(s []string, v string) bool {
+	for _, x := range s {
+		if x == v {
+			return true
+		}
+	}
+	return false
+}
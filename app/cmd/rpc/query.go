@@ -17,6 +17,7 @@ import (
 	"github.com/pokt-network/pocket-core/app"
 	appTypes "github.com/pokt-network/pocket-core/x/apps/types"
 	nodeTypes "github.com/pokt-network/pocket-core/x/nodes/types"
+	pocketTypes "github.com/pokt-network/pocket-core/x/pocketcore/types"
 	core_types "github.com/tendermint/tendermint/rpc/core/types"
 )
 
@@ -52,6 +53,24 @@ type HeightAndApplicaitonOptsParams struct {
 	Opts   appTypes.QueryApplicationsWithOpts `json:"opts"`
 }
 
+type PaginatedAllowancesParams struct {
+	Height  int64  `json:"height"`
+	Granter string `json:"granter,omitempty"`
+	Grantee string `json:"grantee,omitempty"`
+	Page    int    `json:"page,omitempty"`
+	PerPage int    `json:"per_page,omitempty"`
+}
+
+type HeightAndStatusParams struct {
+	Height int64  `json:"height"`
+	Status string `json:"status,omitempty"`
+}
+
+type HeightAndProposalIDParams struct {
+	Height     int64 `json:"height"`
+	ProposalID int64 `json:"proposal_id"`
+}
+
 type PaginateAddrParams struct {
 	Address  string `json:"address"`
 	Page     int    `json:"page,omitempty"`
@@ -386,6 +405,28 @@ func Accounts(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
 	WriteJSONResponse(w, string(s), r.URL.Path, r.Host)
 }
 
+func Allowances(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	var params = PaginatedAllowancesParams{Height: 0}
+	if err := PopModel(w, r, ps, &params); err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return
+	}
+	if params.Height == 0 {
+		params.Height = app.PCA.BaseApp.LastBlockHeight()
+	}
+	res, err := app.PCA.QueryFeeAllowances(params.Granter, params.Grantee, params.Height, params.Page, params.PerPage)
+	if err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return
+	}
+	s, err := json.Marshal(res)
+	if err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return
+	}
+	WriteJSONResponse(w, string(s), r.URL.Path, r.Host)
+}
+
 func Nodes(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
 	var params = HeightAndValidatorOptsParams{}
 	if err := PopModel(w, r, ps, &params); err != nil {
@@ -487,7 +528,7 @@ func Chains(w http.ResponseWriter, r *http.Request, _ httprouter.Params) {
 			WriteErrorResponse(w, 400, err.Error())
 			return
 		}
-		j, err := app.Codec().MarshalJSON(res)
+		j, err := json.Marshal(res)
 		if err != nil {
 			WriteErrorResponse(w, 400, err.Error())
 			return
@@ -498,6 +539,117 @@ func Chains(w http.ResponseWriter, r *http.Request, _ httprouter.Params) {
 	}
 }
 
+type RelayLedgerParams struct {
+	pocketTypes.LedgerFilter
+	Format string `json:"format"` // json (default) or csv
+}
+
+func RelayLedger(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	value := r.URL.Query().Get("authtoken")
+	if value != app.AuthToken.Value {
+		WriteErrorResponse(w, 401, "wrong authtoken "+value)
+		return
+	}
+	var params = RelayLedgerParams{}
+	if err := PopModel(w, r, ps, &params); err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return
+	}
+	entries, err := app.PCA.QueryRelayLedger(params.LedgerFilter)
+	if err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return
+	}
+	switch params.Format {
+	case "", "json":
+		j, err := json.Marshal(map[string]interface{}{"entries": entries})
+		if err != nil {
+			WriteErrorResponse(w, 400, err.Error())
+			return
+		}
+		WriteJSONResponse(w, string(j), r.URL.Path, r.Host)
+	case "csv":
+		res, err := pocketTypes.LedgerEntriesToCSV(entries)
+		if err != nil {
+			WriteErrorResponse(w, 400, err.Error())
+			return
+		}
+		w.Header().Set("Content-Type", "text/csv; charset=utf-8")
+		if _, err := w.Write([]byte(res)); err != nil {
+			fmt.Println(fmt.Errorf("error in RPC Handler RelayLedger: %v", err))
+		}
+	default:
+		WriteErrorResponse(w, 400, "unsupported format "+params.Format)
+	}
+}
+
+func Submissions(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	value := r.URL.Query().Get("authtoken")
+	if value != app.AuthToken.Value {
+		WriteErrorResponse(w, 401, "wrong authtoken "+value)
+		return
+	}
+	j, err := json.Marshal(map[string]interface{}{"submissions": app.PCA.QuerySubmissions()})
+	if err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return
+	}
+	WriteJSONResponse(w, string(j), r.URL.Path, r.Host)
+}
+
+type ExportEvidenceParams struct {
+	Servicer string `json:"servicer"` // only the evidence of the servicer address, every hosted servicer if empty
+}
+
+func ExportEvidence(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	value := r.URL.Query().Get("authtoken")
+	if value != app.AuthToken.Value {
+		WriteErrorResponse(w, 401, "wrong authtoken "+value)
+		return
+	}
+	var params = ExportEvidenceParams{}
+	if err := PopModel(w, r, ps, &params); err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return
+	}
+	res, err := app.PCA.ExportEvidence(params.Servicer)
+	if err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return
+	}
+	j, err := json.Marshal(res)
+	if err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return
+	}
+	WriteJSONResponse(w, string(j), r.URL.Path, r.Host)
+}
+
+func ImportEvidence(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	value := r.URL.Query().Get("authtoken")
+	if value != app.AuthToken.Value {
+		WriteErrorResponse(w, 401, "wrong authtoken "+value)
+		return
+	}
+	var snapshot = pocketTypes.EvidenceSnapshot{}
+	// snapshots are larger than the usual request, there's no limit for this private endpoint
+	if err := PopModelWithLimit(w, r, ps, &snapshot, 0); err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return
+	}
+	res, err := app.PCA.ImportEvidence(snapshot)
+	if err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return
+	}
+	j, err := json.Marshal(res)
+	if err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return
+	}
+	WriteJSONResponse(w, string(j), r.URL.Path, r.Host)
+}
+
 func NodeParams(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
 	var params = HeightParams{Height: 0}
 	if err := PopModel(w, r, ps, &params); err != nil {
@@ -595,6 +747,72 @@ func NodeClaims(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
 	WriteJSONResponse(w, string(j), r.URL.Path, r.Host)
 }
 
+type EarningsParams struct {
+	Height  int64  `json:"height"`
+	Address string `json:"address"` // the node the pending claims and evidence are estimated for, optional
+	Stake   int64  `json:"stake"`   // a hypothetical stake in uPOKT, the stake of the node if 0
+	Relays  int64  `json:"relays"`  // the relays to project
+}
+
+func Earnings(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	var params = EarningsParams{}
+	if err := PopModel(w, r, ps, &params); err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return
+	}
+	if params.Height == 0 {
+		params.Height = app.PCA.BaseApp.LastBlockHeight()
+	}
+	res, err := app.PCA.QueryEarnings(params.Address, params.Stake, params.Relays, params.Height)
+	if err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return
+	}
+	j, err := app.Codec().MarshalJSON(res)
+	if err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return
+	}
+	WriteJSONResponse(w, string(j), r.URL.Path, r.Host)
+}
+
+type SimulateStakeParams struct {
+	Height     int64    `json:"height"`
+	PublicKey  string   `json:"public_key"` // the public key of the operator (hex)
+	Chains     []string `json:"chains"`
+	ServiceURL string   `json:"service_url"`
+	Value      string   `json:"value"`  // the stake in uPOKT
+	Output     string   `json:"output"` // the output address, custodial if empty
+	Signer     string   `json:"signer"` // the address that would sign the message, the output (or operator) if empty
+}
+
+func SimulateStake(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	var params = SimulateStakeParams{}
+	if err := PopModel(w, r, ps, &params); err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return
+	}
+	if params.Height == 0 {
+		params.Height = app.PCA.BaseApp.LastBlockHeight()
+	}
+	value, ok := sdk.NewIntFromString(params.Value)
+	if !ok {
+		WriteErrorResponse(w, 400, "invalid value: "+params.Value)
+		return
+	}
+	res, err := app.PCA.SimulateStake(params.PublicKey, params.Chains, params.ServiceURL, value, params.Output, params.Signer, params.Height)
+	if err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return
+	}
+	j, err := app.Codec().MarshalJSON(res)
+	if err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return
+	}
+	WriteJSONResponse(w, string(j), r.URL.Path, r.Host)
+}
+
 func Apps(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
 	var params = HeightAndApplicaitonOptsParams{}
 	if err := PopModel(w, r, ps, &params); err != nil {
@@ -831,6 +1049,72 @@ func ACL(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
 	WriteResponse(w, string(j), r.URL.Path, r.Host)
 }
 
+func Proposals(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	var params = HeightAndStatusParams{Height: 0}
+	if err := PopModel(w, r, ps, &params); err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return
+	}
+	if params.Height == 0 {
+		params.Height = app.PCA.BaseApp.LastBlockHeight()
+	}
+	res, err := app.PCA.QueryProposals(params.Height, params.Status)
+	if err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return
+	}
+	j, err := app.Codec().MarshalJSON(res)
+	if err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return
+	}
+	WriteResponse(w, string(j), r.URL.Path, r.Host)
+}
+
+func Votes(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	var params = HeightAndProposalIDParams{Height: 0}
+	if err := PopModel(w, r, ps, &params); err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return
+	}
+	if params.Height == 0 {
+		params.Height = app.PCA.BaseApp.LastBlockHeight()
+	}
+	res, err := app.PCA.QueryVotes(params.Height, params.ProposalID)
+	if err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return
+	}
+	j, err := app.Codec().MarshalJSON(res)
+	if err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return
+	}
+	WriteResponse(w, string(j), r.URL.Path, r.Host)
+}
+
+func ScheduledParams(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	var params = HeightParams{Height: 0}
+	if err := PopModel(w, r, ps, &params); err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return
+	}
+	if params.Height == 0 {
+		params.Height = app.PCA.BaseApp.LastBlockHeight()
+	}
+	res, err := app.PCA.QueryScheduledParamChanges(params.Height)
+	if err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return
+	}
+	j, err := app.Codec().MarshalJSON(res)
+	if err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return
+	}
+	WriteResponse(w, string(j), r.URL.Path, r.Host)
+}
+
 func AllParams(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
 	var params = HeightParams{Height: 0}
 	if err := PopModel(w, r, ps, &params); err != nil {
@@ -13,7 +13,9 @@ import (
 	"os"
 	"strconv"
 	"strings"
+	"sync/atomic"
 	"testing"
+	"time"
 
 	"github.com/pokt-network/pocket-core/codec"
 
@@ -23,10 +25,12 @@ import (
 
 	types3 "github.com/pokt-network/pocket-core/x/apps/types"
 
+	"github.com/gorilla/websocket"
 	"github.com/julienschmidt/httprouter"
 	"github.com/pokt-network/pocket-core/types"
 	"github.com/pokt-network/pocket-core/x/auth"
 	authTypes "github.com/pokt-network/pocket-core/x/auth/types"
+	govTypes "github.com/pokt-network/pocket-core/x/gov/types"
 	"github.com/pokt-network/pocket-core/x/nodes"
 	types2 "github.com/pokt-network/pocket-core/x/nodes/types"
 	pocketTypes "github.com/pokt-network/pocket-core/x/pocketcore/types"
@@ -902,6 +906,7 @@ func TestRPC_Relay(t *testing.T) {
 	// setup relay endpoint
 	expectedRequest := `"jsonrpc":"2.0","method":"web3_sha3","params":["0x68656c6c6f20776f726c64"],"id":64`
 	expectedResponse := "0x47173285a8d7341e5e972fc677286384f802f8ef42a5ec5f03bbfa254cb01fad"
+	gock.InterceptClient(pocketTypes.GetRelayClient(dummyChainsHash))
 	gock.New(dummyChainsURL).
 		Post("").
 		BodyString(expectedRequest).
@@ -996,6 +1001,124 @@ func TestRPC_Relay(t *testing.T) {
 	stopCli()
 }
 
+func TestRPC_WebsocketRelay(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping in short mode")
+	}
+	codec.UpgradeHeight = 7000
+
+	kb := getInMemoryKeybase()
+	genBZ, _, validators, application := fiveValidatorsOneAppGenesis()
+	_, _, cleanup := NewInMemoryTendermintNode(t, genBZ)
+	defer cleanup()
+	// the websocket of the hosted chain echoes the requests and counts the connections
+	var dials int32
+	backend := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		conn, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			return
+		}
+		atomic.AddInt32(&dials, 1)
+		defer conn.Close()
+		for {
+			mt, msg, err := conn.ReadMessage()
+			if err != nil {
+				return
+			}
+			if err := conn.WriteMessage(mt, msg); err != nil {
+				return
+			}
+		}
+	}))
+	defer backend.Close()
+	_, err := app.PCA.SetHostedChains(map[string]pocketTypes.HostedBlockchain{dummyChainsHash: {
+		ID:           dummyChainsHash,
+		URL:          dummyChainsURL,
+		WebsocketURL: "ws" + strings.TrimPrefix(backend.URL, "http"),
+	}})
+	assert.Nil(t, err)
+	defer func() {
+		_, _ = app.PCA.SetHostedChains(map[string]pocketTypes.HostedBlockchain{dummyChainsHash: {ID: dummyChainsHash, URL: dummyChainsURL}})
+	}()
+	// two messages per second from the same ip
+	pocketTypes.GlobalPocketConfig.RelayRateLimitIP, pocketTypes.GlobalPocketConfig.RelayRateLimitBurst = 1, 2
+	pocketTypes.ResetRateLimits()
+	defer func() {
+		pocketTypes.GlobalPocketConfig.RelayRateLimitIP, pocketTypes.GlobalPocketConfig.RelayRateLimitBurst = 0, 0
+		pocketTypes.ResetRateLimits()
+	}()
+	appPrivateKey, err := kb.ExportPrivateKeyObject(application.Address, "test")
+	assert.Nil(t, err)
+	aat := pocketTypes.AAT{
+		Version:              "0.0.1",
+		ApplicationPublicKey: appPrivateKey.PublicKey().RawString(),
+		ClientPublicKey:      appPrivateKey.PublicKey().RawString(),
+	}
+	sig, err := appPrivateKey.Sign(aat.Hash())
+	assert.Nil(t, err)
+	aat.ApplicationSignature = hex.EncodeToString(sig)
+	newWebsocketRelay := func(entropy int64, data string, signer crypto.PrivateKey) pocketTypes.Relay {
+		relay := pocketTypes.Relay{
+			Payload: pocketTypes.Payload{Data: data},
+			Meta:    pocketTypes.RelayMeta{BlockHeight: 5},
+			Proof: pocketTypes.RelayProof{
+				Entropy:            entropy,
+				SessionBlockHeight: 1,
+				ServicerPubKey:     validators[0].PublicKey.RawString(),
+				Blockchain:         dummyChainsHash,
+				Token:              aat,
+			},
+		}
+		relay.Proof.RequestHash = relay.RequestHashString()
+		sig, err := signer.Sign(relay.Proof.Hash())
+		assert.Nil(t, err)
+		relay.Proof.Signature = hex.EncodeToString(sig)
+		return relay
+	}
+	_, stopCli, evtChan := subscribeTo(t, tmTypes.EventNewBlock)
+	defer stopCli()
+	<-evtChan // Wait for block
+	srv := httptest.NewServer(Router(Routes{Route{Name: "WebsocketService", Method: "GET", Path: WebsocketRelayPath, HandlerFunc: WebsocketRelay}}))
+	defer srv.Close()
+	conn, _, err := websocket.DefaultDialer.Dial("ws"+strings.TrimPrefix(srv.URL, "http")+WebsocketRelayPath, nil)
+	assert.Nil(t, err)
+	defer conn.Close()
+	_ = conn.SetReadDeadline(time.Now().Add(20 * time.Second))
+	// a relay that isn't signed by the client is rejected before the chain is dialed
+	assert.Nil(t, conn.WriteJSON(newWebsocketRelay(1, `{"id":1}`, crypto.GenerateEd25519PrivKey())))
+	var errResponse map[string]json.RawMessage
+	assert.Nil(t, conn.ReadJSON(&errResponse))
+	assert.NotEqual(t, "null", string(errResponse["error"]))
+	assert.Zero(t, atomic.LoadInt32(&dials))
+	// the valid relay dials the chain and gets the signed response
+	assert.Nil(t, conn.WriteJSON(newWebsocketRelay(2, `{"id":2}`, appPrivateKey)))
+	var response pocketTypes.WebsocketRelayResponse
+	assert.Nil(t, conn.ReadJSON(&response))
+	assert.Equal(t, `{"id":2}`, response.Response)
+	assert.False(t, response.Streamed)
+	assert.NotEmpty(t, response.Signature)
+	assert.Equal(t, int32(1), atomic.LoadInt32(&dials))
+	// an acknowledgement for another chain is rejected
+	ack := newWebsocketRelay(3, hex.EncodeToString([]byte("foo")), appPrivateKey)
+	ack.Payload.Method = pocketTypes.WebsocketAckMethod
+	ack.Proof.Blockchain = "0099"
+	assert.Nil(t, conn.WriteJSON(ack))
+	errResponse = nil
+	assert.Nil(t, conn.ReadJSON(&errResponse))
+	assert.Contains(t, string(errResponse["error"]), "opened for chain "+dummyChainsHash)
+	// the ip is out of tokens
+	assert.Nil(t, conn.WriteJSON(newWebsocketRelay(4, `{"id":4}`, appPrivateKey)))
+	errResponse = nil
+	assert.Nil(t, conn.ReadJSON(&errResponse))
+	assert.Contains(t, string(errResponse["error"]), pocketTypes.IPRateLimit)
+	// acknowledgements are limited like the other relays
+	ack.Proof.Blockchain = dummyChainsHash
+	assert.Nil(t, conn.WriteJSON(ack))
+	errResponse = nil
+	assert.Nil(t, conn.ReadJSON(&errResponse))
+	assert.Contains(t, string(errResponse["error"]), pocketTypes.IPRateLimit)
+}
+
 func TestRPC_Dispatch(t *testing.T) {
 	codec.UpgradeHeight = 7000
 	kb := getInMemoryKeybase()
@@ -1110,6 +1233,289 @@ func TestRPC_RawTX(t *testing.T) {
 	stopCli()
 }
 
+func TestRPC_SimulateTx(t *testing.T) {
+	codec.UpgradeHeight = 7000
+	_, kb, cleanup := NewInMemoryTendermintNode(t, oneValTwoNodeGenesisState())
+	cb, err := kb.GetCoinbase()
+	assert.Nil(t, err)
+	kp, err := kb.Create("test")
+	assert.Nil(t, err)
+	pk, err := kb.ExportPrivateKeyObject(cb.GetAddress(), "test")
+	assert.Nil(t, err)
+	_, stopCli, evtChan := subscribeTo(t, tmTypes.EventNewBlock)
+	_ = memCodecMod(true)
+	newTx := func(amount int64, fee int64) authTypes.StdTx {
+		return authTypes.NewTestTx(types.Context{}.WithChainID("pocket-test"),
+			&types2.MsgSend{
+				FromAddress: cb.GetAddress(),
+				ToAddress:   kp.GetAddress(),
+				Amount:      types.NewInt(amount),
+			},
+			pk,
+			rand2.Int64(),
+			types.NewCoins(types.NewCoin(types.DefaultStakeDenom, types.NewInt(fee)))).(authTypes.StdTx)
+	}
+	simulate := func(tx authTypes.StdTx) (res app.TxSimulation) {
+		txBz, err := auth.DefaultTxEncoder(memCodec())(tx, 0)
+		assert.Nil(t, err)
+		q := newClientRequest("simulate", newBody(SimulateTxParams{RawHexBytes: hex.EncodeToString(txBz)}))
+		rec := httptest.NewRecorder()
+		SimulateTx(rec, q, httprouter.Params{})
+		assert.Nil(t, memCodec().UnmarshalJSON([]byte(getResponse(rec)), &res))
+		return
+	}
+	<-evtChan // Wait for block
+	balance, err := app.PCA.QueryBalance(cb.GetAddress().String(), 0)
+	assert.Nil(t, err)
+	res := simulate(newTx(1, 100000))
+	assert.True(t, res.Signed)
+	assert.Equal(t, uint32(0), res.Code)
+	assert.NotEmpty(t, res.Events)
+	assert.Equal(t, types.NewCoins(types.NewCoin(types.DefaultStakeDenom, types.NewInt(10000))), res.RequiredFee)
+	// the transaction doesn't need to be signed
+	unsigned := newTx(1, 100000)
+	unsigned.Signature.Signature = nil
+	res = simulate(unsigned)
+	assert.False(t, res.Signed)
+	assert.Equal(t, uint32(0), res.Code)
+	// the errors of the ante handler and of the message handler are returned
+	res = simulate(newTx(1, 1))
+	assert.Equal(t, uint32(authTypes.CodeInsufficientFee), res.Code)
+	res = simulate(newTx(balance.Add(types.OneInt()).Int64(), 100000))
+	assert.NotEqual(t, uint32(0), res.Code)
+	// nothing is committed
+	<-evtChan // Wait for block
+	after, err := app.PCA.QueryBalance(cb.GetAddress().String(), 0)
+	assert.Nil(t, err)
+	assert.Equal(t, balance, after)
+
+	cleanup()
+	stopCli()
+}
+
+func TestRPC_MultiMsgTx(t *testing.T) {
+	codec.UpgradeHeight = 7000
+	_, kb, cleanup := NewInMemoryTendermintNode(t, oneValTwoNodeGenesisState())
+	cb, err := kb.GetCoinbase()
+	assert.Nil(t, err)
+	kp, err := kb.Create("test")
+	assert.Nil(t, err)
+	pk, err := kb.ExportPrivateKeyObject(cb.GetAddress(), "test")
+	assert.Nil(t, err)
+	_, stopCli, evtChan := subscribeTo(t, tmTypes.EventNewBlock)
+	_ = memCodecMod(true)
+	send := func(amount int64) types.Msg {
+		return &types2.MsgSend{
+			FromAddress: cb.GetAddress(),
+			ToAddress:   kp.GetAddress(),
+			Amount:      types.NewInt(amount),
+		}
+	}
+	newTxBz := func(fee int64, msgs ...types.Msg) []byte {
+		msg := authTypes.NewMsgMulti(msgs...)
+		txBz, err := auth.DefaultTxEncoder(memCodec())(authTypes.NewTestTx(types.Context{}.WithChainID("pocket-test"),
+			&msg, pk, rand2.Int64(), types.NewCoins(types.NewCoin(types.DefaultStakeDenom, types.NewInt(fee)))), 0)
+		assert.Nil(t, err)
+		return txBz
+	}
+	simulate := func(txBz []byte) (res app.TxSimulation) {
+		q := newClientRequest("simulate", newBody(SimulateTxParams{RawHexBytes: hex.EncodeToString(txBz)}))
+		rec := httptest.NewRecorder()
+		SimulateTx(rec, q, httprouter.Params{})
+		assert.Nil(t, memCodec().UnmarshalJSON([]byte(getResponse(rec)), &res))
+		return
+	}
+	<-evtChan // Wait for block
+	// not accepted before the upgrade
+	codec.UpgradeFeatureMap[codec.MultiMsgKey] = 1000000
+	res := simulate(newTxBz(100000, send(1), send(2)))
+	assert.Equal(t, uint32(authTypes.CodeMultiMsgNotActive), res.Code)
+	codec.UpgradeFeatureMap[codec.MultiMsgKey] = 1
+	defer delete(codec.UpgradeFeatureMap, codec.MultiMsgKey)
+	// the fee is the sum of the fees of the messages
+	res = simulate(newTxBz(10000, send(1), send(2)))
+	assert.Equal(t, uint32(authTypes.CodeInsufficientFee), res.Code)
+	assert.Equal(t, types.NewCoins(types.NewCoin(types.DefaultStakeDenom, types.NewInt(20000))), res.RequiredFee)
+	// every message is applied
+	sendRes, err := app.PCA.SendRawTx(cb.GetAddress().String(), newTxBz(20000, send(1), send(2)))
+	assert.Nil(t, err)
+	assert.Equal(t, uint32(0), sendRes.Code)
+	<-evtChan // Wait for block
+	<-evtChan // Wait for block
+	received, err := app.PCA.QueryBalance(kp.GetAddress().String(), 0)
+	assert.Nil(t, err)
+	assert.Equal(t, types.NewInt(3), received)
+	// none of the messages is applied if one fails
+	balance, err := app.PCA.QueryBalance(cb.GetAddress().String(), 0)
+	assert.Nil(t, err)
+	sendRes, err = app.PCA.SendRawTx(cb.GetAddress().String(), newTxBz(20000, send(1), send(balance.Int64())))
+	assert.Nil(t, err)
+	assert.Equal(t, uint32(0), sendRes.Code)
+	<-evtChan // Wait for block
+	<-evtChan // Wait for block
+	received, err = app.PCA.QueryBalance(kp.GetAddress().String(), 0)
+	assert.Nil(t, err)
+	assert.Equal(t, types.NewInt(3), received)
+	failed, err := app.PCA.QueryTx(sendRes.TxHash, false)
+	assert.Nil(t, err)
+	assert.NotEqual(t, uint32(0), failed.TxResult.Code)
+	assert.Equal(t, authTypes.MsgMultiName, failed.TxResult.MessageType)
+
+	cleanup()
+	stopCli()
+}
+
+func TestRPC_EventsStream(t *testing.T) {
+	codec.UpgradeHeight = 7000
+	_, kb, cleanup := NewInMemoryTendermintNode(t, oneValTwoNodeGenesisState())
+	cb, err := kb.GetCoinbase()
+	assert.Nil(t, err)
+	kp, err := kb.Create("test")
+	assert.Nil(t, err)
+	pk, err := kb.ExportPrivateKeyObject(cb.GetAddress(), "test")
+	assert.Nil(t, err)
+	_, stopCli, evtChan := subscribeTo(t, tmTypes.EventNewBlock)
+	_ = memCodecMod(true)
+	txBz, err := auth.DefaultTxEncoder(memCodec())(authTypes.NewTestTx(types.Context{}.WithChainID("pocket-test"),
+		&types2.MsgSend{
+			FromAddress: cb.GetAddress(),
+			ToAddress:   kp.GetAddress(),
+			Amount:      types.NewInt(1),
+		},
+		pk,
+		rand2.Int64(),
+		types.NewCoins(types.NewCoin(types.DefaultStakeDenom, types.NewInt(100000)))), 0)
+	assert.Nil(t, err)
+	<-evtChan // Wait for block
+	res, err := app.PCA.SendRawTx(cb.GetAddress().String(), txBz)
+	assert.Nil(t, err)
+	assert.Equal(t, uint32(0), res.Code)
+	<-evtChan // Wait for block
+	<-evtChan // Wait for block
+	srv := httptest.NewServer(TimeoutHandler(Router(Routes{Route{Name: "EventsStream", Method: "GET", Path: EventsWebsocketPath, HandlerFunc: EventsStream}}), 3*time.Second))
+	defer srv.Close()
+	conn, _, err := websocket.DefaultDialer.Dial("ws"+strings.TrimPrefix(srv.URL, "http")+EventsWebsocketPath, nil)
+	assert.Nil(t, err)
+	defer conn.Close()
+	// resume from the first height, only the txs of the recipient and the transfers are streamed
+	assert.Nil(t, conn.WriteJSON(EventsSubscription{
+		FromHeight:   1,
+		Txs:          true,
+		Recipient:    kp.GetAddress().String(),
+		MessageTypes: []string{types2.MsgSendName},
+		Events:       []string{"transfer"},
+	}))
+	_ = conn.SetReadDeadline(time.Now().Add(20 * time.Second))
+	type streamedTx struct {
+		Hash     string               `json:"hash"`
+		TxResult RPCResponseDeliverTx `json:"tx_result"`
+		StdTx    json.RawMessage      `json:"stdTx"`
+	}
+	var tx *streamedTx
+	var transfer *ModuleEvent
+	lastHeight := int64(0)
+	for tx == nil || transfer == nil {
+		var msg struct {
+			StreamedMessage
+			Tx *streamedTx `json:"tx"`
+		}
+		if !assert.Nil(t, conn.ReadJSON(&msg)) {
+			break
+		}
+		assert.NotEqual(t, StreamedError, msg.Type, msg.Error)
+		assert.True(t, msg.Height >= lastHeight)
+		lastHeight = msg.Height
+		switch msg.Type {
+		case StreamedBlock:
+			assert.Nil(t, msg.Block)
+		case StreamedTx:
+			tx = msg.Tx
+		case StreamedEvent:
+			transfer = msg.Event
+		}
+	}
+	if assert.NotNil(t, tx) && assert.NotNil(t, transfer) {
+		assert.Equal(t, types2.MsgSendName, tx.TxResult.MessageType)
+		assert.True(t, kp.GetAddress().Equals(types.Address(tx.TxResult.Recipient)))
+		assert.Contains(t, string(tx.StdTx), kp.GetAddress().String())
+		assert.Equal(t, TxStage, transfer.Stage)
+		assert.Equal(t, tx.Hash, transfer.TxHash)
+		assert.Nil(t, transfer.Data)
+	}
+	// a jailing carries the node at the height and a param change the param
+	height := app.PCA.LastBlockHeight()
+	data, err := decodeEventData(height, nil, types.StringEvent{
+		Type:       types2.EventTypeJail,
+		Attributes: []types.Attribute{{Key: types2.AttributeKeyAddress, Value: cb.GetAddress().String()}},
+	})
+	assert.Nil(t, err)
+	var node types2.Validator
+	assert.Nil(t, app.Codec().UnmarshalJSON(data, &node))
+	assert.True(t, cb.GetAddress().Equals(node.Address))
+	data, err = decodeEventData(height, nil, types.StringEvent{
+		Type:       govTypes.EventParamChange,
+		Attributes: []types.Attribute{{Key: types.AttributeKeyAction, Value: "modified: pos/StakeMinimum to: 1"}},
+	})
+	assert.Nil(t, err)
+	var param app.SingleParamReturn
+	assert.Nil(t, json.Unmarshal(data, &param))
+	assert.Equal(t, "pos/StakeMinimum", param.Key)
+	assert.NotEmpty(t, param.Value)
+	// a subscription past the next height is rejected
+	conn2, _, err := websocket.DefaultDialer.Dial("ws"+strings.TrimPrefix(srv.URL, "http")+EventsWebsocketPath, nil)
+	assert.Nil(t, err)
+	defer conn2.Close()
+	assert.Nil(t, conn2.WriteJSON(EventsSubscription{FromHeight: app.PCA.LastBlockHeight() + 10}))
+	var msg StreamedMessage
+	assert.Nil(t, conn2.ReadJSON(&msg))
+	assert.Equal(t, StreamedError, msg.Type)
+
+	cleanup()
+	stopCli()
+}
+
+func TestRPC_EventsStreamLimits(t *testing.T) {
+	prev := app.GlobalConfig.PocketConfig.MaxEventSubscribers
+	app.GlobalConfig.PocketConfig.MaxEventSubscribers = 1
+	defer func() { app.GlobalConfig.PocketConfig.MaxEventSubscribers = prev }()
+	srv := httptest.NewServer(TimeoutHandler(Router(Routes{Route{Name: "EventsStream", Method: "GET", Path: EventsWebsocketPath, HandlerFunc: EventsStream}}), 500*time.Millisecond))
+	defer srv.Close()
+	url := "ws" + strings.TrimPrefix(srv.URL, "http") + EventsWebsocketPath
+	conn, _, err := websocket.DefaultDialer.Dial(url, nil)
+	assert.Nil(t, err)
+	defer conn.Close()
+	// a single subscriber is allowed
+	_, resp, err := websocket.DefaultDialer.Dial(url, nil)
+	assert.Equal(t, websocket.ErrBadHandshake, err)
+	if assert.NotNil(t, resp) {
+		assert.Equal(t, http.StatusServiceUnavailable, resp.StatusCode)
+	}
+	// the subscription isn't sent within the timeout
+	_ = conn.SetReadDeadline(time.Now().Add(5 * time.Second))
+	var msg StreamedMessage
+	assert.Nil(t, conn.ReadJSON(&msg))
+	assert.Equal(t, StreamedError, msg.Type)
+	assert.Contains(t, msg.Error, "invalid subscription")
+	_, _, err = conn.ReadMessage()
+	assert.NotNil(t, err)
+	// the subscriber went away so another one is allowed
+	assert.Eventually(t, func() bool {
+		conn2, _, err := websocket.DefaultDialer.Dial(url, nil)
+		if err != nil {
+			return false
+		}
+		_ = conn2.Close()
+		return true
+	}, 5*time.Second, 50*time.Millisecond)
+	// the other routes are still served within the timeout
+	res, err := http.Get(srv.URL + "/v1")
+	assert.Nil(t, err)
+	if assert.NotNil(t, res) {
+		assert.Equal(t, http.StatusNotFound, res.StatusCode)
+		_ = res.Body.Close()
+	}
+}
+
 func TestRPC_QueryNodeClaims(t *testing.T) {
 	codec.UpgradeHeight = 7000
 	_, _, cleanup := NewInMemoryTendermintNode(t, oneValTwoNodeGenesisState())
@@ -1268,6 +1674,18 @@ func newCORSRequest(query string, body io.Reader) *http.Request {
 	return req
 }
 
+func TestPopModelWithLimit(t *testing.T) {
+	var relay pocketTypes.Relay
+	body := `{"payload":{"data":"foo"}}`
+	assert.Nil(t, PopModelWithLimit(nil, newClientRequest("relay", strings.NewReader(body)), nil, &relay, int64(len(body))))
+	assert.Equal(t, "foo", relay.Payload.Data)
+	err := PopModelWithLimit(nil, newClientRequest("relay", strings.NewReader(body)), nil, &relay, int64(len(body)-1))
+	assert.NotNil(t, err)
+	assert.Equal(t, pocketTypes.NewRequestTooLargeError(pocketTypes.ModuleName).Error(), err.Error())
+	// an empty body leaves the model untouched
+	assert.Nil(t, PopModelWithLimit(nil, newClientRequest("relay", strings.NewReader("")), nil, &relay, 0))
+}
+
 func newClientRequest(query string, body io.Reader) *http.Request {
 	req, err := http.NewRequest("POST", "localhost:8081/v1/client/"+query, body)
 	if err != nil {
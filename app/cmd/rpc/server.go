@@ -1,19 +1,23 @@
 package rpc
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
 	"io/ioutil"
 	"log"
+	"math"
 	"net/http"
 	"net/http/pprof"
 	"runtime"
 	"runtime/debug"
 	"time"
 
+	"github.com/gorilla/websocket"
 	"github.com/julienschmidt/httprouter"
 	"github.com/pokt-network/pocket-core/app"
+	"github.com/pokt-network/pocket-core/x/pocketcore/types"
 )
 
 var APIVersion = app.AppVersion
@@ -55,11 +59,27 @@ func StartRPC(port string, timeout int64, simulation, debug, allBlockTxs, hotRel
 		ReadHeaderTimeout: 20 * time.Second,
 		WriteTimeout:      60 * time.Second,
 		Addr:              ":" + port,
-		Handler:           http.TimeoutHandler(Router(routes), time.Duration(timeout)*time.Millisecond, "Server Timeout Handling Request"),
+		Handler:           TimeoutHandler(Router(routes), time.Duration(timeout)*time.Millisecond),
 	}
 	log.Fatal(srv.ListenAndServe())
 }
 
+// TimeoutHandler serves the routes within the timeout. Websocket connections are long lived and must be hijacked, which
+// http.TimeoutHandler does not support, so a websocket route is given the timeout as the deadline of the request
+// context instead, to upgrade the connection and read what it needs from the client
+func TimeoutHandler(h http.Handler, timeout time.Duration) http.Handler {
+	timeoutHandler := http.TimeoutHandler(h, timeout, "Server Timeout Handling Request")
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !websocket.IsWebSocketUpgrade(r) {
+			timeoutHandler.ServeHTTP(w, r)
+			return
+		}
+		ctx, cancel := context.WithTimeout(r.Context(), timeout)
+		defer cancel()
+		h.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
+
 func Router(routes Routes) *httprouter.Router {
 	router := httprouter.New()
 	for _, route := range routes {
@@ -92,14 +112,18 @@ func GetRoutes() Routes {
 		Route{Name: "HandleDispatch", Method: "POST", Path: "/v1/client/dispatch", HandlerFunc: Dispatch},
 		Route{Name: "HandleDispatchCORS", Method: "OPTIONS", Path: "/v1/client/dispatch", HandlerFunc: Dispatch},
 		Route{Name: "SendRawTx", Method: "POST", Path: "/v1/client/rawtx", HandlerFunc: SendRawTx},
+		Route{Name: "SimulateTx", Method: "POST", Path: "/v1/client/simulate", HandlerFunc: SimulateTx},
 		Route{Name: "Service", Method: "POST", Path: "/v1/client/relay", HandlerFunc: Relay},
 		Route{Name: "Stop", Method: "POST", Path: "/v1/private/stop", HandlerFunc: Stop},
 		Route{Name: "ServiceCORS", Method: "OPTIONS", Path: "/v1/client/relay", HandlerFunc: Relay},
+		Route{Name: "WebsocketService", Method: "GET", Path: WebsocketRelayPath, HandlerFunc: WebsocketRelay},
+		Route{Name: "EventsStream", Method: "GET", Path: EventsWebsocketPath, HandlerFunc: EventsStream},
 		Route{Name: "QueryAccount", Method: "POST", Path: "/v1/query/account", HandlerFunc: Account},
 		Route{Name: "QueryAccounts", Method: "POST", Path: "/v1/query/accounts", HandlerFunc: Accounts},
 		Route{Name: "QueryAccountTxs", Method: "POST", Path: "/v1/query/accounttxs", HandlerFunc: AccountTxs},
 		Route{Name: "QueryACL", Method: "POST", Path: "/v1/query/acl", HandlerFunc: ACL},
 		Route{Name: "QueryAllParams", Method: "POST", Path: "/v1/query/allparams", HandlerFunc: AllParams},
+		Route{Name: "QueryAllowances", Method: "POST", Path: "/v1/query/allowances", HandlerFunc: Allowances},
 		Route{Name: "QueryApp", Method: "POST", Path: "/v1/query/app", HandlerFunc: App},
 		Route{Name: "QueryAppParams", Method: "POST", Path: "/v1/query/appparams", HandlerFunc: AppParams},
 		Route{Name: "QueryApps", Method: "POST", Path: "/v1/query/apps", HandlerFunc: Apps},
@@ -107,6 +131,7 @@ func GetRoutes() Routes {
 		Route{Name: "QueryBlock", Method: "POST", Path: "/v1/query/block", HandlerFunc: Block},
 		Route{Name: "QueryBlockTxs", Method: "POST", Path: "/v1/query/blocktxs", HandlerFunc: BlockTxs},
 		Route{Name: "QueryDAOOwner", Method: "POST", Path: "/v1/query/daoowner", HandlerFunc: DAOOwner},
+		Route{Name: "QueryEarnings", Method: "POST", Path: "/v1/query/earnings", HandlerFunc: Earnings},
 		Route{Name: "QueryHeight", Method: "POST", Path: "/v1/query/height", HandlerFunc: Height},
 		Route{Name: "QueryNode", Method: "POST", Path: "/v1/query/node", HandlerFunc: Node},
 		Route{Name: "QueryNodeClaim", Method: "POST", Path: "/v1/query/nodeclaim", HandlerFunc: NodeClaim},
@@ -115,13 +140,21 @@ func GetRoutes() Routes {
 		Route{Name: "QueryNodes", Method: "POST", Path: "/v1/query/nodes", HandlerFunc: Nodes},
 		Route{Name: "QueryParam", Method: "POST", Path: "/v1/query/param", HandlerFunc: Param},
 		Route{Name: "QueryPocketParams", Method: "POST", Path: "/v1/query/pocketparams", HandlerFunc: PocketParams},
+		Route{Name: "QueryProposals", Method: "POST", Path: "/v1/query/proposals", HandlerFunc: Proposals},
+		Route{Name: "QueryScheduledParams", Method: "POST", Path: "/v1/query/scheduledparams", HandlerFunc: ScheduledParams},
+		Route{Name: "QuerySimulateStake", Method: "POST", Path: "/v1/query/simulatestake", HandlerFunc: SimulateStake},
 		Route{Name: "QueryState", Method: "POST", Path: "/v1/query/state", HandlerFunc: State},
 		Route{Name: "QuerySupply", Method: "POST", Path: "/v1/query/supply", HandlerFunc: Supply},
 		Route{Name: "QuerySupportedChains", Method: "POST", Path: "/v1/query/supportedchains", HandlerFunc: SupportedChains},
 		Route{Name: "QueryTX", Method: "POST", Path: "/v1/query/tx", HandlerFunc: Tx},
 		Route{Name: "QueryUpgrade", Method: "POST", Path: "/v1/query/upgrade", HandlerFunc: Upgrade},
+		Route{Name: "QueryVotes", Method: "POST", Path: "/v1/query/votes", HandlerFunc: Votes},
 		Route{Name: "QuerySigningInfo", Method: "POST", Path: "/v1/query/signinginfo", HandlerFunc: SigningInfo},
 		Route{Name: "QueryChains", Method: "POST", Path: "/v1/private/chains", HandlerFunc: Chains},
+		Route{Name: "QueryRelayLedger", Method: "POST", Path: "/v1/private/ledger", HandlerFunc: RelayLedger},
+		Route{Name: "QuerySubmissions", Method: "POST", Path: "/v1/private/submissions", HandlerFunc: Submissions},
+		Route{Name: "ExportEvidence", Method: "POST", Path: "/v1/private/evidence/export", HandlerFunc: ExportEvidence},
+		Route{Name: "ImportEvidence", Method: "POST", Path: "/v1/private/evidence/import", HandlerFunc: ImportEvidence},
 	}
 	return routes
 }
@@ -229,6 +262,23 @@ func PopModel(_ http.ResponseWriter, r *http.Request, _ httprouter.Params, model
 	return nil
 }
 
+// "PopModelWithLimit" - Decodes the body into the model while streaming, failing once more than max bytes are read (max <= 0 is no limit)
+func PopModelWithLimit(_ http.ResponseWriter, r *http.Request, _ httprouter.Params, model interface{}, max int64) error {
+	defer r.Body.Close()
+	body := &io.LimitedReader{R: r.Body, N: max + 1}
+	if max <= 0 {
+		body.N = math.MaxInt64
+	}
+	err := json.NewDecoder(body).Decode(model)
+	if body.N <= 0 {
+		return types.NewRequestTooLargeError(types.ModuleName)
+	}
+	if err == io.EOF {
+		return nil
+	}
+	return err
+}
+
 func wrapperHandlerFunc(f func(http.ResponseWriter, *http.Request)) httprouter.Handle {
 	return func(w http.ResponseWriter, r *http.Request, _ httprouter.Params) {
 		f(w, r)
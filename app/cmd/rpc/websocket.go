@@ -0,0 +1,203 @@
+package rpc
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync"
+
+	"github.com/gorilla/websocket"
+	"github.com/julienschmidt/httprouter"
+	"github.com/pokt-network/pocket-core/app"
+	"github.com/pokt-network/pocket-core/x/pocketcore/types"
+)
+
+const WebsocketRelayPath = "/v1/client/relay/ws"
+
+var upgrader = websocket.Upgrader{
+	CheckOrigin: func(r *http.Request) bool { return true },
+}
+
+// WebsocketRelay upgrades the connection and relays streaming requests to the websocket of the hosted chain.
+// Every relay sent by the client is validated and stored like an http relay; messages pushed by the hosted
+// chain must be acknowledged by the client with a WS_ACK relay so the work is included in the evidence.
+func WebsocketRelay(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	conn, err := upgrader.Upgrade(w, r, nil)
+	if err != nil {
+		// the upgrader already replied with an http error
+		return
+	}
+	// the limit of the chain is applied to the payload once the relay is decoded
+	conn.SetReadLimit(app.PCA.GetHostedBlockchains().MaxRequestLimit())
+	s := &websocketRelaySession{client: conn, ip: sourceIP(r), unacked: make(map[string]bool)}
+	s.serve()
+}
+
+// "websocketRelaySession" - the state of a single client websocket connection
+type websocketRelaySession struct {
+	client   *websocket.Conn
+	backend  *websocket.Conn
+	ip       string           // the source ip of the client, for the rate limits
+	chain    string           // the chain the backend connection is opened for
+	proof    types.RelayProof // the latest proof received from the client
+	pending  int              // relays forwarded to the backend that are awaiting a response
+	unacked  map[string]bool  // hashes of the streamed messages awaiting acknowledgement
+	l        sync.Mutex       // guards the fields above and writes to the client
+	closeOne sync.Once
+}
+
+func (s *websocketRelaySession) serve() {
+	defer s.close()
+	for {
+		_, msg, err := s.client.ReadMessage()
+		if err != nil {
+			return
+		}
+		var relay types.Relay
+		if err := json.Unmarshal(msg, &relay); err != nil {
+			s.writeError(err, nil)
+			continue
+		}
+		if err := s.handleRelay(relay); err != nil {
+			return
+		}
+	}
+}
+
+// "handleRelay" - validates the relay and either forwards it to the backend or consumes it as an acknowledgement
+func (s *websocketRelaySession) handleRelay(relay types.Relay) error {
+	ack := relay.IsWebsocketAck()
+	// an acknowledgement only answers a message streamed from the chain of the connection
+	if (ack || s.backend != nil) && relay.Proof.Blockchain != s.chain {
+		s.writeError(types.NewWebsocketExecutionError(types.ModuleName, fmt.Errorf("the websocket connection is opened for chain %s", s.chain)), nil)
+		return nil
+	}
+	// the app and the client are limited once the relay is validated
+	if err := types.CheckIPRateLimit(relay.Proof.Blockchain, s.ip); err != nil {
+		s.writeError(err, nil)
+		return nil
+	}
+	if ack {
+		// the payload data of an acknowledgement is the hex hash of the signed streamed response
+		s.l.Lock()
+		_, ok := s.unacked[relay.Payload.Data]
+		s.l.Unlock()
+		if !ok {
+			s.writeError(types.NewWebsocketExecutionError(types.ModuleName, fmt.Errorf("no streamed message awaiting acknowledgement with hash %s", relay.Payload.Data)), nil)
+			return nil
+		}
+		if dispatch, err := app.PCA.HandleWebsocketRelay(relay); err != nil {
+			s.writeError(err, dispatch)
+			return nil
+		}
+		s.l.Lock()
+		delete(s.unacked, relay.Payload.Data)
+		s.l.Unlock()
+		return nil
+	}
+	// the relay is validated before the backend is dialed, so an invalid relay can't open connections to the chain
+	if dispatch, err := app.PCA.HandleWebsocketRelay(relay); err != nil {
+		s.writeError(err, dispatch)
+		return nil
+	}
+	// open the backend connection on the first request
+	if s.backend == nil {
+		conn, err := types.DialWebsocket(app.PCA.GetHostedBlockchains(), relay.Proof.Blockchain, relay.Proof.Token.ApplicationPublicKey)
+		if err != nil {
+			s.writeError(err, nil)
+			return err
+		}
+		s.l.Lock()
+		s.backend = conn
+		s.chain = relay.Proof.Blockchain
+		s.l.Unlock()
+		go s.readBackend()
+	}
+	s.l.Lock()
+	s.proof = relay.Proof
+	s.pending++
+	s.l.Unlock()
+	if err := s.backend.WriteMessage(websocket.TextMessage, []byte(relay.Payload.Data)); err != nil {
+		er := types.NewWebsocketExecutionError(types.ModuleName, err)
+		types.GlobalServiceMetric().AddErrorFor(s.chain, relay.Proof.Token.ApplicationPublicKey, er)
+		s.writeError(er, nil)
+		return err
+	}
+	return nil
+}
+
+// "readBackend" - signs every message of the backend and forwards it to the client
+func (s *websocketRelaySession) readBackend() {
+	defer s.close()
+	for {
+		_, msg, err := s.backend.ReadMessage()
+		if err == websocket.ErrReadLimit {
+			er := types.NewResponseTooLargeError(types.ModuleName)
+			s.l.Lock()
+			appPubKey := s.proof.Token.ApplicationPublicKey
+			s.l.Unlock()
+			types.GlobalServiceMetric().AddErrorFor(s.chain, appPubKey, er)
+			s.writeError(er, nil)
+			return
+		}
+		if err != nil {
+			return
+		}
+		s.l.Lock()
+		streamed := s.pending == 0
+		if streamed && len(s.unacked) >= types.GlobalPocketConfig.MaxUnackedStreamMessages {
+			s.l.Unlock()
+			s.writeError(types.NewUnackedStreamLimitError(types.ModuleName), nil)
+			return
+		}
+		if !streamed {
+			s.pending--
+		}
+		resp := types.RelayResponse{
+			Response: string(msg),
+			Proof:    s.proof,
+		}
+		s.l.Unlock()
+		if err := app.PCA.SignRelayResponse(&resp); err != nil {
+			s.writeError(err, nil)
+			return
+		}
+		if streamed {
+			s.l.Lock()
+			s.unacked[resp.HashString()] = true
+			s.l.Unlock()
+		}
+		s.write(types.WebsocketRelayResponse{
+			Signature:   resp.Signature,
+			Response:    resp.Response,
+			RequestHash: resp.Proof.RequestHash,
+			Streamed:    streamed,
+		})
+	}
+}
+
+func (s *websocketRelaySession) writeError(err error, dispatch *types.DispatchResponse) {
+	s.write(RPCRelayErrorResponse{
+		Error:    err,
+		Dispatch: dispatch,
+	})
+}
+
+func (s *websocketRelaySession) write(v interface{}) {
+	s.l.Lock()
+	defer s.l.Unlock()
+	if err := s.client.WriteJSON(v); err != nil {
+		fmt.Println(fmt.Errorf("error in RPC Handler WebsocketRelay: %v", err))
+	}
+}
+
+func (s *websocketRelaySession) close() {
+	s.closeOne.Do(func() {
+		_ = s.client.Close()
+		s.l.Lock()
+		defer s.l.Unlock()
+		if s.backend != nil {
+			_ = s.backend.Close()
+		}
+	})
+}
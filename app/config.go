@@ -44,6 +44,7 @@ import (
 	"github.com/tendermint/tendermint/rpc/client"
 	"github.com/tendermint/tendermint/rpc/client/http"
 	"github.com/tendermint/tendermint/rpc/client/local"
+	tmtypes "github.com/tendermint/tendermint/types"
 	dbm "github.com/tendermint/tm-db"
 	"golang.org/x/crypto/ssh/terminal"
 )
@@ -80,6 +81,8 @@ func InitApp(datadir, tmNode, persistentPeers, seeds, remoteCLIURL string, keyba
 	InitAuthToken(GlobalConfig.PocketConfig.GenerateTokenOnStart)
 	// init the keyfiles
 	InitKeyfiles()
+	// load the additional servicer identities
+	InitServicerKeyfiles()
 	// get hosted blockchains
 	chains := NewHostedChains(false)
 	if GlobalConfig.PocketConfig.ChainsHotReload {
@@ -90,6 +93,12 @@ func InitApp(datadir, tmNode, persistentPeers, seeds, remoteCLIURL string, keyba
 	logger := InitLogger()
 	// init cache
 	InitPocketCoreConfig(chains, logger)
+	if GlobalConfig.PocketConfig.EndpointHealthCheckMs > 0 {
+		// probe the endpoints of the hosted chains
+		types.StartEndpointHealthChecks(chains, GlobalConfig.PocketConfig.EndpointHealthCheckMs)
+	}
+	// probe the sync of the hosted chains with a sync check
+	types.StartChainSyncChecks(chains)
 	// init genesis
 	InitGenesis(genesisType, logger)
 	// log the config and chains
@@ -370,6 +379,80 @@ func InitKeyfiles() {
 	}
 }
 
+// InitServicerKeyfiles loads the additional servicer keys listed in the servicer key file list, a json array of
+// private validator key file paths (relative to the data directory unless absolute)
+func InitServicerKeyfiles() {
+	listPath := GlobalConfig.PocketConfig.ServicerKeyFileList
+	if listPath == "" {
+		return
+	}
+	datadir := GlobalConfig.PocketConfig.DataDir
+	if !fp.IsAbs(listPath) {
+		listPath = datadir + FS + listPath
+	}
+	bz, err := ioutil.ReadFile(listPath)
+	if err != nil {
+		log2.Fatal(err)
+	}
+	var paths []string
+	if err := json.Unmarshal(bz, &paths); err != nil {
+		log2.Fatal(fmt.Sprintf("invalid servicer key file list %s: %s", listPath, err.Error()))
+	}
+	files := make([]privval.FilePVKey, 0, len(paths))
+	for _, path := range paths {
+		if !fp.IsAbs(path) {
+			path = datadir + FS + path
+		}
+		file, err := readPKFromFile(path)
+		if err != nil {
+			log2.Fatal(fmt.Sprintf("invalid servicer key file %s: %s", path, err.Error()))
+		}
+		files = append(files, file)
+	}
+	if err := types.InitServicerKeyFiles(files); err != nil {
+		log2.Fatal(err)
+	}
+	log2.Printf("Hosting %d additional servicers", len(files))
+}
+
+// ExportEvidenceOffline reads the evidence of the servicers hosted by the node (or of a single servicer) from the evidence
+// databases, the node must be stopped. The session cache is in memory and the height is unknown, so neither is exported
+func ExportEvidenceOffline(servicer string) (types.EvidenceSnapshot, error) {
+	datadir := GlobalConfig.PocketConfig.DataDir
+	pvKeyPath := datadir + FS + GlobalConfig.TendermintConfig.PrivValidatorKey
+	file, err := readPKFromFile(pvKeyPath)
+	if err != nil {
+		return types.EvidenceSnapshot{}, err
+	}
+	types.InitPVKeyFile(file)
+	InitServicerKeyfiles()
+	genesisPath := datadir + FS + sdk.ConfigDirName + FS + GlobalConfig.PocketConfig.GenesisName
+	genDoc, err := tmtypes.GenesisDocFromFile(genesisPath)
+	if err != nil {
+		return types.EvidenceSnapshot{}, err
+	}
+	types.InitConfig(nil, log.NewNopLogger(), GlobalConfig)
+	servicers := []sdk.Address{sdk.Address(file.Address)}
+	for _, pk := range types.GetServicerKeys() {
+		servicers = append(servicers, sdk.Address(pk.PublicKey().Address()))
+	}
+	if servicer != "" {
+		addr, err := sdk.AddressFromHex(servicer)
+		if err != nil {
+			return types.EvidenceSnapshot{}, err
+		}
+		hosted := false
+		for _, s := range servicers {
+			hosted = hosted || s.Equals(addr)
+		}
+		if !hosted {
+			return types.EvidenceSnapshot{}, types.NewForeignServicerError(types.ModuleName)
+		}
+		servicers = []sdk.Address{addr}
+	}
+	return types.NewEvidenceSnapshot(genDoc.ChainID, 0, servicers)
+}
+
 func InitLogger() (logger log.Logger) {
 	logger = log.NewTMLoggerWithColorFn(log.NewSyncWriter(os.Stdout), func(keyvals ...interface{}) term.FgBgColor {
 		if keyvals[0] != kitlevel.Key() {
@@ -433,17 +516,28 @@ func GetKeybase() (kb.Keybase, error) {
 }
 
 func loadPKFromFile(path string) (privval.FilePVKey, string) {
-	keyJSONBytes, err := ioutil.ReadFile(path)
+	pvKey, err := readPKFromFile(path)
 	if err != nil {
 		cmn.Exit(err.Error())
 	}
+	return pvKey, path
+}
+
+// "readPKFromFile" - Reads a private key file, the key must be set
+func readPKFromFile(path string) (privval.FilePVKey, error) {
+	keyJSONBytes, err := ioutil.ReadFile(path)
+	if err != nil {
+		return privval.FilePVKey{}, err
+	}
 	pvKey := privval.FilePVKey{}
 	err = cdc.UnmarshalJSON(keyJSONBytes, &pvKey)
 	if err != nil {
-		cmn.Exit(fmt.Sprintf("Error reading PrivValidator key from %v: %v\n", path, err))
+		return privval.FilePVKey{}, fmt.Errorf("error reading PrivValidator key from %v: %v", path, err)
 	}
-
-	return pvKey, path
+	if pvKey.PrivKey == nil {
+		return privval.FilePVKey{}, fmt.Errorf("no private key in %v", path)
+	}
+	return pvKey, nil
 }
 
 func privValKey(res crypto.PrivateKey) {
@@ -551,6 +645,11 @@ func HotReloadChains(chains *types.HostedBlockchains) {
 				}
 				m[chain.ID] = chain
 			}
+			// an invalid config is rejected and the current chains are kept
+			if err := (&types.HostedBlockchains{M: m}).Validate(); err != nil {
+				log2.Println(NewInvalidChainsError(err))
+				continue
+			}
 			chains.L.Lock()
 			chains.M = m
 			chains.L.Unlock()
@@ -601,11 +700,15 @@ func NewHostedChains(generate bool) *types.HostedBlockchains {
 		}
 		m[chain.ID] = chain
 	}
-	// return the map
-	return &types.HostedBlockchains{
+	hostedChains := &types.HostedBlockchains{
 		M: m,
 		L: sync.Mutex{},
 	}
+	if err := hostedChains.Validate(); err != nil {
+		log2.Fatal(NewInvalidChainsError(err))
+	}
+	// return the map
+	return hostedChains
 }
 
 func generateChainsJson(chainsPath string) *types.HostedBlockchains {
@@ -639,8 +742,12 @@ func generateChainsJson(chainsPath string) *types.HostedBlockchains {
 		}
 		m[chain.ID] = chain
 	}
+	hostedChains := &types.HostedBlockchains{M: m, L: sync.Mutex{}}
+	if err := hostedChains.Validate(); err != nil {
+		log2.Fatal(NewInvalidChainsError(err))
+	}
 	// return the map
-	return &types.HostedBlockchains{M: m, L: sync.Mutex{}}
+	return hostedChains
 }
 
 const (
@@ -0,0 +1,58 @@
+package app
+
+import (
+	"context"
+	"fmt"
+
+	core_types "github.com/tendermint/tendermint/rpc/core/types"
+	tmtypes "github.com/tendermint/tendermint/types"
+)
+
+// QueryBlockResults - Returns the block at the height and the results of its execution (begin block, txs, end block)
+func (app PocketCoreApp) QueryBlockResults(height int64) (*core_types.ResultBlock, *core_types.ResultBlockResults, error) {
+	tmClient := app.GetClient()
+	b, err := tmClient.Block(&height)
+	if err != nil {
+		return nil, nil, err
+	}
+	res, err := tmClient.BlockResults(&height)
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(res.TxsResults) != len(b.Block.Txs) {
+		return nil, nil, fmt.Errorf("the results of the block at height %d don't match its %d txs", height, len(b.Block.Txs))
+	}
+	return b, res, nil
+}
+
+// SubscribeNewBlocks - Notifies the subscriber of every new block until the context is done. The notifications don't
+// queue up, a subscriber that is behind gets a single notification and must catch up with the latest height
+func (app PocketCoreApp) SubscribeNewBlocks(ctx context.Context, subscriber string) (<-chan struct{}, error) {
+	tmNode := app.TMNode()
+	if tmNode == nil {
+		return nil, fmt.Errorf("the tendermint node is not running")
+	}
+	sub, err := tmNode.EventBus().Subscribe(ctx, subscriber, tmtypes.EventQueryNewBlock)
+	if err != nil {
+		return nil, err
+	}
+	notify := make(chan struct{}, 1)
+	go func() {
+		defer func() { _ = tmNode.EventBus().UnsubscribeAll(context.Background(), subscriber) }()
+		for {
+			select {
+			case <-sub.Out():
+				select {
+				case notify <- struct{}{}:
+				default:
+				}
+			case <-sub.Cancelled():
+				close(notify)
+				return
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return notify, nil
+}
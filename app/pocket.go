@@ -89,9 +89,17 @@ func (app *PocketCoreApp) InitChainerWithGenesis(ctx sdk.Ctx, req abci.RequestIn
 	return app.mm.InitGenesis(ctx, GenState)
 }
 
-// setups all of the begin blockers for each module
+// setups all of the begin blockers for each module, the param changes scheduled at the height are applied first so they
+// apply to every module in the same block
 func (app *PocketCoreApp) BeginBlocker(ctx sdk.Ctx, req abci.RequestBeginBlock) abci.ResponseBeginBlock {
-	return app.mm.BeginBlock(ctx, req)
+	if !app.govKeeper.GetCodec().IsAfterNamedFeatureActivationHeight(ctx.BlockHeight(), codec.ScheduledParamChangeKey) {
+		return app.mm.BeginBlock(ctx, req)
+	}
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	app.govKeeper.ApplyScheduledParamChanges(ctx)
+	res := app.mm.BeginBlock(ctx, req)
+	res.Events = append(ctx.EventManager().ABCIEvents(), res.Events...)
+	return res
 }
 
 // setups all of the end blockers for each module
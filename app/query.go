@@ -9,6 +9,7 @@ import (
 	"reflect"
 	"strconv"
 
+	"github.com/pokt-network/pocket-core/crypto"
 	sdk "github.com/pokt-network/pocket-core/types"
 	appsTypes "github.com/pokt-network/pocket-core/x/apps/types"
 	"github.com/pokt-network/pocket-core/x/auth/exported"
@@ -168,6 +169,29 @@ func (app PocketCoreApp) QueryAccounts(height int64, page, perPage int) (res Pag
 	return paginate(page, perPage, accs, 10000)
 }
 
+func (app PocketCoreApp) QueryFeeAllowances(granter, grantee string, height int64, page, perPage int) (res Page, err error) {
+	var granterAddr, granteeAddr sdk.Address
+	if granter != "" {
+		granterAddr, err = sdk.AddressFromHex(granter)
+		if err != nil {
+			return Page{}, err
+		}
+	}
+	if grantee != "" {
+		granteeAddr, err = sdk.AddressFromHex(grantee)
+		if err != nil {
+			return Page{}, err
+		}
+	}
+	ctx, err := app.NewContext(height)
+	if err != nil {
+		return
+	}
+	page, perPage = checkPagination(page, perPage)
+	allowances := app.accountKeeper.GetActiveFeeAllowances(ctx, granterAddr, granteeAddr)
+	return paginate(page, perPage, allowances, 10000)
+}
+
 func (app PocketCoreApp) QueryNodes(height int64, opts nodesTypes.QueryValidatorsParams) (res Page, err error) {
 	ctx, err := app.NewContext(height)
 	if err != nil {
@@ -202,12 +226,62 @@ func (app PocketCoreApp) QueryNodeParams(height int64) (res nodesTypes.Params, e
 	return app.nodesKeeper.GetParams(ctx), nil
 }
 
-func (app PocketCoreApp) QueryHostedChains() (res map[string]pocketTypes.HostedBlockchain, err error) {
-	return app.pocketKeeper.GetHostedBlockchains().M, nil
+func (app PocketCoreApp) QueryHostedChains() (res map[string]pocketTypes.HostedBlockchainStatus, err error) {
+	hostedBlockchains := app.pocketKeeper.GetHostedBlockchains()
+	hostedBlockchains.L.Lock()
+	defer hostedBlockchains.L.Unlock()
+	res = make(map[string]pocketTypes.HostedBlockchainStatus, len(hostedBlockchains.M))
+	for id, chain := range hostedBlockchains.M {
+		res[id] = pocketTypes.HostedBlockchainStatus{
+			HostedBlockchain: chain,
+			EndpointStates:   pocketTypes.GetEndpointStates(chain),
+			SyncState:        pocketTypes.GetChainSyncState(id),
+		}
+	}
+	return res, nil
+}
+
+func (app PocketCoreApp) QueryRelayLedger(filter pocketTypes.LedgerFilter) ([]pocketTypes.LedgerEntry, error) {
+	return pocketTypes.QueryRelayLedger(filter)
+}
+
+func (app PocketCoreApp) QuerySubmissions() []pocketTypes.Submission {
+	return pocketTypes.GetSubmissions()
+}
+
+func (app PocketCoreApp) ExportEvidence(servicer string) (res pocketTypes.EvidenceSnapshot, err error) {
+	var addr sdk.Address
+	if servicer != "" {
+		addr, err = sdk.AddressFromHex(servicer)
+		if err != nil {
+			return
+		}
+	}
+	ctx, err := app.NewContext(app.LastBlockHeight())
+	if err != nil {
+		return
+	}
+	return app.pocketKeeper.ExportEvidenceSnapshot(ctx, addr)
+}
+
+func (app PocketCoreApp) ImportEvidence(snapshot pocketTypes.EvidenceSnapshot) (res pocketTypes.EvidenceImportResult, err error) {
+	ctx, err := app.NewContext(app.LastBlockHeight())
+	if err != nil {
+		return
+	}
+	return app.pocketKeeper.ImportEvidenceSnapshot(ctx, snapshot)
+}
+
+func (app PocketCoreApp) GetHostedBlockchains() *pocketTypes.HostedBlockchains {
+	return app.pocketKeeper.GetHostedBlockchains()
 }
 
 func (app PocketCoreApp) SetHostedChains(req map[string]pocketTypes.HostedBlockchain) (res map[string]pocketTypes.HostedBlockchain, err error) {
-	return app.pocketKeeper.SetHostedBlockchains(req).M, nil
+	chains, err := app.pocketKeeper.SetHostedBlockchains(req)
+	if err != nil {
+		return nil, err
+	}
+	return chains.M, nil
 }
 
 func (app PocketCoreApp) QuerySigningInfo(height int64, addr string) (res nodesTypes.ValidatorSigningInfo, err error) {
@@ -295,6 +369,35 @@ func (app PocketCoreApp) QueryACL(height int64) (res types.ACL, err error) {
 	return app.govKeeper.GetACL(ctx), nil
 }
 
+// QueryProposals returns the proposals with the status, or every proposal if the status is empty
+func (app PocketCoreApp) QueryProposals(height int64, status string) (res []types.Proposal, err error) {
+	ctx, err := app.NewContext(height)
+	if err != nil {
+		return
+	}
+	return app.govKeeper.GetProposals(ctx, status), nil
+}
+
+func (app PocketCoreApp) QueryVotes(height int64, proposalID int64) (res []types.Vote, err error) {
+	ctx, err := app.NewContext(height)
+	if err != nil {
+		return
+	}
+	if _, found := app.govKeeper.GetProposal(ctx, proposalID); !found {
+		return nil, types.ErrProposalNotFound(types.ModuleName, proposalID)
+	}
+	return app.govKeeper.GetVotes(ctx, proposalID), nil
+}
+
+// QueryScheduledParamChanges returns the param changes that are not applied yet, sorted by height
+func (app PocketCoreApp) QueryScheduledParamChanges(height int64) (res []types.ScheduledParamChange, err error) {
+	ctx, err := app.NewContext(height)
+	if err != nil {
+		return
+	}
+	return app.govKeeper.GetScheduledParamChanges(ctx), nil
+}
+
 type AllParamsReturn struct {
 	AppParams    []SingleParamReturn `json:"app_params"`
 	NodeParams   []SingleParamReturn `json:"node_params"`
@@ -495,6 +598,58 @@ func (app PocketCoreApp) QueryClaims(address string, height int64, page, perPage
 	return p, nil
 }
 
+func (app PocketCoreApp) QueryEarnings(address string, stake, relays int64, height int64) (res pocketTypes.EarningsEstimate, err error) {
+	var a sdk.Address
+	if address != "" {
+		a, err = sdk.AddressFromHex(address)
+		if err != nil {
+			return
+		}
+	}
+	ctx, err := app.NewContext(height)
+	if err != nil {
+		return
+	}
+	res, er := app.pocketKeeper.EstimateEarnings(ctx, a, sdk.NewInt(stake), sdk.NewInt(relays))
+	if er != nil {
+		return res, er
+	}
+	return res, nil
+}
+
+// SimulateStake - Runs a stake (or edit stake) message of the operator against the world state without a signature. An
+// empty output stakes the node custodially, an empty signer signs with the output address (or the operator address)
+func (app PocketCoreApp) SimulateStake(operatorPubKey string, chains []string, serviceURL string, amount sdk.BigInt, output, signer string, height int64) (res nodesTypes.StakeSimulation, err error) {
+	pk, err := crypto.NewPublicKey(operatorPubKey)
+	if err != nil {
+		return
+	}
+	ctx, err := app.NewContext(height)
+	if err != nil {
+		return
+	}
+	operator := sdk.Address(pk.Address())
+	msg := nodesTypes.MsgStake{PublicKey: pk, Chains: chains, Value: amount, ServiceUrl: serviceURL}
+	if output != "" {
+		msg.Output, err = sdk.AddressFromHex(output)
+		if err != nil {
+			return
+		}
+	} else if app.cdc.IsAfterNonCustodialUpgrade(ctx.BlockHeight()) {
+		msg.Output = operator
+	}
+	s := operator
+	if signer != "" {
+		s, err = sdk.AddressFromHex(signer)
+		if err != nil {
+			return
+		}
+	} else if msg.Output != nil {
+		s = msg.Output
+	}
+	return app.nodesKeeper.SimulateStake(ctx, msg, s), nil
+}
+
 func (app PocketCoreApp) QueryPocketParams(height int64) (res pocketTypes.Params, err error) {
 	ctx, err := app.NewContext(height)
 	if err != nil {
@@ -544,6 +699,39 @@ func (app PocketCoreApp) HandleRelay(r pocketTypes.Relay) (res *pocketTypes.Rela
 	return
 }
 
+func (app PocketCoreApp) HandleWebsocketRelay(r pocketTypes.Relay) (dispatch *pocketTypes.DispatchResponse, err error) {
+	ctx, err := app.NewContext(app.LastBlockHeight())
+	if err != nil {
+		return nil, err
+	}
+	status, err := app.pocketKeeper.TmNode.Status()
+	if err != nil {
+		return nil, fmt.Errorf("pocket node is unable to retrieve status from tendermint node, cannot service in this state")
+	}
+	if status.SyncInfo.CatchingUp {
+		return nil, fmt.Errorf("pocket node is currently syncing to the blockchain, cannot service in this state")
+	}
+	er := app.pocketKeeper.HandleWebsocketRelay(ctx, r)
+	if er != nil {
+		err = er
+		if pocketTypes.ErrorWarrantsDispatch(er) {
+			dispatch, _ = app.HandleDispatch(r.Proof.SessionHeader())
+		}
+	}
+	return
+}
+
+func (app PocketCoreApp) SignRelayResponse(resp *pocketTypes.RelayResponse) error {
+	ctx, err := app.NewContext(app.LastBlockHeight())
+	if err != nil {
+		return err
+	}
+	if er := app.pocketKeeper.SignRelayResponse(ctx, resp); er != nil {
+		return er
+	}
+	return nil
+}
+
 func checkPagination(page, limit int) (int, int) {
 	if page <= 0 {
 		page = 1
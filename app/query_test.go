@@ -780,6 +780,7 @@ func TestQueryRelay(t *testing.T) {
 			relay.Proof.Signature = hex.EncodeToString(sig)
 			_, _, evtChan := subscribeTo(t, tmTypes.EventNewBlock)
 			<-evtChan // Wait for block
+			gock.InterceptClient(types.GetRelayClient(relay.Proof.Blockchain))
 			res, _, err := PCA.HandleRelay(relay)
 			assert.Nil(t, err, err)
 			assert.Equal(t, expectedResponse, res.Response)
@@ -791,7 +792,7 @@ func TestQueryRelay(t *testing.T) {
 			_, stopCli, evtChan := subscribeTo(t, tmTypes.EventNewBlock)
 			select {
 			case <-evtChan:
-				inv, err := types.GetEvidence(types.SessionHeader{
+				inv, err := types.GetEvidence(validators[0].Address, types.SessionHeader{
 					ApplicationPubKey:  aat.ApplicationPublicKey,
 					Chain:              relay.Proof.Blockchain,
 					SessionBlockHeight: relay.Proof.SessionBlockHeight,
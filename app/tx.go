@@ -1,10 +1,27 @@
 package app
 
 import (
+	"fmt"
+
+	"github.com/pokt-network/pocket-core/crypto"
 	sdk "github.com/pokt-network/pocket-core/types"
+	"github.com/pokt-network/pocket-core/x/auth"
 	"github.com/pokt-network/pocket-core/x/auth/util"
+	"github.com/tendermint/tendermint/rpc/client"
 )
 
+// TxSimulation - The result of a transaction run against the latest state, without being committed or broadcast
+type TxSimulation struct {
+	Height      int64            `json:"height"` // the height of the state the transaction was run against
+	Signed      bool             `json:"signed"` // the signature is only verified if the transaction is signed
+	Code        uint32           `json:"code"`
+	Codespace   string           `json:"codespace"`
+	Log         string           `json:"log"`
+	Events      sdk.StringEvents `json:"events"`
+	Fee         sdk.Coins        `json:"fee"`          // the fee of the transaction
+	RequiredFee sdk.Coins        `json:"required_fee"` // the fee the auth module requires for the message
+}
+
 // SendRawTx - Deliver tx bytes to node
 func (app PocketCoreApp) SendRawTx(fromAddr string, txBytes []byte) (sdk.TxResponse, error) {
 	fa, err := sdk.AddressFromHex(fromAddr)
@@ -21,3 +38,51 @@ func (app PocketCoreApp) SendRawTx(fromAddr string, txBytes []byte) (sdk.TxRespo
 	cliCtx.BroadcastMode = util.BroadcastSync
 	return cliCtx.BroadcastTx(txBytes)
 }
+
+// SimulateTx - Runs the tx bytes through the ante handler and the message handler against a cached copy of the latest
+// state. An unsigned tx (empty signature) is accepted, the signature is never verified in a simulation
+func (app PocketCoreApp) SimulateTx(txBytes []byte) (res TxSimulation, err error) {
+	height := app.LastBlockHeight()
+	tx, er := auth.DefaultTxDecoder(app.cdc)(txBytes, height)
+	if er != nil {
+		return res, er
+	}
+	stdTx, ok := tx.(auth.StdTx)
+	if !ok {
+		return res, fmt.Errorf("the transaction is not a standard transaction")
+	}
+	if stdTx.Signature.PublicKey == nil {
+		return res, fmt.Errorf("the public key of the signer is missing from the transaction")
+	}
+	res.Signed = len(stdTx.Signature.Signature) != 0
+	if !res.Signed {
+		// the ante handler rejects an empty signature before it gets to the signature verification
+		stdTx.Signature.Signature = make([]byte, crypto.Ed25519SignatureSize)
+		txBytes, err = auth.DefaultTxEncoder(app.cdc)(stdTx, height)
+		if err != nil {
+			return res, err
+		}
+	}
+	ctx, err := app.NewContext(height)
+	if err != nil {
+		return res, err
+	}
+	fee := app.accountKeeper.GetParams(ctx).FeeMultiplier.GetFee(stdTx.GetMsg())
+	// the query runs the tx in simulation mode, on a cached copy of the state that is never written
+	q, err := app.GetClient().ABCIQueryWithOptions("/app/simulate", txBytes, client.ABCIQueryOptions{Height: height})
+	if err != nil {
+		return res, err
+	}
+	var result sdk.Result
+	if err = app.cdc.UnmarshalBinaryLengthPrefixed(q.Response.Value, &result, height); err != nil {
+		return res, err
+	}
+	res.Height = height
+	res.Code = uint32(result.Code)
+	res.Codespace = string(result.Codespace)
+	res.Log = result.Log
+	res.Events = sdk.StringifyEvents(result.Events.ToABCIEvents())
+	res.Fee = stdTx.GetFee()
+	res.RequiredFee = sdk.NewCoins(sdk.NewCoin(sdk.DefaultStakeDenom, fee))
+	return res, nil
+}
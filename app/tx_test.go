@@ -1232,7 +1232,7 @@ func TestClaimAminoTx(t *testing.T) {
 					t.Fatal(err)
 				}
 				proof.Signature = hex.EncodeToString(sig)
-				pocketTypes.SetProof(pocketTypes.SessionHeader{
+				pocketTypes.SetProof(validators[0].Address, pocketTypes.SessionHeader{
 					ApplicationPubKey:  appPrivateKey.PublicKey().RawString(),
 					Chain:              sdk.PlaceholderHash,
 					SessionBlockHeight: 1,
@@ -1307,7 +1307,7 @@ func TestClaimProtoTx(t *testing.T) {
 					t.Fatal(err)
 				}
 				proof.Signature = hex.EncodeToString(sig)
-				pocketTypes.SetProof(pocketTypes.SessionHeader{
+				pocketTypes.SetProof(validators[0].Address, pocketTypes.SessionHeader{
 					ApplicationPubKey:  appPrivateKey.PublicKey().RawString(),
 					Chain:              sdk.PlaceholderHash,
 					SessionBlockHeight: 1,
@@ -1349,7 +1349,7 @@ func TestAminoClaimTxChallenge(t *testing.T) {
 			challenges := NewValidChallengeProof(t, keys, 5)
 			_, _, cleanup := tc.memoryNodeFn(t, genBz)
 			for _, c := range challenges {
-				c.Store(sdk.NewInt(1000000))
+				c.Store(sdk.Address(keys[0].PublicKey().Address()), sdk.NewInt(1000000))
 			}
 			_, _, evtChan := subscribeTo(t, tmTypes.EventTx)
 			res := <-evtChan // Wait for tx
@@ -1386,7 +1386,7 @@ func TestProtoClaimTxChallenge(t *testing.T) {
 			challenges := NewValidChallengeProof(t, keys, 5)
 			_, _, cleanup := tc.memoryNodeFn(t, genBz)
 			for _, c := range challenges {
-				c.Store(sdk.NewInt(1000000))
+				c.Store(sdk.Address(keys[0].PublicKey().Address()), sdk.NewInt(1000000))
 			}
 			_, _, evtChan := subscribeTo(t, tmTypes.EventTx)
 			res := <-evtChan // Wait for tx
@@ -28,15 +28,10 @@ func BuildMultisig(fromAddr, jsonMessage, passphrase, chainID string, pk crypto.
 	if err != nil {
 		return nil, err
 	}
-	var m sdk.Msg
-	if err := Codec().UnmarshalJSON([]byte(jsonMessage), &m); err != nil {
+	protoMsg, err := protoMsgFromJSON(jsonMessage)
+	if err != nil {
 		return nil, err
 	}
-	// use reflection to convert to proto msg
-	val := reflect.ValueOf(m)
-	vp := reflect.New(val.Type())
-	vp.Elem().Set(val)
-	protoMsg := vp.Interface().(sdk.ProtoMsg)
 	kb, err := GetKeybase()
 	if err != nil {
 		return nil, err
@@ -49,6 +44,56 @@ func BuildMultisig(fromAddr, jsonMessage, passphrase, chainID string, pk crypto.
 	return txBuilder.BuildAndSignMultisigTransaction(fa, pk, protoMsg, passphrase, fees, legacyCodec)
 }
 
+// "BuildMultiMsgTx" - Builds and signs a transaction of the messages (a json array) that are executed atomically
+func BuildMultiMsgTx(fromAddr, jsonMessages, passphrase, chainID string, fees int64, memo string, legacyCodec bool) ([]byte, error) {
+	fa, err := sdk.AddressFromHex(fromAddr)
+	if err != nil {
+		return nil, err
+	}
+	var rawMsgs []json.RawMessage
+	if err := json.Unmarshal([]byte(jsonMessages), &rawMsgs); err != nil {
+		return nil, err
+	}
+	msg := types.MsgMulti{Msgs: make([]sdk.Msg, 0, len(rawMsgs))}
+	for _, raw := range rawMsgs {
+		m, err := protoMsgFromJSON(string(raw))
+		if err != nil {
+			return nil, err
+		}
+		msg.Msgs = append(msg.Msgs, m)
+	}
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+	kb, err := GetKeybase()
+	if err != nil {
+		return nil, err
+	}
+	txBuilder := auth.NewTxBuilder(
+		auth.DefaultTxEncoder(cdc),
+		auth.DefaultTxDecoder(cdc),
+		chainID,
+		memo,
+		sdk.NewCoins(sdk.NewCoin(sdk.DefaultStakeDenom, sdk.NewInt(fees)))).WithKeybase(kb)
+	return txBuilder.BuildAndSignWithKeyBase(fa, passphrase, &msg, legacyCodec)
+}
+
+// "protoMsgFromJSON" - Decodes the json (amino) message into a proto message
+func protoMsgFromJSON(jsonMessage string) (sdk.ProtoMsg, error) {
+	var m sdk.Msg
+	if err := Codec().UnmarshalJSON([]byte(jsonMessage), &m); err != nil {
+		return nil, err
+	}
+	// use reflection to convert to proto msg
+	val := reflect.ValueOf(m)
+	if val.Kind() == reflect.Ptr {
+		return m.(sdk.ProtoMsg), nil
+	}
+	vp := reflect.New(val.Type())
+	vp.Elem().Set(val)
+	return vp.Interface().(sdk.ProtoMsg), nil
+}
+
 func SignMultisigNext(fromAddr, txHex, passphrase, chainID string, legacyCodec bool) ([]byte, error) {
 	fa, err := sdk.AddressFromHex(fromAddr)
 	if err != nil {
@@ -864,6 +864,9 @@ func (app *BaseApp) getContextForTx(mode runTxMode, txBytes []byte) (ctx sdk.Ctx
 // runMsg iterates through all the messages and executes them.
 // nolint: gocyclo
 func (app *BaseApp) runMsg(ctx sdk.Ctx, msg sdk.Msg, mode runTxMode, signer crypto.PublicKey) (result sdk.Result) {
+	if msgs, ok := auth.MultiMsgs(msg); ok {
+		return app.runMultiMsg(ctx, msgs, mode, signer)
+	}
 	var msgLogs sdk.ABCIMessageLogs
 
 	if GetABCILogging() {
@@ -917,6 +920,59 @@ func (app *BaseApp) runMsg(ctx sdk.Ctx, msg sdk.Msg, mode runTxMode, signer cryp
 	return result
 }
 
+// runMultiMsg executes the messages of a multi message in order on a single cache, which is only written if every
+// message succeeds.
+func (app *BaseApp) runMultiMsg(ctx sdk.Ctx, msgs []sdk.Msg, mode runTxMode, signer crypto.PublicKey) (result sdk.Result) {
+	if !cdc.IsAfterNamedFeatureActivationHeight(ctx.BlockHeight(), codec.MultiMsgKey) {
+		return auth.ErrMultiMsgNotActive(authCodespace).Result()
+	}
+	var msgLogs sdk.ABCIMessageLogs
+	if GetABCILogging() {
+		msgLogs = make(sdk.ABCIMessageLogs, 0, len(msgs))
+	}
+	var data []byte
+	events := sdk.EmptyEvents()
+	cacheCtx, writeCache := ctx.CacheContext()
+	for i, msg := range msgs {
+		msgRoute := msg.Route()
+		handler := app.router.Route(msgRoute)
+		if handler == nil {
+			return sdk.ErrUnknownRequest("unrecognized ProtoMsg type: " + msgRoute).Result()
+		}
+		var msgResult sdk.Result
+		// skip actual execution for CheckTx mode
+		if mode != runTxModeCheck {
+			msgResult = handler(cacheCtx.WithEventManager(sdk.NewEventManager()), msg, signer)
+		}
+		data = append(data, msgResult.Data...)
+		msgEvents := sdk.EmptyEvents().
+			AppendEvent(sdk.NewEvent(sdk.EventTypeMessage, sdk.NewAttribute(sdk.AttributeKeyAction, msg.Type()))).
+			AppendEvents(msgResult.Events)
+		// stop execution on the first failed message, nothing is written
+		if !msgResult.IsOK() {
+			if GetABCILogging() {
+				msgLogs = append(msgLogs, sdk.NewABCIMessageLog(uint32(i), false, msgResult.Log, msgEvents))
+			}
+			return sdk.Result{
+				Code:      msgResult.Code,
+				Codespace: msgResult.Codespace,
+				Log:       strings.TrimSpace(msgLogs.String()),
+				Events:    msgEvents,
+			}
+		}
+		if GetABCILogging() {
+			msgLogs = append(msgLogs, sdk.NewABCIMessageLog(uint32(i), true, msgResult.Log, msgEvents))
+		}
+		events = events.AppendEvents(msgEvents)
+	}
+	writeCache()
+	return sdk.Result{
+		Data:   data,
+		Log:    strings.TrimSpace(msgLogs.String()),
+		Events: events,
+	}
+}
+
 // Returns the applications's deliverState if app is in runTxModeDeliver,
 // otherwise it returns the application's checkstate.
 func (app *BaseApp) getState(mode runTxMode) *state {
@@ -1068,6 +1124,10 @@ func (app *BaseApp) runTx(mode runTxMode, txBytes []byte, tx sdk.Tx) (result sdk
 	// Create a new context based off of the existing context with a cache wrapped
 	// multi-store in case message processing fails.
 	runMsgCtx, newMS := app.txContext(ctx, txBytes) // todo edit here!!!
+	if mode == runTxModeSimulate {
+		// the tx context writes through to the state, so a simulation runs the messages on a cache that is never written
+		runMsgCtx, _ = app.cacheTxContext(runMsgCtx, txBytes)
+	}
 	result = app.runMsg(runMsgCtx, msgs, mode, signer)
 	result.GasWanted = gasWanted
 
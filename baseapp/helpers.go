@@ -18,7 +18,7 @@ func (app *BaseApp) Check(tx sdk.Tx) (result sdk.Result) {
 
 // nolint - full tx execution
 func (app *BaseApp) Simulate(txBytes []byte, tx sdk.Tx) (result sdk.Result) {
-	result, _ = app.runTx(runTxModeSimulate, nil, tx)
+	result, _ = app.runTx(runTxModeSimulate, txBytes, tx)
 	return
 }
 
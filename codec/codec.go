@@ -48,6 +48,11 @@ const (
 	BlockSizeModifyKey      = "BLOCK"
 	RSCALKey                = "RSCAL"
 	VEDITKey                = "VEDIT"
+	BatchClaimKey           = "BCLM"
+	MultiMsgKey             = "MMSG"
+	FeeGrantKey             = "FEEG"
+	GovProposalKey          = "GPROP"
+	ScheduledParamChangeKey = "SCHED"
 )
 
 func GetCodecUpgradeHeight() int64 {
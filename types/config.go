@@ -35,6 +35,7 @@ type PocketConfig struct {
 	RPCTimeout               int64  `json:"rpc_timeout"`
 	PrometheusAddr           string `json:"pocket_prometheus_port"`
 	PrometheusMaxOpenfiles   int    `json:"prometheus_max_open_files"`
+	PrometheusLegacyMetrics  bool   `json:"prometheus_legacy_metrics"`
 	MaxClaimAgeForProofRetry int    `json:"max_claim_age_for_proof_retry"`
 	ProofPrevalidation       bool   `json:"proof_prevalidation"`
 	CtxCacheSize             int    `json:"ctx_cache_size"`
@@ -45,6 +46,31 @@ type PocketConfig struct {
 	IavlCacheSize            int64  `json:"iavl_cache_size"`
 	ChainsHotReload          bool   `json:"chains_hot_reload"`
 	GenerateTokenOnStart     bool   `json:"generate_token_on_start"`
+	MaxUnackedStreamMessages int    `json:"max_unacked_stream_messages"`
+	EndpointHealthCheckMs    int64  `json:"endpoint_health_check_interval"`
+	RelayMaxIdleConns        int    `json:"relay_max_idle_conns"`
+	RelayMaxIdleConnsPerHost int    `json:"relay_max_idle_conns_per_host"`
+	RelayMaxConnsPerHost     int    `json:"relay_max_conns_per_host"`
+	RelayIdleConnTimeout     int64  `json:"relay_idle_conn_timeout"`
+	RelayKeepAlive           int64  `json:"relay_keep_alive"`
+	RelayHTTP2               bool   `json:"relay_http2"`
+	RelayTLSHandshakeTimeout int64  `json:"relay_tls_handshake_timeout"`
+	RelayTLSSkipVerify       bool   `json:"relay_tls_skip_verify"`
+	RelayMaxRequestBytes     int64  `json:"relay_max_request_bytes"`
+	RelayMaxResponseBytes    int64  `json:"relay_max_response_bytes"`
+	RelayRateLimitApp        int    `json:"relay_rate_limit_app"`
+	RelayRateLimitClient     int    `json:"relay_rate_limit_client"`
+	RelayRateLimitIP         int    `json:"relay_rate_limit_ip"`
+	RelayRateLimitBurst      int    `json:"relay_rate_limit_burst"`
+	RelayRateLimitMaxKeys    int    `json:"relay_rate_limit_max_keys"`
+	RelayLedger              bool   `json:"relay_ledger"`
+	ServicerKeyFileList      string `json:"servicer_key_file_list"`
+	SubmissionSpreadBlocks   int64  `json:"submission_spread_blocks"`
+	SubmissionTimeoutBlocks  int64  `json:"submission_timeout_blocks"`
+	SubmissionMaxPerBlock    int    `json:"submission_max_per_block"`
+	EvidenceCacheEngine      string `json:"evidence_cache_engine"`
+	SubmissionFeePayer       string `json:"submission_fee_payer"`
+	MaxEventSubscribers      int    `json:"max_event_subscribers"`
 }
 
 type Config struct {
@@ -89,6 +115,7 @@ const (
 	DefaultApplicationCacheSize        = DefaultValidatorCacheSize / 4
 	DefaultPocketPrometheusListenAddr  = "8083"
 	DefaultPrometheusMaxOpenFile       = 3
+	DefaultPrometheusLegacyMetrics     = true
 	DefaultRPCTimeout                  = 30000
 	DefaultMaxClaimProofRetryAge       = 32
 	DefaultProofPrevalidation          = false
@@ -99,6 +126,31 @@ const (
 	DefaultIavlCacheSize               = 5000000
 	DefaultChainHotReload              = false
 	DefaultGenerateTokenOnStart        = true
+	DefaultMaxUnackedStreamMessages    = 25
+	DefaultEndpointHealthCheckMs       = 0
+	DefaultRelayMaxIdleConns           = 1000
+	DefaultRelayMaxIdleConnsPerHost    = 100
+	DefaultRelayMaxConnsPerHost        = 0
+	DefaultRelayIdleConnTimeout        = 90000
+	DefaultRelayKeepAlive              = 30000
+	DefaultRelayHTTP2                  = true
+	DefaultRelayTLSHandshakeTimeout    = 10000
+	DefaultRelayTLSSkipVerify          = false
+	DefaultRelayMaxRequestBytes        = 1024 * 1024
+	DefaultRelayMaxResponseBytes       = 100 * 1024 * 1024
+	DefaultRelayRateLimitApp           = 0
+	DefaultRelayRateLimitClient        = 0
+	DefaultRelayRateLimitIP            = 0
+	DefaultRelayRateLimitBurst         = 0
+	DefaultRelayRateLimitMaxKeys       = 100000
+	DefaultRelayLedger                 = false
+	DefaultServicerKeyFileList         = ""
+	DefaultSubmissionSpreadBlocks      = 4
+	DefaultSubmissionTimeoutBlocks     = 3
+	DefaultSubmissionMaxPerBlock       = 25
+	DefaultEvidenceCacheEngine         = "leveldb"
+	DefaultSubmissionFeePayer          = ""
+	DefaultMaxEventSubscribers         = 100
 )
 
 func DefaultConfig(dataDir string) Config {
@@ -123,6 +175,7 @@ func DefaultConfig(dataDir string) Config {
 			RPCTimeout:               DefaultRPCTimeout,
 			PrometheusAddr:           DefaultPocketPrometheusListenAddr,
 			PrometheusMaxOpenfiles:   DefaultPrometheusMaxOpenFile,
+			PrometheusLegacyMetrics:  DefaultPrometheusLegacyMetrics,
 			MaxClaimAgeForProofRetry: DefaultMaxClaimProofRetryAge,
 			ProofPrevalidation:       DefaultProofPrevalidation,
 			CtxCacheSize:             DefaultCtxCacheSize,
@@ -132,6 +185,31 @@ func DefaultConfig(dataDir string) Config {
 			IavlCacheSize:            DefaultIavlCacheSize,
 			ChainsHotReload:          DefaultChainHotReload,
 			GenerateTokenOnStart:     DefaultGenerateTokenOnStart,
+			MaxUnackedStreamMessages: DefaultMaxUnackedStreamMessages,
+			EndpointHealthCheckMs:    DefaultEndpointHealthCheckMs,
+			RelayMaxIdleConns:        DefaultRelayMaxIdleConns,
+			RelayMaxIdleConnsPerHost: DefaultRelayMaxIdleConnsPerHost,
+			RelayMaxConnsPerHost:     DefaultRelayMaxConnsPerHost,
+			RelayIdleConnTimeout:     DefaultRelayIdleConnTimeout,
+			RelayKeepAlive:           DefaultRelayKeepAlive,
+			RelayHTTP2:               DefaultRelayHTTP2,
+			RelayTLSHandshakeTimeout: DefaultRelayTLSHandshakeTimeout,
+			RelayTLSSkipVerify:       DefaultRelayTLSSkipVerify,
+			RelayMaxRequestBytes:     DefaultRelayMaxRequestBytes,
+			RelayMaxResponseBytes:    DefaultRelayMaxResponseBytes,
+			RelayRateLimitApp:        DefaultRelayRateLimitApp,
+			RelayRateLimitClient:     DefaultRelayRateLimitClient,
+			RelayRateLimitIP:         DefaultRelayRateLimitIP,
+			RelayRateLimitBurst:      DefaultRelayRateLimitBurst,
+			RelayRateLimitMaxKeys:    DefaultRelayRateLimitMaxKeys,
+			RelayLedger:              DefaultRelayLedger,
+			ServicerKeyFileList:      DefaultServicerKeyFileList,
+			SubmissionSpreadBlocks:   DefaultSubmissionSpreadBlocks,
+			SubmissionTimeoutBlocks:  DefaultSubmissionTimeoutBlocks,
+			SubmissionMaxPerBlock:    DefaultSubmissionMaxPerBlock,
+			EvidenceCacheEngine:      DefaultEvidenceCacheEngine,
+			SubmissionFeePayer:       DefaultSubmissionFeePayer,
+			MaxEventSubscribers:      DefaultMaxEventSubscribers,
 		},
 	}
 	c.TendermintConfig.LevelDBOptions = config.DefaultLevelDBOpts()
@@ -18,35 +18,54 @@ const (
 	QuerierRoute      = types.QuerierRoute
 	DefaultParamspace = types.DefaultCodespace
 	QueryAccount      = types.QueryAccount
+	QueryAllowances   = types.QueryAllowances
 	Burner            = types.Burner
 	Staking           = types.Staking
 	Minter            = types.Minter
 )
 
 var (
-	NewKeeper                 = keeper.NewKeeper
-	NewModuleAddress          = types.NewModuleAddress
-	NewBaseAccountWithAddress = types.NewBaseAccountWithAddress
-	RegisterCodec             = types.RegisterCodec
-	CountSubKeys              = types.CountSubKeys
-	StdSignBytes              = types.StdSignBytes
-	DefaultTxDecoder          = types.DefaultTxDecoder
-	DefaultTxEncoder          = types.DefaultTxEncoder
-	NewTxBuilder              = types.NewTxBuilder
-	ModuleCdc                 = types.ModuleCdc
+	NewKeeper                   = keeper.NewKeeper
+	NewModuleAddress            = types.NewModuleAddress
+	NewBaseAccountWithAddress   = types.NewBaseAccountWithAddress
+	RegisterCodec               = types.RegisterCodec
+	CountSubKeys                = types.CountSubKeys
+	StdSignBytes                = types.StdSignBytes
+	StdSignBytesWithFeePayer    = types.StdSignBytesWithFeePayer
+	DefaultTxDecoder            = types.DefaultTxDecoder
+	DefaultTxEncoder            = types.DefaultTxEncoder
+	NewTxBuilder                = types.NewTxBuilder
+	NewMsgMulti                 = types.NewMsgMulti
+	MultiMsgs                   = types.MultiMsgs
+	ErrMultiMsgNotActive        = types.ErrMultiMsgNotActive
+	NewFeeAllowance             = types.NewFeeAllowance
+	NewMsgGrantFeeAllowance     = types.NewMsgGrantFeeAllowance
+	NewMsgRevokeFeeAllowance    = types.NewMsgRevokeFeeAllowance
+	ErrFeeGrantNotActive        = types.ErrFeeGrantNotActive
+	NewContinuousVestingAccount = types.NewContinuousVestingAccount
+	NewDelayedVestingAccount    = types.NewDelayedVestingAccount
+	ModuleCdc                   = types.ModuleCdc
 )
 
 // Type exported types
 type (
-	GenesisState       = types.GenesisState
-	Keeper             = keeper.Keeper
-	Account            = exported.Account
-	BaseAccount        = types.BaseAccount
-	Params             = types.Params
-	QueryAccountParams = types.QueryAccountParams
-	ProtoStdTx         = types.ProtoStdTx
-	StdTx              = types.StdTx
-	StdSignDoc         = types.StdSignDoc
-	StdSignature       = types.ProtoStdSignature
-	TxBuilder          = types.TxBuilder
+	GenesisState             = types.GenesisState
+	Keeper                   = keeper.Keeper
+	Account                  = exported.Account
+	BaseAccount              = types.BaseAccount
+	VestingAccount           = exported.VestingAccount
+	ContinuousVestingAccount = types.ContinuousVestingAccount
+	DelayedVestingAccount    = types.DelayedVestingAccount
+	Params                   = types.Params
+	QueryAccountParams       = types.QueryAccountParams
+	ProtoStdTx               = types.ProtoStdTx
+	StdTx                    = types.StdTx
+	MsgMulti                 = types.MsgMulti
+	FeeAllowance             = types.FeeAllowance
+	MsgGrantFeeAllowance     = types.MsgGrantFeeAllowance
+	MsgRevokeFeeAllowance    = types.MsgRevokeFeeAllowance
+	QueryAllowancesParams    = types.QueryAllowancesParams
+	StdSignDoc               = types.StdSignDoc
+	StdSignature             = types.ProtoStdSignature
+	TxBuilder                = types.TxBuilder
 )
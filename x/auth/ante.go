@@ -29,6 +29,10 @@ func NewAnteHandler(ak keeper.Keeper) sdk.AnteHandler {
 		if !ok {
 			return newCtx, sdk.ErrInternal("all transactions must be convertible to inteface: ProtoStdTx").Result(), nil, true
 		}
+		// the fee allowance messages are rejected before the fee grants are activated, so they don't pay a fee
+		if IsFeeAllowanceMsg(stdTx.GetMsg()) && !ak.Cdc.IsAfterNamedFeatureActivationHeight(ctx.BlockHeight(), codec.FeeGrantKey) {
+			return newCtx, types.ErrFeeGrantNotActive(ModuleName).Result(), nil, true
+		}
 		signer, err := ValidateTransaction(ctx, ak, stdTx, ak.GetParams(ctx), txIndexer, txBz, simulate)
 		if err != nil {
 			return newCtx, err.Result(), signer, true
@@ -119,6 +123,19 @@ func ValidateTransaction(ctx sdk.Ctx, k Keeper, stdTx types.StdTx, params Params
 	return nil, sdk.ErrUnauthorized("signature verification failed for the transaction")
 }
 
+// IsFeeAllowanceMsg returns true if the message, or a message wrapped by it, grants or revokes a fee allowance
+func IsFeeAllowanceMsg(msg sdk.Msg) bool {
+	if msgs, ok := types.MultiMsgs(msg); ok {
+		for _, m := range msgs {
+			if IsFeeAllowanceMsg(m) {
+				return true
+			}
+		}
+		return false
+	}
+	return msg.Route() == types.ModuleName && (msg.Type() == types.MsgGrantFeeAllowanceName || msg.Type() == types.MsgRevokeFeeAllowanceName)
+}
+
 func ValidateSignatureDepth(limit uint64, publicKey posCrypto.PublicKeyMultiSig) (ok bool) {
 	_, ok = recSignDepth(1, limit, publicKey)
 	return
@@ -164,7 +181,8 @@ func ValidateMemo(stdTx types.StdTx, params Params) sdk.Error {
 	return nil
 }
 
-// DeductFees deducts fees from the given account.
+// DeductFees deducts fees from the given account, or from the fee payer of the transaction out of its fee allowance
+// to the signer.
 func DeductFees(keeper keeper.Keeper, ctx sdk.Ctx, tx types.StdTx, signer posCrypto.PublicKey) sdk.Error {
 	fees := tx.GetFee()
 	if !fees.IsValid() {
@@ -173,7 +191,20 @@ func DeductFees(keeper keeper.Keeper, ctx sdk.Ctx, tx types.StdTx, signer posCry
 	var acc Account
 	var err sdk.Error
 
-	if keeper.Cdc.IsAfterNonCustodialUpgrade(ctx.BlockHeight()) {
+	if feePayer := tx.GetFeePayer(); feePayer != nil {
+		if !keeper.Cdc.IsAfterNamedFeatureActivationHeight(ctx.BlockHeight(), codec.FeeGrantKey) {
+			return types.ErrFeeGrantNotActive(ModuleName)
+		}
+		// the signer account may not exist, the fees are spent from the allowance of the fee payer to the signer
+		err = keeper.UseFeeAllowance(ctx, feePayer, sdk.Address(signer.Address()), fees)
+		if err != nil {
+			return err
+		}
+		acc, err = GetSignerAcc(ctx, keeper, feePayer)
+		if err != nil {
+			return err
+		}
+	} else if keeper.Cdc.IsAfterNonCustodialUpgrade(ctx.BlockHeight()) {
 		acc, err = GetSignerAcc(ctx, keeper, sdk.Address(signer.Address()))
 		if err != nil {
 			return err
@@ -185,8 +216,8 @@ func DeductFees(keeper keeper.Keeper, ctx sdk.Ctx, tx types.StdTx, signer posCry
 		}
 	}
 
-	coins := acc.GetCoins()
-	// verify the account has enough funds to pay for fees
+	// verify the account has enough funds to pay for fees, the locked coins of a vesting account can't pay them
+	coins := acc.SpendableCoins(ctx.BlockHeader().Time)
 	_, hasNeg := coins.SafeSub(fees)
 	if hasNeg {
 		return types.ErrInsufficientBalance(ModuleName, acc.GetAddress(), fees)
@@ -201,7 +232,7 @@ func DeductFees(keeper keeper.Keeper, ctx sdk.Ctx, tx types.StdTx, signer posCry
 // GetSignBytes returns a slice of bytes to sign over for a given transaction
 // and an account.
 func GetSignBytes(chainID string, stdTx types.StdTx) ([]byte, error) {
-	return StdSignBytes(
-		chainID, stdTx.GetEntropy(), stdTx.GetFee(), stdTx.GetMsg(), stdTx.GetMemo(),
+	return StdSignBytesWithFeePayer(
+		chainID, stdTx.GetEntropy(), stdTx.GetFee(), stdTx.GetMsg(), stdTx.GetMemo(), stdTx.GetFeePayer(),
 	)
 }
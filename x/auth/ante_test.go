@@ -2,6 +2,8 @@ package auth
 
 import (
 	"github.com/pokt-network/pocket-core/crypto"
+	sdk "github.com/pokt-network/pocket-core/types"
+	"github.com/pokt-network/pocket-core/x/auth/types"
 	"github.com/stretchr/testify/assert"
 	"testing"
 )
@@ -28,3 +30,15 @@ func TestSignatureDepth(t *testing.T) {
 	assert.True(t, ValidateSignatureDepth(5, mspk))
 	assert.False(t, ValidateSignatureDepth(4, mspk))
 }
+
+func TestIsFeeAllowanceMsg(t *testing.T) {
+	granter := sdk.Address(crypto.GenerateEd25519PrivKey().PublicKey().Address())
+	grantee := sdk.Address(crypto.GenerateEd25519PrivKey().PublicKey().Address())
+	grant := types.MsgGrantFeeAllowance{Granter: granter, Grantee: grantee}
+	revoke := &types.MsgRevokeFeeAllowance{Granter: granter, Grantee: grantee}
+	assert.True(t, IsFeeAllowanceMsg(grant))
+	assert.True(t, IsFeeAllowanceMsg(revoke))
+	// wrapped in a multi message
+	assert.True(t, IsFeeAllowanceMsg(types.NewMsgMulti(types.NewMsgMulti(), revoke)))
+	assert.False(t, IsFeeAllowanceMsg(types.NewMsgMulti()))
+}
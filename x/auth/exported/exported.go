@@ -27,6 +27,27 @@ type Account interface {
 	String() string
 }
 
+// VestingAccount defines an account whose coins are locked until they vest. The locked coins can be staked but not
+// spent; the staked coins are tracked so the locked ones stay locked once they are unstaked.
+type VestingAccount interface {
+	Account
+
+	// Tracks the staking of coins at the block time, the locked coins are staked first
+	TrackDelegation(blockTime time.Time, amount sdk.Coins)
+	// Tracks the unstaking of coins, the free coins are unstaked first
+	TrackUndelegation(amount sdk.Coins)
+
+	GetVestedCoins(blockTime time.Time) sdk.Coins
+	GetVestingCoins(blockTime time.Time) sdk.Coins
+
+	GetStartTime() int64
+	GetEndTime() int64
+
+	GetOriginalVesting() sdk.Coins
+	GetDelegatedFree() sdk.Coins
+	GetDelegatedVesting() sdk.Coins
+}
+
 // ModuleAccountI defines an account interface for modules that hold tokens in an escrow
 type ModuleAccountI interface {
 	Account
@@ -13,7 +13,9 @@ func ExportGenesis(ctx sdk.Ctx, k keeper.Keeper) types.GenesisState {
 	params := k.GetParams(ctx)
 	accounts := k.GetAllAccountsExport(ctx)
 	supply := k.GetSupply(ctx)
-	return types.NewGenesisState(params, accounts, supply.GetTotal())
+	genesis := types.NewGenesisState(params, accounts, supply.GetTotal())
+	genesis.FeeAllowances = k.GetActiveFeeAllowances(ctx, nil, nil)
+	return genesis
 }
 
 // InitGenesis sets supply information for genesis.
@@ -45,4 +47,7 @@ func InitGenesis(ctx sdk.Ctx, k keeper.Keeper, data types.GenesisState) {
 		data.Supply = totalSupply
 	}
 	k.SetSupply(ctx, types.NewSupply(data.Supply))
+	for _, allowance := range data.FeeAllowances {
+		k.SetFeeAllowance(ctx, allowance)
+	}
 }
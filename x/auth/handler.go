@@ -0,0 +1,66 @@
+package auth
+
+import (
+	"fmt"
+	"reflect"
+
+	"github.com/pokt-network/pocket-core/codec"
+	"github.com/pokt-network/pocket-core/crypto"
+	sdk "github.com/pokt-network/pocket-core/types"
+	"github.com/pokt-network/pocket-core/x/auth/keeper"
+	"github.com/pokt-network/pocket-core/x/auth/types"
+)
+
+// NewHandler returns a handler for the fee allowance messages
+func NewHandler(k keeper.Keeper) sdk.Handler {
+	return func(ctx sdk.Ctx, msg sdk.Msg, _ crypto.PublicKey) sdk.Result {
+		ctx = ctx.WithEventManager(sdk.NewEventManager())
+		if !k.Cdc.IsAfterNamedFeatureActivationHeight(ctx.BlockHeight(), codec.FeeGrantKey) {
+			return types.ErrFeeGrantNotActive(types.DefaultCodespace).Result()
+		}
+		// convert to value for switch consistency
+		if reflect.ValueOf(msg).Kind() == reflect.Ptr {
+			msg = reflect.Indirect(reflect.ValueOf(msg)).Interface().(sdk.Msg)
+		}
+		switch msg := msg.(type) {
+		case types.MsgGrantFeeAllowance:
+			return handleMsgGrantFeeAllowance(ctx, msg, k)
+		case types.MsgRevokeFeeAllowance:
+			return handleMsgRevokeFeeAllowance(ctx, msg, k)
+		default:
+			errMsg := fmt.Sprintf("unrecognized auth message type: %T", msg)
+			return sdk.ErrUnknownRequest(errMsg).Result()
+		}
+	}
+}
+
+func handleMsgGrantFeeAllowance(ctx sdk.Ctx, msg types.MsgGrantFeeAllowance, k keeper.Keeper) sdk.Result {
+	allowance := msg.FeeAllowance()
+	if allowance.IsExpired(ctx.BlockHeight()) {
+		return types.ErrFeeAllowanceExpired(types.DefaultCodespace, allowance.ExpirationHeight).Result()
+	}
+	k.SetFeeAllowance(ctx, allowance)
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeGrantFeeAllowance,
+			sdk.NewAttribute(types.AttributeKeyGranter, msg.Granter.String()),
+			sdk.NewAttribute(types.AttributeKeyGrantee, msg.Grantee.String()),
+		),
+	)
+	return sdk.Result{Events: ctx.EventManager().Events()}
+}
+
+func handleMsgRevokeFeeAllowance(ctx sdk.Ctx, msg types.MsgRevokeFeeAllowance, k keeper.Keeper) sdk.Result {
+	if _, found := k.GetFeeAllowance(ctx, msg.Granter, msg.Grantee); !found {
+		return types.ErrFeeAllowanceNotFound(types.DefaultCodespace, msg.Granter, msg.Grantee).Result()
+	}
+	k.DeleteFeeAllowance(ctx, msg.Granter, msg.Grantee)
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRevokeFeeAllowance,
+			sdk.NewAttribute(types.AttributeKeyGranter, msg.Granter.String()),
+			sdk.NewAttribute(types.AttributeKeyGrantee, msg.Grantee.String()),
+		),
+	)
+	return sdk.Result{Events: ctx.EventManager().Events()}
+}
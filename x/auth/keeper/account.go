@@ -108,8 +108,9 @@ func (k Keeper) GetAllAccounts(ctx sdk.Ctx) []exported.Account {
 func (k Keeper) GetAllAccountsExport(ctx sdk.Ctx) []exported.Account {
 	var accounts []exported.Account
 	appendAccount := func(acc exported.Account) (stop bool) {
-		//not get empty coins accounts
-		if !acc.GetCoins().Empty() {
+		//not get empty coins accounts, unless they are vesting accounts with staked coins
+		_, isVesting := acc.(exported.VestingAccount)
+		if !acc.GetCoins().Empty() || isVesting {
 			//sanity check here
 			if acc.GetAddress() != nil {
 				accounts = append(accounts, acc)
@@ -186,6 +187,8 @@ func (k Keeper) EncodeAccount(acc exported.Account, ctx sdk.Ctx) ([]byte, error)
 		return k.EncodeBaseAccount(a, ctx)
 	case *types.ModuleAccount:
 		return k.EncodeModuleAccount(a, ctx)
+	case *types.ContinuousVestingAccount, *types.DelayedVestingAccount:
+		return k.Cdc.MarshalBinaryBare(a, ctx.BlockHeight())
 	}
 	return nil, fmt.Errorf("could not encode account: unrecognized account type")
 }
@@ -205,7 +208,11 @@ func (k Keeper) DecodeAccount(bz []byte, ctx sdk.Ctx) (exported.Account, error)
 	if err == nil {
 		return acc, err
 	}
-	return k.DecodeModuleAccount(bz, ctx)
+	macc, err := k.DecodeModuleAccount(bz, ctx)
+	if err == nil {
+		return macc, err
+	}
+	return k.DecodeVestingAccount(bz, ctx)
 }
 
 func (k Keeper) DecodeBaseAccount(bz []byte, ctx sdk.Ctx) (exported.Account, error) {
@@ -220,3 +227,14 @@ func (k Keeper) DecodeModuleAccount(bz []byte, ctx sdk.Ctx) (exported.ModuleAcco
 	err := k.Cdc.UnmarshalBinaryBare(bz, &ma, ctx.BlockHeight())
 	return &ma, err
 }
+
+// "DecodeVestingAccount" - decodes a continuous or a delayed vesting account
+func (k Keeper) DecodeVestingAccount(bz []byte, ctx sdk.Ctx) (exported.VestingAccount, error) {
+	var cva types.ContinuousVestingAccount
+	if err := k.Cdc.UnmarshalBinaryBare(bz, &cva, ctx.BlockHeight()); err == nil {
+		return &cva, nil
+	}
+	var dva types.DelayedVestingAccount
+	err := k.Cdc.UnmarshalBinaryBare(bz, &dva, ctx.BlockHeight())
+	return &dva, err
+}
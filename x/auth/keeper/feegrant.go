@@ -0,0 +1,109 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/pokt-network/pocket-core/types"
+	"github.com/pokt-network/pocket-core/x/auth/types"
+)
+
+// GetFeeAllowance returns the fee allowance of the granter to the grantee
+func (k Keeper) GetFeeAllowance(ctx sdk.Ctx, granter, grantee sdk.Address) (allowance types.FeeAllowance, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz, _ := store.Get(types.FeeAllowanceKey(granter, grantee))
+	if bz == nil {
+		return allowance, false
+	}
+	if err := k.Cdc.UnmarshalBinaryBare(bz, &allowance, ctx.BlockHeight()); err != nil {
+		ctx.Logger().Error(fmt.Sprintf("could not unmarshal the fee allowance of %s to %s: %s", granter, grantee, err.Error()))
+		return allowance, false
+	}
+	return allowance, true
+}
+
+// SetFeeAllowance sets the fee allowance in the store, replacing the previous allowance of the granter to the grantee
+func (k Keeper) SetFeeAllowance(ctx sdk.Ctx, allowance types.FeeAllowance) {
+	store := ctx.KVStore(k.storeKey)
+	bz, err := k.Cdc.MarshalBinaryBare(&allowance, ctx.BlockHeight())
+	if err != nil {
+		ctx.Logger().Error(fmt.Sprintf("could not marshal the fee allowance of %s to %s: %s", allowance.Granter, allowance.Grantee, err.Error()))
+		return
+	}
+	_ = store.Set(types.FeeAllowanceKey(allowance.Granter, allowance.Grantee), bz)
+}
+
+// DeleteFeeAllowance removes the fee allowance of the granter to the grantee from the store
+func (k Keeper) DeleteFeeAllowance(ctx sdk.Ctx, granter, grantee sdk.Address) {
+	store := ctx.KVStore(k.storeKey)
+	_ = store.Delete(types.FeeAllowanceKey(granter, grantee))
+}
+
+// IterateFeeAllowances iterates over the fee allowances of the granter, or over all of them if the granter is nil
+func (k Keeper) IterateFeeAllowances(ctx sdk.Ctx, granter sdk.Address, process func(types.FeeAllowance) (stop bool)) {
+	prefix := types.FeeAllowanceKeyPrefix
+	if granter != nil {
+		prefix = types.FeeAllowancesByGranterKey(granter)
+	}
+	store := ctx.KVStore(k.storeKey)
+	iter, _ := sdk.KVStorePrefixIterator(store, prefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var allowance types.FeeAllowance
+		if err := k.Cdc.UnmarshalBinaryBare(iter.Value(), &allowance, ctx.BlockHeight()); err != nil {
+			ctx.Logger().Error(fmt.Sprintf("error while iterating fee allowances: unmarshalling allowance at height %d: %s", ctx.BlockHeight(), err.Error()))
+			continue
+		}
+		if process(allowance) {
+			return
+		}
+	}
+}
+
+// GetAllFeeAllowances returns every fee allowance in the store, expired ones included
+func (k Keeper) GetAllFeeAllowances(ctx sdk.Ctx) []types.FeeAllowance {
+	allowances := make([]types.FeeAllowance, 0)
+	k.IterateFeeAllowances(ctx, nil, func(allowance types.FeeAllowance) (stop bool) {
+		allowances = append(allowances, allowance)
+		return false
+	})
+	return allowances
+}
+
+// GetActiveFeeAllowances returns the fee allowances that are not expired, filtered by granter and grantee if not nil
+func (k Keeper) GetActiveFeeAllowances(ctx sdk.Ctx, granter, grantee sdk.Address) []types.FeeAllowance {
+	allowances := make([]types.FeeAllowance, 0)
+	k.IterateFeeAllowances(ctx, granter, func(allowance types.FeeAllowance) (stop bool) {
+		if !allowance.IsExpired(ctx.BlockHeight()) && (grantee == nil || grantee.Equals(allowance.Grantee)) {
+			allowances = append(allowances, allowance)
+		}
+		return false
+	})
+	return allowances
+}
+
+// UseFeeAllowance spends the fees from the fee allowance of the granter to the grantee. The allowance is removed once
+// its spend limit is used up
+func (k Keeper) UseFeeAllowance(ctx sdk.Ctx, granter, grantee sdk.Address, fees sdk.Coins) sdk.Error {
+	allowance, found := k.GetFeeAllowance(ctx, granter, grantee)
+	if !found {
+		return types.ErrFeeAllowanceNotFound(types.ModuleName, granter, grantee)
+	}
+	if allowance.IsExpired(ctx.BlockHeight()) {
+		return types.ErrFeeAllowanceExpired(types.ModuleName, allowance.ExpirationHeight)
+	}
+	// an empty spend limit is unlimited
+	if allowance.SpendLimit.Empty() {
+		return nil
+	}
+	remaining, hasNeg := allowance.SpendLimit.SafeSub(fees)
+	if hasNeg {
+		return types.ErrFeeAllowanceExceeded(types.ModuleName, allowance.SpendLimit, fees)
+	}
+	if remaining.IsZero() {
+		k.DeleteFeeAllowance(ctx, granter, grantee)
+		return nil
+	}
+	allowance.SpendLimit = remaining
+	k.SetFeeAllowance(ctx, allowance)
+	return nil
+}
@@ -0,0 +1,66 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/pokt-network/pocket-core/crypto"
+	sdk "github.com/pokt-network/pocket-core/types"
+	"github.com/pokt-network/pocket-core/x/auth/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUseFeeAllowance(t *testing.T) {
+	ctx, keeper := createTestInput(t, false, initialPower, 0)
+	ctx = ctx.WithBlockHeight(10)
+	granter := sdk.Address(crypto.GenerateEd25519PrivKey().PublicKey().Address())
+	grantee := sdk.Address(crypto.GenerateEd25519PrivKey().PublicKey().Address())
+	coins := func(amount int64) sdk.Coins {
+		return sdk.NewCoins(sdk.NewCoin(sdk.DefaultStakeDenom, sdk.NewInt(amount)))
+	}
+	// not found
+	err := keeper.UseFeeAllowance(ctx, granter, grantee, coins(10))
+	require.NotNil(t, err)
+	require.Equal(t, types.CodeFeeAllowanceNotFound, err.Code())
+	// expired
+	keeper.SetFeeAllowance(ctx, types.NewFeeAllowance(granter, grantee, coins(100), 9))
+	err = keeper.UseFeeAllowance(ctx, granter, grantee, coins(10))
+	require.NotNil(t, err)
+	require.Equal(t, types.CodeFeeAllowanceExpired, err.Code())
+	// exceeded
+	keeper.SetFeeAllowance(ctx, types.NewFeeAllowance(granter, grantee, coins(15), 10))
+	err = keeper.UseFeeAllowance(ctx, granter, grantee, coins(20))
+	require.NotNil(t, err)
+	require.Equal(t, types.CodeFeeAllowanceExceeded, err.Code())
+	// partially spent
+	require.Nil(t, keeper.UseFeeAllowance(ctx, granter, grantee, coins(10)))
+	allowance, found := keeper.GetFeeAllowance(ctx, granter, grantee)
+	require.True(t, found)
+	require.Equal(t, coins(5), allowance.SpendLimit)
+	// used up and removed
+	require.Nil(t, keeper.UseFeeAllowance(ctx, granter, grantee, coins(5)))
+	_, found = keeper.GetFeeAllowance(ctx, granter, grantee)
+	require.False(t, found)
+	// unlimited
+	keeper.SetFeeAllowance(ctx, types.NewFeeAllowance(granter, grantee, nil, 0))
+	require.Nil(t, keeper.UseFeeAllowance(ctx, granter, grantee, coins(1000000)))
+	_, found = keeper.GetFeeAllowance(ctx, granter, grantee)
+	require.True(t, found)
+}
+
+func TestGetActiveFeeAllowances(t *testing.T) {
+	ctx, keeper := createTestInput(t, false, initialPower, 0)
+	ctx = ctx.WithBlockHeight(10)
+	a := sdk.Address(crypto.GenerateEd25519PrivKey().PublicKey().Address())
+	b := sdk.Address(crypto.GenerateEd25519PrivKey().PublicKey().Address())
+	c := sdk.Address(crypto.GenerateEd25519PrivKey().PublicKey().Address())
+	keeper.SetFeeAllowance(ctx, types.NewFeeAllowance(a, b, nil, 0))
+	keeper.SetFeeAllowance(ctx, types.NewFeeAllowance(a, c, nil, 9)) // expired
+	keeper.SetFeeAllowance(ctx, types.NewFeeAllowance(b, c, nil, 10))
+	require.Len(t, keeper.GetAllFeeAllowances(ctx), 3)
+	require.Len(t, keeper.GetActiveFeeAllowances(ctx, nil, nil), 2)
+	require.Len(t, keeper.GetActiveFeeAllowances(ctx, a, nil), 1)
+	require.Len(t, keeper.GetActiveFeeAllowances(ctx, nil, c), 1)
+	require.Len(t, keeper.GetActiveFeeAllowances(ctx, a, c), 0)
+	keeper.DeleteFeeAllowance(ctx, a, b)
+	require.Len(t, keeper.GetActiveFeeAllowances(ctx, a, nil), 0)
+}
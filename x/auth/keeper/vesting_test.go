@@ -0,0 +1,88 @@
+package keeper
+
+import (
+	"testing"
+	"time"
+
+	"github.com/pokt-network/pocket-core/crypto"
+	sdk "github.com/pokt-network/pocket-core/types"
+	"github.com/pokt-network/pocket-core/x/auth/exported"
+	"github.com/pokt-network/pocket-core/x/auth/types"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestVestingAccount(amount int64, endTime int64) *types.DelayedVestingAccount {
+	pk := crypto.GenerateEd25519PrivKey().PublicKey()
+	acc := types.NewBaseAccountWithAddress(sdk.Address(pk.Address()))
+	acc.PubKey = pk
+	acc.Coins = sdk.NewCoins(sdk.NewCoin(sdk.DefaultStakeDenom, sdk.NewInt(amount)))
+	return types.NewDelayedVestingAccount(&acc, acc.Coins, endTime)
+}
+
+func TestVestingAccount_Store(t *testing.T) {
+	for _, height := range []int64{1, 40000} { // before and after the codec upgrade
+		ctx, keeper := createTestInput(t, false, initialPower, 0)
+		ctx = ctx.WithBlockHeight(height)
+		dva := newTestVestingAccount(100, 1000)
+		keeper.SetAccount(ctx, dva)
+		cva := types.NewContinuousVestingAccount(newTestVestingAccount(100, 1000).BaseAccount, dva.Coins, 10, 1000)
+		keeper.SetAccount(ctx, cva)
+		resD, ok := keeper.GetAccount(ctx, dva.GetAddress()).(*types.DelayedVestingAccount)
+		require.True(t, ok)
+		require.Equal(t, dva, resD)
+		resC, ok := keeper.GetAccount(ctx, cva.GetAddress()).(*types.ContinuousVestingAccount)
+		require.True(t, ok)
+		require.Equal(t, cva, resC)
+		require.Len(t, keeper.GetAllAccounts(ctx), 2)
+	}
+}
+
+func TestVestingAccount_SendAndDelegate(t *testing.T) {
+	ctx, keeper := createTestInput(t, false, initialPower, 0)
+	end := time.Unix(1000, 0)
+	ctx = ctx.WithBlockTime(end.Add(-time.Second))
+	dva := newTestVestingAccount(100, end.Unix())
+	keeper.SetAccount(ctx, dva)
+	recipient := sdk.Address(crypto.GenerateEd25519PrivKey().PublicKey().Address())
+	coins := func(amount int64) sdk.Coins {
+		return sdk.NewCoins(sdk.NewCoin(sdk.DefaultStakeDenom, sdk.NewInt(amount)))
+	}
+	// the locked coins can't be sent
+	require.NotNil(t, keeper.SendCoins(ctx, dva.GetAddress(), recipient, coins(1)))
+	// but they can be staked
+	require.Nil(t, keeper.DelegateCoinsFromAccountToModule(ctx, dva.GetAddress(), holder, coins(60)))
+	acc := keeper.GetAccount(ctx, dva.GetAddress()).(exported.VestingAccount)
+	require.Equal(t, coins(40), acc.GetCoins())
+	require.Equal(t, coins(60), acc.GetDelegatedVesting())
+	require.Equal(t, coins(60), getCoinsByName(ctx, keeper, holder))
+	require.NotNil(t, keeper.DelegateCoinsFromAccountToModule(ctx, dva.GetAddress(), holder, coins(41)))
+	// and are locked again once unstaked
+	require.Nil(t, keeper.UndelegateCoinsFromModuleToAccount(ctx, holder, dva.GetAddress(), coins(60)))
+	acc = keeper.GetAccount(ctx, dva.GetAddress()).(exported.VestingAccount)
+	require.Equal(t, coins(100), acc.GetCoins())
+	require.True(t, acc.GetDelegatedVesting().Empty())
+	require.NotNil(t, keeper.SendCoins(ctx, dva.GetAddress(), recipient, coins(1)))
+	// once vested the coins can be sent
+	ctx = ctx.WithBlockTime(end)
+	require.Nil(t, keeper.SendCoins(ctx, dva.GetAddress(), recipient, coins(100)))
+	// the non vesting accounts stake as before
+	require.Nil(t, keeper.DelegateCoinsFromAccountToModule(ctx, recipient, holder, coins(100)))
+	require.Nil(t, keeper.UndelegateCoinsFromModuleToAccount(ctx, holder, recipient, coins(100)))
+	require.Equal(t, coins(100), keeper.GetCoins(ctx, recipient))
+}
+
+func TestVestingAccount_Export(t *testing.T) {
+	ctx, keeper := createTestInput(t, false, initialPower, 0)
+	dva := newTestVestingAccount(100, 1000)
+	keeper.SetAccount(ctx, dva)
+	// a vesting account with every coin staked is still exported
+	require.Nil(t, keeper.DelegateCoinsFromAccountToModule(ctx, dva.GetAddress(), holder, dva.Coins))
+	found := false
+	for _, acc := range keeper.GetAllAccountsExport(ctx) {
+		if acc.GetAddress().Equals(dva.GetAddress()) {
+			found = true
+			require.Equal(t, dva.Coins, acc.(exported.VestingAccount).GetDelegatedVesting())
+		}
+	}
+	require.True(t, found)
+}
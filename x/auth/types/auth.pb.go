@@ -302,11 +302,12 @@ func (m *Supply) XXX_DiscardUnknown() {
 var xxx_messageInfo_Supply proto.InternalMessageInfo
 
 type ProtoStdTx struct {
-	Msg       types1.Any                                      `protobuf:"bytes,1,opt,name=msg,proto3" json:"msg" yaml:"msg"`
-	Fee       github_com_pokt_network_pocket_core_types.Coins `protobuf:"bytes,2,rep,name=fee,proto3,castrepeated=github.com/pokt-network/pocket-core/types.Coins" json:"fee" yaml:"fee"`
-	Signature ProtoStdSignature                               `protobuf:"bytes,3,opt,name=signature,proto3,casttype=ProtoStdSignature" json:"signature" yaml:"signature"`
-	Memo      string                                          `protobuf:"bytes,4,opt,name=memo,proto3" json:"memo" yaml:"memo"`
-	Entropy   int64                                           `protobuf:"varint,5,opt,name=entropy,proto3" json:"entropy" yaml:"entropy"`
+	Msg       types1.Any                                        `protobuf:"bytes,1,opt,name=msg,proto3" json:"msg" yaml:"msg"`
+	Fee       github_com_pokt_network_pocket_core_types.Coins   `protobuf:"bytes,2,rep,name=fee,proto3,castrepeated=github.com/pokt-network/pocket-core/types.Coins" json:"fee" yaml:"fee"`
+	Signature ProtoStdSignature                                 `protobuf:"bytes,3,opt,name=signature,proto3,casttype=ProtoStdSignature" json:"signature" yaml:"signature"`
+	Memo      string                                            `protobuf:"bytes,4,opt,name=memo,proto3" json:"memo" yaml:"memo"`
+	Entropy   int64                                             `protobuf:"varint,5,opt,name=entropy,proto3" json:"entropy" yaml:"entropy"`
+	FeePayer  github_com_pokt_network_pocket_core_types.Address `protobuf:"bytes,6,opt,name=fee_payer,json=feePayer,proto3,casttype=github.com/pokt-network/pocket-core/types.Address" json:"fee_payer,omitempty" yaml:"fee_payer"`
 }
 
 func (m *ProtoStdTx) Reset()         { *m = ProtoStdTx{} }
@@ -403,11 +404,12 @@ func (*ProtoStdSignature) XXX_MessageName() string {
 }
 
 type StdSignDoc struct {
-	ChainID string                                        `protobuf:"bytes,1,opt,name=ChainID,proto3" json:"chain_id" yaml:"chain_id"`
-	Fee     github_com_pokt_network_pocket_core_types.Raw `protobuf:"bytes,2,opt,name=fee,proto3,casttype=github.com/pokt-network/pocket-core/types.Raw" json:"fee" yaml:"fee"`
-	Memo    string                                        `protobuf:"bytes,3,opt,name=memo,proto3" json:"memo" yaml:"memo"`
-	Msg     github_com_pokt_network_pocket_core_types.Raw `protobuf:"bytes,4,opt,name=msg,proto3,casttype=github.com/pokt-network/pocket-core/types.Raw" json:"msg" yaml:"msg"`
-	Entropy int64                                         `protobuf:"varint,5,opt,name=entropy,proto3" json:"entropy" yaml:"entropy"`
+	ChainID  string                                            `protobuf:"bytes,1,opt,name=ChainID,proto3" json:"chain_id" yaml:"chain_id"`
+	Fee      github_com_pokt_network_pocket_core_types.Raw     `protobuf:"bytes,2,opt,name=fee,proto3,casttype=github.com/pokt-network/pocket-core/types.Raw" json:"fee" yaml:"fee"`
+	Memo     string                                            `protobuf:"bytes,3,opt,name=memo,proto3" json:"memo" yaml:"memo"`
+	Msg      github_com_pokt_network_pocket_core_types.Raw     `protobuf:"bytes,4,opt,name=msg,proto3,casttype=github.com/pokt-network/pocket-core/types.Raw" json:"msg" yaml:"msg"`
+	Entropy  int64                                             `protobuf:"varint,5,opt,name=entropy,proto3" json:"entropy" yaml:"entropy"`
+	FeePayer github_com_pokt_network_pocket_core_types.Address `protobuf:"bytes,6,opt,name=fee_payer,json=feePayer,proto3,casttype=github.com/pokt-network/pocket-core/types.Address" json:"fee_payer,omitempty" yaml:"fee_payer"`
 }
 
 func (m *StdSignDoc) Reset()         { *m = StdSignDoc{} }
@@ -461,68 +463,70 @@ func init() {
 func init() { proto.RegisterFile("x/auth/auth.proto", fileDescriptor_840f82faebe7fabc) }
 
 var fileDescriptor_840f82faebe7fabc = []byte{
-	// 967 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x56, 0xcf, 0x6f, 0x1b, 0x45,
-	0x14, 0xf6, 0xc6, 0x49, 0x1c, 0x8f, 0xdb, 0x90, 0x0c, 0xa6, 0xb5, 0x8b, 0xea, 0x29, 0x83, 0x2a,
-	0x55, 0x42, 0xd9, 0x85, 0x72, 0x88, 0x30, 0x48, 0x34, 0xdb, 0x52, 0x04, 0x01, 0xa9, 0x5a, 0x73,
-	0xa8, 0x72, 0xb1, 0xf6, 0xc7, 0x78, 0xb3, 0xf2, 0xee, 0xce, 0xca, 0xbb, 0xab, 0xc4, 0xff, 0x41,
-	0x4f, 0x88, 0x1b, 0x88, 0x53, 0xca, 0x91, 0x33, 0x17, 0xfe, 0x83, 0x1e, 0x23, 0x4e, 0x9c, 0x06,
-	0x94, 0x5c, 0x90, 0xb9, 0xed, 0x31, 0x27, 0x34, 0x3f, 0x36, 0x6b, 0x27, 0x48, 0x44, 0x41, 0x82,
-	0x8b, 0x35, 0xef, 0xdb, 0xf7, 0xde, 0x7c, 0xf3, 0xcd, 0xf7, 0x46, 0x06, 0x9b, 0x87, 0x86, 0x9d,
-	0x67, 0xfb, 0xe2, 0x47, 0x4f, 0x26, 0x34, 0xa3, 0x70, 0xf5, 0x50, 0xe7, 0xd1, 0x9d, 0xae, 0x4b,
-	0xd3, 0x88, 0xa6, 0x43, 0x81, 0x1a, 0x32, 0x90, 0x29, 0x77, 0xda, 0x3e, 0xf5, 0xa9, 0xc4, 0xf9,
-	0x4a, 0xa1, 0x1b, 0xd9, 0x34, 0x21, 0xa9, 0xe1, 0xd2, 0x20, 0x56, 0x48, 0xd7, 0xa7, 0xd4, 0x0f,
-	0x89, 0x21, 0x22, 0x27, 0x1f, 0x19, 0x76, 0x3c, 0x95, 0x9f, 0xf0, 0xcb, 0x25, 0xb0, 0xf1, 0x8c,
-	0xaf, 0x4c, 0x3b, 0x25, 0x3b, 0xae, 0x4b, 0xf3, 0x38, 0x83, 0x7b, 0xa0, 0x61, 0x7b, 0xde, 0x84,
-	0xa4, 0x69, 0x47, 0xbb, 0xa7, 0x3d, 0xb8, 0x61, 0x3e, 0x9a, 0x31, 0x54, 0x42, 0x67, 0x0c, 0xbd,
-	0xe7, 0x07, 0xd9, 0x7e, 0xee, 0xe8, 0x2e, 0x8d, 0x8c, 0x84, 0x8e, 0xb3, 0xad, 0x98, 0x64, 0x07,
-	0x74, 0x32, 0x36, 0x12, 0xea, 0x8e, 0x49, 0xb6, 0xe5, 0xd2, 0x09, 0x31, 0x04, 0x0b, 0x7d, 0x47,
-	0x16, 0x59, 0x65, 0x35, 0xfc, 0x08, 0x34, 0x92, 0xdc, 0x19, 0x8e, 0xc9, 0xb4, 0xb3, 0x24, 0x7a,
-	0xbf, 0x3d, 0x63, 0x08, 0x24, 0xb9, 0x13, 0x06, 0x2e, 0x47, 0x0b, 0x86, 0x36, 0xa7, 0x76, 0x14,
-	0xf6, 0x71, 0x85, 0x61, 0x6b, 0x35, 0xc9, 0x9d, 0x5d, 0x32, 0x85, 0x7b, 0x60, 0x85, 0x9f, 0x2b,
-	0xed, 0xd4, 0xef, 0xd5, 0x1f, 0xb4, 0x1e, 0xb6, 0x74, 0xb9, 0xcb, 0x63, 0x1a, 0xc4, 0xe6, 0xf6,
-	0x2b, 0x86, 0x6a, 0x3f, 0xfe, 0x86, 0x8c, 0xab, 0xb3, 0xe3, 0x75, 0xa9, 0x25, 0x5b, 0xf6, 0x6f,
-	0xbf, 0x38, 0x42, 0xb5, 0xef, 0x8e, 0x90, 0xf6, 0xe2, 0x07, 0xa4, 0xfd, 0xf2, 0xd3, 0x56, 0x43,
-	0xc9, 0x81, 0xbf, 0x5f, 0x02, 0x50, 0x68, 0xf4, 0x25, 0xf5, 0xf2, 0xf0, 0x5c, 0x25, 0x0a, 0x6e,
-	0x39, 0x76, 0x4a, 0x86, 0xb6, 0x8c, 0x87, 0x24, 0x76, 0xa9, 0x67, 0x3b, 0x21, 0x11, 0xa2, 0xb5,
-	0x1e, 0x76, 0x74, 0x79, 0x83, 0xfa, 0x45, 0x7d, 0x4d, 0xc4, 0x99, 0x1e, 0x33, 0xa4, 0x15, 0x0c,
-	0xbd, 0x2e, 0x0f, 0x3b, 0xdf, 0x09, 0x5b, 0x6d, 0xa7, 0xca, 0xfe, 0xa4, 0x6c, 0x0b, 0xdf, 0x01,
-	0xcb, 0xb1, 0x1d, 0x11, 0xa1, 0x5b, 0xd3, 0xbc, 0x3d, 0x63, 0x48, 0xc4, 0x05, 0x43, 0x2d, 0xd9,
-	0x84, 0x47, 0xd8, 0x12, 0x20, 0xfc, 0x14, 0xb4, 0x12, 0x32, 0x89, 0x82, 0x34, 0x0d, 0xa8, 0xd2,
-	0xab, 0x69, 0xde, 0x9f, 0x31, 0x34, 0x0f, 0x17, 0x0c, 0x41, 0x25, 0x76, 0x05, 0x62, 0x6b, 0x3e,
-	0xa5, 0x7f, 0xf7, 0x82, 0x2c, 0x37, 0x17, 0x54, 0xc0, 0x3f, 0x2f, 0x81, 0xb6, 0x14, 0x27, 0x0f,
-	0xb3, 0x60, 0x10, 0xf8, 0xff, 0x85, 0x89, 0x9e, 0x5d, 0x34, 0xd1, 0xf6, 0x8c, 0xa1, 0x76, 0x65,
-	0x98, 0x61, 0xc4, 0xc9, 0x0c, 0xd3, 0xc0, 0x2f, 0x18, 0x7a, 0xf3, 0xa2, 0x9d, 0xaa, 0xaf, 0xff,
-	0xb3, 0xb1, 0x3c, 0x70, 0xf3, 0x29, 0x21, 0x42, 0xb8, 0x24, 0x0c, 0xc8, 0x04, 0x76, 0x41, 0x9d,
-	0x9f, 0x49, 0x13, 0x17, 0xdc, 0x98, 0x31, 0xc4, 0x43, 0x8b, 0xff, 0x40, 0x1d, 0x80, 0xe8, 0x3c,
-	0x51, 0x9c, 0xba, 0x6e, 0xae, 0xf3, 0xd1, 0xa9, 0x50, 0x6b, 0x6e, 0xdd, 0x5f, 0xe3, 0x1b, 0xfe,
-	0x71, 0x84, 0x34, 0xfc, 0xb5, 0x06, 0xd6, 0x17, 0xb6, 0x49, 0xe1, 0x2e, 0x68, 0x8e, 0x14, 0xc2,
-	0x6f, 0x87, 0x9f, 0xf8, 0x8d, 0xd2, 0xad, 0x0b, 0xa9, 0xe6, 0x2d, 0x7e, 0xf6, 0x19, 0x43, 0xeb,
-	0x23, 0x42, 0x86, 0x73, 0x5b, 0x55, 0xf5, 0xf0, 0x3e, 0x68, 0x78, 0x64, 0x64, 0xe7, 0x61, 0xa6,
-	0x68, 0xb5, 0xf8, 0x45, 0x2b, 0xc8, 0x2a, 0x17, 0x73, 0x84, 0x0e, 0xc0, 0xea, 0x20, 0x4f, 0x92,
-	0x70, 0x0a, 0x5d, 0xb0, 0x92, 0xd1, 0xcc, 0x0e, 0x15, 0x87, 0x05, 0xd5, 0x1f, 0xa9, 0x9d, 0x65,
-	0xc6, 0xb5, 0xe4, 0x17, 0x95, 0xfd, 0x35, 0x25, 0x7f, 0x0d, 0xbf, 0xac, 0x03, 0x20, 0xbc, 0x3a,
-	0xc8, 0xbc, 0xaf, 0x0e, 0xe1, 0x0e, 0xa8, 0x47, 0xa9, 0xaf, 0xa6, 0xb5, 0xad, 0xcb, 0x47, 0x52,
-	0x2f, 0x1f, 0x49, 0x7d, 0x27, 0x9e, 0x9a, 0x5d, 0x45, 0x82, 0x27, 0x16, 0x0c, 0x01, 0x69, 0xa5,
-	0x28, 0xf5, 0xb1, 0xc5, 0x21, 0x38, 0x06, 0xf5, 0x11, 0xe1, 0x13, 0x79, 0x89, 0xfe, 0x17, 0x65,
-	0xe5, 0x88, 0x90, 0xaa, 0x72, 0x44, 0x08, 0xbe, 0xce, 0x51, 0x78, 0x17, 0x98, 0x82, 0x66, 0x1a,
-	0xf8, 0xb1, 0x9d, 0xe5, 0x13, 0xd2, 0xa9, 0x0b, 0xd6, 0xdd, 0x85, 0x37, 0x66, 0x90, 0x79, 0x83,
-	0x32, 0xc1, 0xec, 0x2b, 0x02, 0x55, 0x4d, 0xc1, 0xd0, 0x86, 0xa4, 0x71, 0x0e, 0xe1, 0x33, 0x86,
-	0x36, 0x2f, 0xd5, 0x5a, 0x55, 0x0d, 0x7f, 0x74, 0x22, 0x12, 0xd1, 0xce, 0x72, 0xf5, 0xe8, 0xf0,
-	0xb8, 0x7a, 0x74, 0x78, 0x84, 0x2d, 0x01, 0xc2, 0x6d, 0xd0, 0x20, 0x71, 0x36, 0xa1, 0xc9, 0xb4,
-	0xb3, 0x22, 0xac, 0x70, 0x97, 0x5b, 0x41, 0x41, 0x05, 0x43, 0xeb, 0xb2, 0x44, 0x01, 0xd8, 0x2a,
-	0x3f, 0xc9, 0x3b, 0xe2, 0xe3, 0x81, 0xbf, 0xd5, 0xc0, 0x65, 0x42, 0xf0, 0x43, 0xd0, 0x94, 0xf3,
-	0xbb, 0xab, 0xc6, 0xe3, 0x86, 0x6c, 0xad, 0x5e, 0x81, 0xaa, 0xb5, 0x02, 0xb0, 0x55, 0xe5, 0xc3,
-	0x8f, 0x41, 0xf3, 0xbc, 0x93, 0x7a, 0x2f, 0xde, 0xfa, 0x47, 0x61, 0xac, 0xaa, 0xa6, 0xbf, 0x2c,
-	0x98, 0xfd, 0xb9, 0x04, 0x80, 0x22, 0xf5, 0x84, 0xba, 0xf0, 0x03, 0xd0, 0x78, 0xbc, 0x6f, 0x07,
-	0xf1, 0x67, 0x4f, 0xd4, 0xbc, 0xa2, 0x19, 0x43, 0x6b, 0x2e, 0x87, 0x86, 0x81, 0x57, 0x30, 0xf4,
-	0x9a, 0x6c, 0x59, 0x22, 0xd8, 0x2a, 0xf3, 0xe1, 0xf3, 0xd2, 0x35, 0x9c, 0xca, 0xd3, 0xbf, 0x35,
-	0xc9, 0x19, 0x43, 0x5b, 0x57, 0x37, 0x89, 0x65, 0x1f, 0x48, 0x8b, 0x94, 0xb7, 0x55, 0xbf, 0xca,
-	0x6d, 0x3d, 0x97, 0xfe, 0x5f, 0xae, 0x68, 0x5c, 0x72, 0xf9, 0x35, 0x68, 0xf0, 0xb1, 0xf8, 0xf7,
-	0x3e, 0x30, 0x3f, 0x7f, 0x75, 0xd2, 0xd3, 0x8e, 0x4f, 0x7a, 0xda, 0xef, 0x27, 0x3d, 0xed, 0x9b,
-	0xd3, 0x5e, 0xed, 0xf8, 0xb4, 0x57, 0xfb, 0xf5, 0xb4, 0x57, 0xdb, 0x7b, 0xf7, 0x2a, 0x84, 0xd4,
-	0xff, 0x29, 0xc1, 0xcb, 0x59, 0x15, 0x33, 0xfd, 0xfe, 0x5f, 0x01, 0x00, 0x00, 0xff, 0xff, 0xdf,
-	0x08, 0xe4, 0xc3, 0x66, 0x09, 0x00, 0x00,
+	// 1005 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x56, 0xbf, 0x6f, 0x23, 0x45,
+	0x14, 0xf6, 0xc6, 0x8e, 0x1d, 0x8f, 0xef, 0x42, 0x32, 0x67, 0xee, 0xec, 0x43, 0xe7, 0x39, 0x06,
+	0x9d, 0x74, 0x12, 0x64, 0x0d, 0x47, 0x11, 0x61, 0x90, 0xb8, 0xec, 0x1d, 0x87, 0x20, 0x20, 0x45,
+	0x6b, 0x8a, 0x53, 0x1a, 0xb3, 0x5e, 0x8f, 0x37, 0x2b, 0xef, 0xee, 0xac, 0xbc, 0xbb, 0x4a, 0xb6,
+	0xa0, 0xbf, 0x0a, 0xd1, 0x81, 0xa8, 0x02, 0x25, 0x35, 0x0d, 0xff, 0xc1, 0x95, 0x11, 0x15, 0xd5,
+	0x80, 0x92, 0x06, 0x6d, 0xe9, 0x32, 0x15, 0x9a, 0x1f, 0xeb, 0x75, 0x62, 0x24, 0xa2, 0x14, 0x20,
+	0x1a, 0x6b, 0xdf, 0x37, 0xef, 0xbd, 0x79, 0xef, 0x9b, 0xef, 0x3d, 0x19, 0x6c, 0x1e, 0x75, 0xad,
+	0x24, 0x3e, 0x10, 0x3f, 0x7a, 0x38, 0xa5, 0x31, 0x85, 0xd5, 0x23, 0x9d, 0x5b, 0x77, 0xdb, 0x36,
+	0x8d, 0x7c, 0x1a, 0x0d, 0x04, 0xda, 0x95, 0x86, 0x74, 0xb9, 0xdb, 0x74, 0xa8, 0x43, 0x25, 0xce,
+	0xbf, 0x14, 0xba, 0x11, 0xa7, 0x21, 0x89, 0xba, 0x36, 0x75, 0x03, 0x85, 0xb4, 0x1d, 0x4a, 0x1d,
+	0x8f, 0x74, 0x85, 0x35, 0x4c, 0xc6, 0x5d, 0x2b, 0x48, 0xe5, 0x11, 0xfe, 0x61, 0x05, 0x6c, 0xec,
+	0xf1, 0x2f, 0xc3, 0x8a, 0xc8, 0x8e, 0x6d, 0xd3, 0x24, 0x88, 0xe1, 0x3e, 0xa8, 0x59, 0xa3, 0xd1,
+	0x94, 0x44, 0x51, 0x4b, 0xbb, 0xaf, 0x3d, 0xbc, 0x61, 0x3c, 0xce, 0x18, 0xca, 0xa1, 0x73, 0x86,
+	0xde, 0x71, 0xdc, 0xf8, 0x20, 0x19, 0xea, 0x36, 0xf5, 0xbb, 0x21, 0x9d, 0xc4, 0x5b, 0x01, 0x89,
+	0x0f, 0xe9, 0x74, 0xd2, 0x0d, 0xa9, 0x3d, 0x21, 0xf1, 0x96, 0x4d, 0xa7, 0xa4, 0x2b, 0xaa, 0xd0,
+	0x77, 0x64, 0x90, 0x99, 0x47, 0xc3, 0x0f, 0x40, 0x2d, 0x4c, 0x86, 0x83, 0x09, 0x49, 0x5b, 0x2b,
+	0x22, 0xf7, 0x1b, 0x19, 0x43, 0x20, 0x4c, 0x86, 0x9e, 0x6b, 0x73, 0x74, 0xc6, 0xd0, 0x66, 0x6a,
+	0xf9, 0x5e, 0x0f, 0x17, 0x18, 0x36, 0xab, 0x61, 0x32, 0xdc, 0x25, 0x29, 0xdc, 0x07, 0xab, 0xbc,
+	0xaf, 0xa8, 0x55, 0xbe, 0x5f, 0x7e, 0xd8, 0x78, 0xd4, 0xd0, 0xe5, 0x2d, 0x4f, 0xa8, 0x1b, 0x18,
+	0xdb, 0x2f, 0x19, 0x2a, 0xfd, 0xf4, 0x3b, 0xea, 0x5e, 0xbd, 0x3a, 0x1e, 0x17, 0x99, 0x32, 0x65,
+	0xef, 0xce, 0x8b, 0x63, 0x54, 0xfa, 0xee, 0x18, 0x69, 0x2f, 0x7e, 0x44, 0xda, 0xaf, 0x3f, 0x6f,
+	0xd5, 0x14, 0x1d, 0xf8, 0xfb, 0x15, 0x00, 0x05, 0x47, 0x9f, 0xd3, 0x51, 0xe2, 0xcd, 0x59, 0xa2,
+	0xe0, 0xf6, 0xd0, 0x8a, 0xc8, 0xc0, 0x92, 0xf6, 0x80, 0x04, 0x36, 0x1d, 0x59, 0x43, 0x8f, 0x08,
+	0xd2, 0x1a, 0x8f, 0x5a, 0xba, 0x7c, 0x41, 0xfd, 0x32, 0xbf, 0x06, 0xe2, 0x95, 0x9e, 0x30, 0xa4,
+	0xcd, 0x18, 0xba, 0x25, 0x9b, 0x5d, 0xcc, 0x84, 0xcd, 0xe6, 0xb0, 0xf0, 0xfe, 0x28, 0x4f, 0x0b,
+	0xdf, 0x04, 0x95, 0xc0, 0xf2, 0x89, 0xe0, 0xad, 0x6e, 0xdc, 0xc9, 0x18, 0x12, 0xf6, 0x8c, 0xa1,
+	0x86, 0x4c, 0xc2, 0x2d, 0x6c, 0x0a, 0x10, 0x7e, 0x0c, 0x1a, 0x21, 0x99, 0xfa, 0x6e, 0x14, 0xb9,
+	0x54, 0xf1, 0x55, 0x37, 0x1e, 0x64, 0x0c, 0x2d, 0xc2, 0x33, 0x86, 0xa0, 0x22, 0xbb, 0x00, 0xb1,
+	0xb9, 0xe8, 0xd2, 0xbb, 0x77, 0x89, 0x96, 0x9b, 0x17, 0x58, 0xc0, 0xbf, 0xac, 0x80, 0xa6, 0x24,
+	0x27, 0xf1, 0x62, 0xb7, 0xef, 0x3a, 0xff, 0x86, 0x88, 0xf6, 0x2e, 0x8b, 0x68, 0x3b, 0x63, 0xa8,
+	0x59, 0x08, 0x66, 0xe0, 0xf3, 0x62, 0x06, 0x91, 0xeb, 0xcc, 0x18, 0x7a, 0xed, 0xb2, 0x9c, 0x8a,
+	0xd3, 0xff, 0x58, 0x58, 0x23, 0x70, 0xf3, 0x19, 0x21, 0x82, 0xb8, 0xd0, 0x73, 0xc9, 0x14, 0xb6,
+	0x41, 0x99, 0xf7, 0xa4, 0x89, 0x07, 0xae, 0x65, 0x0c, 0x71, 0xd3, 0xe4, 0x3f, 0x50, 0x07, 0xc0,
+	0x9f, 0x3b, 0x8a, 0xae, 0xcb, 0xc6, 0x3a, 0x1f, 0x9d, 0x02, 0x35, 0x17, 0xbe, 0x7b, 0x6b, 0xfc,
+	0xc2, 0x3f, 0x8f, 0x91, 0x86, 0xbf, 0xd6, 0xc0, 0xfa, 0x85, 0x6b, 0x22, 0xb8, 0x0b, 0xea, 0x63,
+	0x85, 0xf0, 0xd7, 0xe1, 0x1d, 0xbf, 0x9a, 0xab, 0xf5, 0x82, 0xab, 0x71, 0x9b, 0xf7, 0x9e, 0x31,
+	0xb4, 0x3e, 0x26, 0x64, 0xb0, 0x70, 0x55, 0x11, 0x0f, 0x1f, 0x80, 0xda, 0x88, 0x8c, 0xad, 0xc4,
+	0x8b, 0x55, 0x59, 0x0d, 0xfe, 0xd0, 0x0a, 0x32, 0xf3, 0x8f, 0x85, 0x82, 0x0e, 0x41, 0xb5, 0x9f,
+	0x84, 0xa1, 0x97, 0x42, 0x1b, 0xac, 0xc6, 0x34, 0xb6, 0xbc, 0x96, 0xb6, 0xcc, 0xfa, 0x63, 0x75,
+	0xb3, 0xf4, 0xb8, 0x16, 0xfd, 0x22, 0xb2, 0xb7, 0xa6, 0xe8, 0x2f, 0xe1, 0xe3, 0x0a, 0x00, 0x42,
+	0xab, 0xfd, 0x78, 0xf4, 0xc5, 0x11, 0xdc, 0x01, 0x65, 0x3f, 0x72, 0xd4, 0xb4, 0x36, 0x75, 0xb9,
+	0x24, 0xf5, 0x7c, 0x49, 0xea, 0x3b, 0x41, 0x6a, 0xb4, 0x55, 0x11, 0xdc, 0x71, 0xc6, 0x10, 0x90,
+	0x52, 0xf2, 0x23, 0x07, 0x9b, 0x1c, 0x82, 0x13, 0x50, 0x1e, 0x13, 0x3e, 0x91, 0x4b, 0xe5, 0x7f,
+	0x96, 0x47, 0x8e, 0x09, 0x29, 0x22, 0xc7, 0x84, 0xe0, 0xeb, 0xb4, 0xc2, 0xb3, 0xc0, 0x08, 0xd4,
+	0x23, 0xd7, 0x09, 0xac, 0x38, 0x99, 0x92, 0x56, 0x59, 0x54, 0xdd, 0xbe, 0xb0, 0x63, 0xfa, 0xf1,
+	0xa8, 0x9f, 0x3b, 0x18, 0x3d, 0x55, 0x40, 0x11, 0x33, 0x63, 0x68, 0x43, 0x96, 0x31, 0x87, 0xf0,
+	0x39, 0x43, 0x9b, 0x4b, 0xb1, 0x66, 0x11, 0xc3, 0x97, 0x8e, 0x4f, 0x7c, 0xda, 0xaa, 0x14, 0x4b,
+	0x87, 0xdb, 0xc5, 0xd2, 0xe1, 0x16, 0x36, 0x05, 0x08, 0xb7, 0x41, 0x8d, 0x04, 0xf1, 0x94, 0x86,
+	0x69, 0x6b, 0x55, 0x48, 0xe1, 0x1e, 0x97, 0x82, 0x82, 0x66, 0x0c, 0xad, 0xcb, 0x10, 0x05, 0x60,
+	0x33, 0x3f, 0x82, 0x5f, 0x09, 0x41, 0x0e, 0x42, 0x2b, 0x25, 0xd3, 0x56, 0x55, 0x8c, 0xf4, 0x97,
+	0x19, 0x43, 0xb7, 0xe6, 0xe0, 0x5b, 0xd4, 0x77, 0x63, 0xe2, 0x87, 0x71, 0x5a, 0x74, 0x31, 0x3f,
+	0xc4, 0xd7, 0x5b, 0x27, 0x6b, 0x63, 0x42, 0xf6, 0x78, 0xbc, 0x94, 0x08, 0x9f, 0x4e, 0xfc, 0xad,
+	0x06, 0x96, 0xf9, 0x80, 0xef, 0x83, 0xba, 0x5c, 0x1f, 0xbb, 0x6a, 0x3a, 0x6f, 0xc8, 0xce, 0xd4,
+	0x12, 0x2a, 0x3a, 0x53, 0x00, 0x36, 0x0b, 0x7f, 0xf8, 0x21, 0xa8, 0xcf, 0x33, 0xa9, 0x75, 0xf5,
+	0xfa, 0x3f, 0xbe, 0x8b, 0x59, 0xc4, 0xf4, 0x2a, 0xa2, 0xb2, 0xac, 0x0c, 0x80, 0x2a, 0xea, 0x29,
+	0xb5, 0xe1, 0x7b, 0xa0, 0xf6, 0xe4, 0xc0, 0x72, 0x83, 0x4f, 0x9e, 0xaa, 0x75, 0x81, 0x32, 0x86,
+	0xd6, 0x6c, 0x0e, 0x0d, 0xdc, 0xd1, 0x8c, 0xa1, 0x57, 0x64, 0xca, 0x1c, 0xc1, 0x66, 0xee, 0x0f,
+	0x9f, 0xe7, 0xa2, 0xe5, 0xa5, 0x3c, 0xfb, 0x5b, 0x8d, 0x9e, 0x33, 0xb4, 0x75, 0x75, 0x42, 0x4d,
+	0xeb, 0x50, 0x2a, 0x34, 0x17, 0x4b, 0xf9, 0x2a, 0x62, 0x79, 0x2e, 0xc7, 0xaf, 0x52, 0x94, 0xb1,
+	0x34, 0x64, 0xd7, 0x28, 0x83, 0x4f, 0xe5, 0xff, 0x5e, 0x86, 0xc6, 0xa7, 0x2f, 0x4f, 0x3b, 0xda,
+	0xc9, 0x69, 0x47, 0xfb, 0xe3, 0xb4, 0xa3, 0x7d, 0x73, 0xd6, 0x29, 0x9d, 0x9c, 0x75, 0x4a, 0xbf,
+	0x9d, 0x75, 0x4a, 0xfb, 0x6f, 0x5f, 0xe5, 0x02, 0xf5, 0x6f, 0x52, 0xdc, 0x33, 0xac, 0x8a, 0x8d,
+	0xf6, 0xee, 0x5f, 0x03, 0x00, 0xe0, 0xd4, 0x63, 0x16, 0x64, 0x0a, 0x00, 0x00,
 }
 
 func (this *FeeMultiplier) Equal(that interface{}) bool {
@@ -869,6 +873,13 @@ func (m *ProtoStdTx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.FeePayer) > 0 {
+		i -= len(m.FeePayer)
+		copy(dAtA[i:], m.FeePayer)
+		i = encodeVarintAuth(dAtA, i, uint64(len(m.FeePayer)))
+		i--
+		dAtA[i] = 0x32
+	}
 	if m.Entropy != 0 {
 		i = encodeVarintAuth(dAtA, i, uint64(m.Entropy))
 		i--
@@ -975,6 +986,13 @@ func (m *StdSignDoc) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.FeePayer) > 0 {
+		i -= len(m.FeePayer)
+		copy(dAtA[i:], m.FeePayer)
+		i = encodeVarintAuth(dAtA, i, uint64(len(m.FeePayer)))
+		i--
+		dAtA[i] = 0x32
+	}
 	if m.Entropy != 0 {
 		i = encodeVarintAuth(dAtA, i, uint64(m.Entropy))
 		i--
@@ -1161,6 +1179,10 @@ func (m *ProtoStdTx) Size() (n int) {
 	if m.Entropy != 0 {
 		n += 1 + sovAuth(uint64(m.Entropy))
 	}
+	l = len(m.FeePayer)
+	if l > 0 {
+		n += 1 + l + sovAuth(uint64(l))
+	}
 	return n
 }
 
@@ -1206,6 +1228,10 @@ func (m *StdSignDoc) Size() (n int) {
 	if m.Entropy != 0 {
 		n += 1 + sovAuth(uint64(m.Entropy))
 	}
+	l = len(m.FeePayer)
+	if l > 0 {
+		n += 1 + l + sovAuth(uint64(l))
+	}
 	return n
 }
 
@@ -2152,6 +2178,40 @@ func (m *ProtoStdTx) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeePayer", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeePayer = append(m.FeePayer[:0], dAtA[iNdEx:postIndex]...)
+			if m.FeePayer == nil {
+				m.FeePayer = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAuth(dAtA[iNdEx:])
@@ -2477,6 +2537,40 @@ func (m *StdSignDoc) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeePayer", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeePayer = append(m.FeePayer[:0], dAtA[iNdEx:postIndex]...)
+			if m.FeePayer == nil {
+				m.FeePayer = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAuth(dAtA[iNdEx:])
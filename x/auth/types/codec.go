@@ -11,13 +11,21 @@ import (
 // RegisterCodec registers concrete types on the codec
 func RegisterCodec(cdc *codec.Codec) {
 	cdc.RegisterInterface("x.auth.ModuleAccount", (*exported.ModuleAccountI)(nil), &ModuleAccount{})
-	cdc.RegisterInterface("x.auth.Account", (*exported.Account)(nil), &BaseAccount{}, &ModuleAccount{})
+	cdc.RegisterInterface("x.auth.Account", (*exported.Account)(nil), &BaseAccount{}, &ModuleAccount{}, &ContinuousVestingAccount{}, &DelayedVestingAccount{})
 	cdc.RegisterInterface("x.auth.Supply", (*exported.SupplyI)(nil), &Supply{})
 	cdc.RegisterStructure(&BaseAccount{}, "posmint/Account")
 	cdc.RegisterStructure(StdTx{}, "posmint/StdTx")
 	cdc.RegisterStructure(&Supply{}, "posmint/Supply")
 	cdc.RegisterStructure(&ModuleAccount{}, "posmint/ModuleAccount")
+	cdc.RegisterStructure(&ContinuousVestingAccount{}, "posmint/ContinuousVestingAccount")
+	cdc.RegisterStructure(&DelayedVestingAccount{}, "posmint/DelayedVestingAccount")
+	cdc.RegisterStructure(MsgMulti{}, "posmint/MsgMulti")
+	cdc.RegisterStructure(&FeeAllowance{}, "posmint/FeeAllowance")
+	cdc.RegisterStructure(MsgGrantFeeAllowance{}, "posmint/MsgGrantFeeAllowance")
+	cdc.RegisterStructure(MsgRevokeFeeAllowance{}, "posmint/MsgRevokeFeeAllowance")
 	cdc.RegisterImplementation((*sdk.Tx)(nil), &StdTx{})
+	cdc.RegisterImplementation((*sdk.ProtoMsg)(nil), &MsgMulti{}, &MsgGrantFeeAllowance{}, &MsgRevokeFeeAllowance{})
+	cdc.RegisterImplementation((*sdk.Msg)(nil), &MsgMulti{}, &MsgGrantFeeAllowance{}, &MsgRevokeFeeAllowance{})
 	ModuleCdc = cdc
 }
 
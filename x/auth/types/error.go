@@ -7,14 +7,25 @@ import (
 
 // Param module codespace constants
 const (
-	CodeInvalidMemo         sdk.CodeType = 1
-	CodeEmptyPublicKey      sdk.CodeType = 2
-	CodeAccNotFound         sdk.CodeType = 3
-	CodeInsufficientFee     sdk.CodeType = 4
-	CodeSignatureLimit      sdk.CodeType = 5
-	CodeDupTx               sdk.CodeType = 6
-	CodeInsufficientBalance sdk.CodeType = 7
-	CodeTxIndexerNil        sdk.CodeType = 8
+	CodeInvalidMemo          sdk.CodeType = 1
+	CodeEmptyPublicKey       sdk.CodeType = 2
+	CodeAccNotFound          sdk.CodeType = 3
+	CodeInsufficientFee      sdk.CodeType = 4
+	CodeSignatureLimit       sdk.CodeType = 5
+	CodeDupTx                sdk.CodeType = 6
+	CodeInsufficientBalance  sdk.CodeType = 7
+	CodeTxIndexerNil         sdk.CodeType = 8
+	CodeEmptyMultiMsg        sdk.CodeType = 9
+	CodeMultiMsgTooLarge     sdk.CodeType = 10
+	CodeNestedMultiMsg       sdk.CodeType = 11
+	CodeMultiMsgSigner       sdk.CodeType = 12
+	CodeMultiMsgNotActive    sdk.CodeType = 13
+	CodeFeeGrantNotActive    sdk.CodeType = 14
+	CodeFeeAllowanceNotFound sdk.CodeType = 15
+	CodeFeeAllowanceExpired  sdk.CodeType = 16
+	CodeFeeAllowanceExceeded sdk.CodeType = 17
+	CodeInvalidFeeAllowance  sdk.CodeType = 18
+	CodeInvalidVestingAcc    sdk.CodeType = 19
 )
 
 // ErrUnknownSubspace returns an unknown subspace error.
@@ -49,3 +60,47 @@ func ErrDuplicateTx(codespace sdk.CodespaceType, txHash string) sdk.Error {
 func ErrInsufficientBalance(codespace sdk.CodespaceType, signer sdk.Address, neededFee sdk.Coins) sdk.Error {
 	return sdk.NewError(codespace, CodeDupTx, fmt.Sprintf("the signer account : %s, does not have enough coins for the tx. Need %s", signer, neededFee.String()))
 }
+
+func ErrEmptyMultiMsg(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeEmptyMultiMsg, "the multi message has no messages")
+}
+
+func ErrMultiMsgTooLarge(codespace sdk.CodespaceType, max int) sdk.Error {
+	return sdk.NewError(codespace, CodeMultiMsgTooLarge, fmt.Sprintf("the multi message has more than %d messages", max))
+}
+
+func ErrNestedMultiMsg(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeNestedMultiMsg, "a multi message can't contain another multi message")
+}
+
+func ErrMultiMsgSigner(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeMultiMsgSigner, "the messages of a multi message must share a signer")
+}
+
+func ErrMultiMsgNotActive(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeMultiMsgNotActive, "multi message transactions are not activated yet")
+}
+
+func ErrFeeGrantNotActive(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeFeeGrantNotActive, "fee allowances and fee payers are not activated yet")
+}
+
+func ErrFeeAllowanceNotFound(codespace sdk.CodespaceType, granter, grantee sdk.Address) sdk.Error {
+	return sdk.NewError(codespace, CodeFeeAllowanceNotFound, fmt.Sprintf("no fee allowance of %s to %s was found", granter, grantee))
+}
+
+func ErrFeeAllowanceExpired(codespace sdk.CodespaceType, expirationHeight int64) sdk.Error {
+	return sdk.NewError(codespace, CodeFeeAllowanceExpired, fmt.Sprintf("the fee allowance expired at height %d", expirationHeight))
+}
+
+func ErrFeeAllowanceExceeded(codespace sdk.CodespaceType, spendLimit, fees sdk.Coins) sdk.Error {
+	return sdk.NewError(codespace, CodeFeeAllowanceExceeded, fmt.Sprintf("the fees %s exceed the remaining fee allowance %s", fees.String(), spendLimit.String()))
+}
+
+func ErrInvalidFeeAllowance(codespace sdk.CodespaceType, reason string) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidFeeAllowance, fmt.Sprintf("the fee allowance is invalid: %s", reason))
+}
+
+func ErrInvalidVestingAccount(codespace sdk.CodespaceType, reason string) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidVestingAcc, fmt.Sprintf("the vesting account is invalid: %s", reason))
+}
@@ -6,3 +6,11 @@ var (
 	AttributeKeyRecipient = "recipient"
 	AttributeKeySender    = "sender"
 )
+
+// Fee allowance event types
+var (
+	EventTypeGrantFeeAllowance  = "grant_fee_allowance"
+	EventTypeRevokeFeeAllowance = "revoke_fee_allowance"
+	AttributeKeyGranter         = "granter"
+	AttributeKeyGrantee         = "grantee"
+)
@@ -3,6 +3,14 @@ package types
 import "github.com/pokt-network/pocket-core/types"
 
 func (fm FeeMultipliers) GetFee(msg types.Msg) types.BigInt {
+	// the fee of a multi message is the sum of the fees of its messages
+	if msgs, ok := MultiMsgs(msg); ok {
+		fee := types.ZeroInt()
+		for _, m := range msgs {
+			fee = fee.Add(fm.GetFee(m))
+		}
+		return fee
+	}
 	for _, feeMultiplier := range fm.FeeMultis {
 		if feeMultiplier.Key == msg.Type() {
 			return msg.GetFee().Mul(types.NewInt(feeMultiplier.Multiplier))
@@ -0,0 +1,135 @@
+package types
+
+import (
+	sdk "github.com/pokt-network/pocket-core/types"
+)
+
+const (
+	// the types of the fee allowance messages
+	MsgGrantFeeAllowanceName  = "grant_fee_allowance"
+	MsgRevokeFeeAllowanceName = "revoke_fee_allowance"
+	// the fee of the fee allowance messages
+	FeeGrantFee = 10000
+)
+
+// "NewFeeAllowance" - Returns the fee allowance of the granter to the grantee. An empty spend limit is unlimited and a
+// zero expiration height never expires
+func NewFeeAllowance(granter, grantee sdk.Address, spendLimit sdk.Coins, expirationHeight int64) FeeAllowance {
+	return FeeAllowance{
+		Granter:          granter,
+		Grantee:          grantee,
+		SpendLimit:       spendLimit,
+		ExpirationHeight: expirationHeight,
+	}
+}
+
+// "IsExpired" - Returns true if the allowance can no longer be used at the height
+func (fa FeeAllowance) IsExpired(height int64) bool {
+	return fa.ExpirationHeight != 0 && height > fa.ExpirationHeight
+}
+
+// "ValidateBasic" - Storeless validity check for the fee allowance
+func (fa FeeAllowance) ValidateBasic() sdk.Error {
+	if fa.Granter.Empty() || fa.Grantee.Empty() {
+		return ErrInvalidFeeAllowance(DefaultCodespace, "the granter and the grantee can't be empty")
+	}
+	if fa.Granter.Equals(fa.Grantee) {
+		return ErrInvalidFeeAllowance(DefaultCodespace, "the granter can't grant an allowance to itself")
+	}
+	if !fa.SpendLimit.IsValid() {
+		return ErrInvalidFeeAllowance(DefaultCodespace, "the spend limit is invalid: "+fa.SpendLimit.String())
+	}
+	if fa.ExpirationHeight < 0 {
+		return ErrInvalidFeeAllowance(DefaultCodespace, "the expiration height can't be negative")
+	}
+	return nil
+}
+
+// ---------------------------------------------------------------------------------------------------------------------
+
+// "NewMsgGrantFeeAllowance" - Returns the message granting the allowance
+func NewMsgGrantFeeAllowance(granter, grantee sdk.Address, spendLimit sdk.Coins, expirationHeight int64) MsgGrantFeeAllowance {
+	return MsgGrantFeeAllowance{
+		Granter:          granter,
+		Grantee:          grantee,
+		SpendLimit:       spendLimit,
+		ExpirationHeight: expirationHeight,
+	}
+}
+
+// "FeeAllowance" - Returns the fee allowance granted by the message
+func (msg MsgGrantFeeAllowance) FeeAllowance() FeeAllowance {
+	return NewFeeAllowance(msg.Granter, msg.Grantee, msg.SpendLimit, msg.ExpirationHeight)
+}
+
+// "Route" - Returns module router key
+func (msg MsgGrantFeeAllowance) Route() string { return ModuleName }
+
+// "Type" - Returns message name
+func (msg MsgGrantFeeAllowance) Type() string { return MsgGrantFeeAllowanceName }
+
+// "ValidateBasic" - Storeless validity check for the grant message
+func (msg MsgGrantFeeAllowance) ValidateBasic() sdk.Error {
+	return msg.FeeAllowance().ValidateBasic()
+}
+
+// "GetSignBytes" - Encodes the message for signing
+func (msg MsgGrantFeeAllowance) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// "GetSigners" - The granter signs the grant
+func (msg MsgGrantFeeAllowance) GetSigners() []sdk.Address {
+	return []sdk.Address{msg.Granter}
+}
+
+// "GetRecipient" - Returns the grantee of the allowance
+func (msg MsgGrantFeeAllowance) GetRecipient() sdk.Address {
+	return msg.Grantee
+}
+
+// "GetFee" - Returns the fee of the message
+func (msg MsgGrantFeeAllowance) GetFee() sdk.BigInt {
+	return sdk.NewInt(FeeGrantFee)
+}
+
+// ---------------------------------------------------------------------------------------------------------------------
+
+// "NewMsgRevokeFeeAllowance" - Returns the message revoking the allowance
+func NewMsgRevokeFeeAllowance(granter, grantee sdk.Address) MsgRevokeFeeAllowance {
+	return MsgRevokeFeeAllowance{Granter: granter, Grantee: grantee}
+}
+
+// "Route" - Returns module router key
+func (msg MsgRevokeFeeAllowance) Route() string { return ModuleName }
+
+// "Type" - Returns message name
+func (msg MsgRevokeFeeAllowance) Type() string { return MsgRevokeFeeAllowanceName }
+
+// "ValidateBasic" - Storeless validity check for the revoke message
+func (msg MsgRevokeFeeAllowance) ValidateBasic() sdk.Error {
+	if msg.Granter.Empty() || msg.Grantee.Empty() {
+		return ErrInvalidFeeAllowance(DefaultCodespace, "the granter and the grantee can't be empty")
+	}
+	return nil
+}
+
+// "GetSignBytes" - Encodes the message for signing
+func (msg MsgRevokeFeeAllowance) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// "GetSigners" - The granter signs the revocation
+func (msg MsgRevokeFeeAllowance) GetSigners() []sdk.Address {
+	return []sdk.Address{msg.Granter}
+}
+
+// "GetRecipient" - Returns the grantee of the allowance
+func (msg MsgRevokeFeeAllowance) GetRecipient() sdk.Address {
+	return msg.Grantee
+}
+
+// "GetFee" - Returns the fee of the message
+func (msg MsgRevokeFeeAllowance) GetFee() sdk.BigInt {
+	return sdk.NewInt(FeeGrantFee)
+}
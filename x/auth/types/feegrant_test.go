@@ -0,0 +1,101 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/pokt-network/pocket-core/codec"
+	codecTypes "github.com/pokt-network/pocket-core/codec/types"
+	"github.com/pokt-network/pocket-core/crypto"
+	sdk "github.com/pokt-network/pocket-core/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFeeAllowance_ValidateBasic(t *testing.T) {
+	a := sdk.Address(crypto.GenerateEd25519PrivKey().PublicKey().Address())
+	b := sdk.Address(crypto.GenerateEd25519PrivKey().PublicKey().Address())
+	limit := sdk.NewCoins(sdk.NewCoin(sdk.DefaultStakeDenom, sdk.NewInt(100)))
+	tests := []struct {
+		name      string
+		allowance FeeAllowance
+		valid     bool
+	}{
+		{"valid", NewFeeAllowance(a, b, limit, 10), true},
+		{"unlimited and without expiration", NewFeeAllowance(a, b, nil, 0), true},
+		{"empty granter", NewFeeAllowance(nil, b, limit, 10), false},
+		{"empty grantee", NewFeeAllowance(a, nil, limit, 10), false},
+		{"self grant", NewFeeAllowance(a, a, limit, 10), false},
+		{"invalid spend limit", NewFeeAllowance(a, b, sdk.Coins{sdk.Coin{Denom: sdk.DefaultStakeDenom, Amount: sdk.NewInt(-1)}}, 10), false},
+		{"negative expiration", NewFeeAllowance(a, b, limit, -1), false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.allowance.ValidateBasic()
+			if tt.valid {
+				require.Nil(t, err)
+				return
+			}
+			require.NotNil(t, err)
+			require.Equal(t, CodeInvalidFeeAllowance, err.Code())
+		})
+	}
+}
+
+func TestFeeAllowance_IsExpired(t *testing.T) {
+	a := sdk.Address(crypto.GenerateEd25519PrivKey().PublicKey().Address())
+	b := sdk.Address(crypto.GenerateEd25519PrivKey().PublicKey().Address())
+	require.False(t, NewFeeAllowance(a, b, nil, 0).IsExpired(1000))
+	require.False(t, NewFeeAllowance(a, b, nil, 10).IsExpired(10))
+	require.True(t, NewFeeAllowance(a, b, nil, 10).IsExpired(11))
+}
+
+func TestMsgGrantFeeAllowance_Proto(t *testing.T) {
+	a := sdk.Address(crypto.GenerateEd25519PrivKey().PublicKey().Address())
+	b := sdk.Address(crypto.GenerateEd25519PrivKey().PublicKey().Address())
+	msg := NewMsgGrantFeeAllowance(a, b, sdk.NewCoins(sdk.NewCoin(sdk.DefaultStakeDenom, sdk.NewInt(100))), 10)
+	bz, err := msg.Marshal()
+	require.Nil(t, err)
+	var res MsgGrantFeeAllowance
+	require.Nil(t, res.Unmarshal(bz))
+	require.Equal(t, msg, res)
+	require.Equal(t, []sdk.Address{a}, msg.GetSigners())
+	require.Equal(t, b, msg.GetRecipient())
+	revoke := NewMsgRevokeFeeAllowance(a, b)
+	bz, err = revoke.Marshal()
+	require.Nil(t, err)
+	var revokeRes MsgRevokeFeeAllowance
+	require.Nil(t, revokeRes.Unmarshal(bz))
+	require.Equal(t, revoke, revokeRes)
+}
+
+func TestStdTx_FeePayer(t *testing.T) {
+	defer func(cdc *codec.Codec) { ModuleCdc = cdc }(ModuleCdc)
+	cdc := codec.NewCodec(codecTypes.NewInterfaceRegistry())
+	RegisterCodec(cdc)
+	ModuleCdc = cdc
+	pk := crypto.GenerateEd25519PrivKey().PublicKey()
+	a := sdk.Address(pk.Address())
+	b := sdk.Address(crypto.GenerateEd25519PrivKey().PublicKey().Address())
+	payer := sdk.Address(crypto.GenerateEd25519PrivKey().PublicKey().Address())
+	msg := NewMsgRevokeFeeAllowance(a, b)
+	fee := sdk.NewCoins(sdk.NewCoin(sdk.DefaultStakeDenom, sdk.NewInt(FeeGrantFee)))
+	// without a fee payer the sign bytes are unchanged
+	signBz, err := StdSignBytes("chain", 1, fee, &msg, "memo")
+	require.Nil(t, err)
+	noPayerBz, err := StdSignBytesWithFeePayer("chain", 1, fee, &msg, "memo", nil)
+	require.Nil(t, err)
+	require.Equal(t, signBz, noPayerBz)
+	require.NotContains(t, string(signBz), "fee_payer")
+	payerBz, err := StdSignBytesWithFeePayer("chain", 1, fee, &msg, "memo", payer)
+	require.Nil(t, err)
+	require.NotEqual(t, signBz, payerBz)
+	// the fee payer survives the proto round trip
+	tx := StdTx{Msg: &msg, Fee: fee, Signature: StdSignature{PublicKey: pk}, Memo: "memo", Entropy: 1}
+	require.Nil(t, tx.GetFeePayer())
+	tx = tx.WithFeePayer(payer)
+	require.Equal(t, payer, tx.GetFeePayer())
+	p, err := tx.ToProto()
+	require.Nil(t, err)
+	res, err := p.FromProto()
+	require.Nil(t, err)
+	require.Equal(t, payer, res.GetFeePayer())
+}
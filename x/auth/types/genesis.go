@@ -10,6 +10,8 @@ type GenesisState struct {
 	Params   Params    `json:"params" yaml:"params"`
 	Accounts Accounts  `json:"accounts" yaml:"accounts"`
 	Supply   sdk.Coins `json:"supply" yaml:"supply"`
+	// the fee allowances, omitted if empty to keep the genesis files without them valid
+	FeeAllowances []FeeAllowance `json:"fee_allowances,omitempty" yaml:"fee_allowances"`
 }
 
 // NewGenesisState - Create a new genesis state
@@ -33,6 +35,9 @@ func ValidateGenesis(data GenesisState) error {
 		if account.GetPubKey().PubKey() == nil {
 			return fmt.Errorf("PubKey should never be nil")
 		}
+		if err := ValidateVestingAccount(account); err != nil {
+			return fmt.Errorf("invalid vesting account %s: %s", account.GetAddress(), err.Error())
+		}
 	}
 	if data.Params.MaxMemoCharacters == 0 {
 		return fmt.Errorf("invalid max memo characters: %d", data.Params.MaxMemoCharacters)
@@ -43,5 +48,10 @@ func ValidateGenesis(data GenesisState) error {
 	if err := NewSupply(data.Supply).ValidateBasic(); err != nil {
 		return err
 	}
+	for _, allowance := range data.FeeAllowances {
+		if err := allowance.ValidateBasic(); err != nil {
+			return err
+		}
+	}
 	return nil
 }
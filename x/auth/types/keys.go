@@ -21,9 +21,20 @@ var (
 	// AddressStoreKeyPrefix prefix for account-by-address store
 	SupplyKeyPrefix       = []byte{0x00}
 	AddressStoreKeyPrefix = []byte{0x01}
+	FeeAllowanceKeyPrefix = []byte{0x02}
 )
 
 // AddressStoreKey turn an address to key used to get it from the account store
 func AddressStoreKey(addr sdk.Address) []byte {
 	return append(AddressStoreKeyPrefix, addr.Bytes()...)
 }
+
+// FeeAllowanceKey turn a granter and a grantee to the key used to get the fee allowance from the store
+func FeeAllowanceKey(granter, grantee sdk.Address) []byte {
+	return append(FeeAllowancesByGranterKey(granter), grantee.Bytes()...)
+}
+
+// FeeAllowancesByGranterKey turn a granter to the prefix of the keys of all its fee allowances
+func FeeAllowancesByGranterKey(granter sdk.Address) []byte {
+	return append(append([]byte{}, FeeAllowanceKeyPrefix...), granter.Bytes()...)
+}
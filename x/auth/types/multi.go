@@ -0,0 +1,224 @@
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/pokt-network/pocket-core/codec"
+	"github.com/pokt-network/pocket-core/codec/types"
+	sdk "github.com/pokt-network/pocket-core/types"
+)
+
+const (
+	// the type of the multi message
+	MsgMultiName = "multi"
+	// the max number of messages in a multi message
+	MaxMultiMsgs = 50
+)
+
+// "MsgMulti" - Wraps several messages signed by the same signer in a single transaction. The messages are executed in
+// order and atomically: if any of them fails none of them is applied
+type MsgMulti struct {
+	Msgs []sdk.Msg `json:"msgs" yaml:"msgs"`
+}
+
+var _ codec.ProtoMarshaler = &MsgMulti{}
+
+// "NewMsgMulti" - Returns the multi message of the messages
+func NewMsgMulti(msgs ...sdk.Msg) MsgMulti {
+	return MsgMulti{Msgs: msgs}
+}
+
+// "MultiMsgs" - Returns the messages wrapped by the message, ok is false if it isn't a multi message
+func MultiMsgs(msg sdk.Msg) (msgs []sdk.Msg, ok bool) {
+	switch m := msg.(type) {
+	case MsgMulti:
+		return m.Msgs, true
+	case *MsgMulti:
+		return m.Msgs, true
+	}
+	return nil, false
+}
+
+func (msg *MsgMulti) Marshal() ([]byte, error) {
+	p, err := msg.ToProto()
+	if err != nil {
+		return nil, err
+	}
+	return p.Marshal()
+}
+
+func (msg *MsgMulti) MarshalTo(data []byte) (n int, err error) {
+	p, err := msg.ToProto()
+	if err != nil {
+		return 0, err
+	}
+	return p.MarshalTo(data)
+}
+
+func (msg *MsgMulti) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	p, err := msg.ToProto()
+	if err != nil {
+		return 0, err
+	}
+	return p.MarshalToSizedBuffer(dAtA)
+}
+
+func (msg *MsgMulti) Size() int {
+	p, _ := msg.ToProto()
+	return p.Size()
+}
+
+func (msg *MsgMulti) Unmarshal(data []byte) error {
+	var p ProtoMsgMulti
+	err := p.Unmarshal(data)
+	if err != nil {
+		return err
+	}
+	m, err := p.FromProto()
+	if err != nil {
+		return err
+	}
+	*msg = m
+	return nil
+}
+
+func (msg *MsgMulti) Reset() {
+	*msg = MsgMulti{}
+}
+
+func (msg *MsgMulti) ProtoMessage() {
+	p, _ := msg.ToProto()
+	p.ProtoMessage()
+}
+
+func (msg *MsgMulti) XXX_MessageName() string {
+	p, _ := msg.ToProto()
+	return p.XXX_MessageName()
+}
+
+func (msg MsgMulti) String() string {
+	p, _ := msg.ToProto()
+	return p.String()
+}
+
+// "ToProto" - Packs every message into an any
+func (msg MsgMulti) ToProto() (ProtoMsgMulti, error) {
+	res := ProtoMsgMulti{Msgs: make([]types.Any, 0, len(msg.Msgs))}
+	for _, m := range msg.Msgs {
+		pMsg, ok := m.(sdk.ProtoMsg)
+		if !ok {
+			return ProtoMsgMulti{}, fmt.Errorf("unable to convert sdk.Msg to sdk.ProtoMsg: %v", m)
+		}
+		any, err := types.NewAnyWithValue(pMsg)
+		if err != nil {
+			return ProtoMsgMulti{}, fmt.Errorf("unable to convert sdk.ProtoMsg into any %v", pMsg)
+		}
+		res.Msgs = append(res.Msgs, *any)
+	}
+	return res, nil
+}
+
+// "FromProto" - Unpacks every message from its any
+func (pmsg ProtoMsgMulti) FromProto() (MsgMulti, error) {
+	res := MsgMulti{Msgs: make([]sdk.Msg, 0, len(pmsg.Msgs))}
+	for i := range pmsg.Msgs {
+		var m sdk.ProtoMsg
+		if err := ModuleCdc.ProtoCodec().UnpackAny(&pmsg.Msgs[i], &m); err != nil {
+			return MsgMulti{}, err
+		}
+		res.Msgs = append(res.Msgs, m)
+	}
+	return res, nil
+}
+
+// "Route" - Returns module router key, the messages are routed one by one
+func (msg MsgMulti) Route() string { return ModuleName }
+
+// "Type" - Returns message name
+func (msg MsgMulti) Type() string { return MsgMultiName }
+
+// "ValidateBasic" - Storeless validity check for the multi message, every message must be valid on its own and signed
+// by a common signer
+func (msg MsgMulti) ValidateBasic() sdk.Error {
+	if len(msg.Msgs) == 0 {
+		return ErrEmptyMultiMsg(DefaultCodespace)
+	}
+	if len(msg.Msgs) > MaxMultiMsgs {
+		return ErrMultiMsgTooLarge(DefaultCodespace, MaxMultiMsgs)
+	}
+	for _, m := range msg.Msgs {
+		if m == nil {
+			return ErrEmptyMultiMsg(DefaultCodespace)
+		}
+		if _, ok := MultiMsgs(m); ok {
+			return ErrNestedMultiMsg(DefaultCodespace)
+		}
+		if err := m.ValidateBasic(); err != nil {
+			return err
+		}
+	}
+	if len(msg.GetSigners()) == 0 {
+		return ErrMultiMsgSigner(DefaultCodespace)
+	}
+	return nil
+}
+
+// "GetSignBytes" - Encodes the sign bytes of every message for signing
+func (msg MsgMulti) GetSignBytes() []byte {
+	signBytes := make([]json.RawMessage, 0, len(msg.Msgs))
+	for _, m := range msg.Msgs {
+		signBytes = append(signBytes, m.GetSignBytes())
+	}
+	bz, err := json.Marshal(struct {
+		Msgs []json.RawMessage `json:"msgs"`
+	}{signBytes})
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(bz)
+}
+
+// "GetSigners" - Returns the signers shared by every message, the transaction must be signed by one of them
+func (msg MsgMulti) GetSigners() []sdk.Address {
+	res := make([]sdk.Address, 0)
+	if len(msg.Msgs) == 0 || msg.Msgs[0] == nil {
+		return res
+	}
+	for _, signer := range msg.Msgs[0].GetSigners() {
+		shared := true
+		for _, m := range msg.Msgs[1:] {
+			if m == nil || !containsAddress(m.GetSigners(), signer) {
+				shared = false
+				break
+			}
+		}
+		if shared {
+			res = append(res, signer)
+		}
+	}
+	return res
+}
+
+// "GetRecipient" - Returns nil, the messages may have different recipients
+func (msg MsgMulti) GetRecipient() sdk.Address {
+	return nil
+}
+
+// "GetFee" - Returns the sum of the fees of the messages
+func (msg MsgMulti) GetFee() sdk.BigInt {
+	fee := sdk.ZeroInt()
+	for _, m := range msg.Msgs {
+		fee = fee.Add(m.GetFee())
+	}
+	return fee
+}
+
+func containsAddress(addrs []sdk.Address, addr sdk.Address) bool {
+	for _, a := range addrs {
+		if a.Equals(addr) {
+			return true
+		}
+	}
+	return false
+}
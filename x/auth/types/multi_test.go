@@ -0,0 +1,80 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/pokt-network/pocket-core/codec"
+	codecTypes "github.com/pokt-network/pocket-core/codec/types"
+	"github.com/pokt-network/pocket-core/crypto"
+	sdk "github.com/pokt-network/pocket-core/types"
+	nodesTypes "github.com/pokt-network/pocket-core/x/nodes/types"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestSend(from, to sdk.Address, amount int64) *nodesTypes.MsgSend {
+	return &nodesTypes.MsgSend{FromAddress: from, ToAddress: to, Amount: sdk.NewInt(amount)}
+}
+
+func TestMsgMulti_ValidateBasic(t *testing.T) {
+	a := sdk.Address(crypto.GenerateEd25519PrivKey().PublicKey().Address())
+	b := sdk.Address(crypto.GenerateEd25519PrivKey().PublicKey().Address())
+	tooLarge := NewMsgMulti()
+	for i := 0; i <= MaxMultiMsgs; i++ {
+		tooLarge.Msgs = append(tooLarge.Msgs, newTestSend(a, b, 1))
+	}
+	nested := NewMsgMulti(newTestSend(a, b, 1))
+	tests := []struct {
+		name string
+		msg  MsgMulti
+		code sdk.CodeType
+	}{
+		{"valid", NewMsgMulti(newTestSend(a, b, 1), newTestSend(a, a, 2)), sdk.CodeOK},
+		{"empty", NewMsgMulti(), CodeEmptyMultiMsg},
+		{"nil message", NewMsgMulti(newTestSend(a, b, 1), nil), CodeEmptyMultiMsg},
+		{"too large", tooLarge, CodeMultiMsgTooLarge},
+		{"nested", NewMsgMulti(newTestSend(a, b, 1), &nested), CodeNestedMultiMsg},
+		{"invalid message", NewMsgMulti(newTestSend(a, b, 0)), nodesTypes.CodeBadSend},
+		{"no common signer", NewMsgMulti(newTestSend(a, b, 1), newTestSend(b, a, 1)), CodeMultiMsgSigner},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.code == sdk.CodeOK {
+				require.Nil(t, err)
+				return
+			}
+			require.NotNil(t, err)
+			require.Equal(t, tt.code, err.Code())
+		})
+	}
+}
+
+func TestMsgMulti_FeeAndSigners(t *testing.T) {
+	a := sdk.Address(crypto.GenerateEd25519PrivKey().PublicKey().Address())
+	b := sdk.Address(crypto.GenerateEd25519PrivKey().PublicKey().Address())
+	msg := NewMsgMulti(newTestSend(a, b, 1), newTestSend(a, b, 2))
+	require.Equal(t, []sdk.Address{a}, msg.GetSigners())
+	require.Nil(t, msg.GetRecipient())
+	// the fee of every message is multiplied by its own multiplier
+	fm := FeeMultipliers{FeeMultis: []FeeMultiplier{{Key: nodesTypes.MsgSendName, Multiplier: 3}}, Default: 1}
+	require.Equal(t, sdk.NewInt(20000), msg.GetFee())
+	require.Equal(t, sdk.NewInt(60000), fm.GetFee(msg))
+	require.Equal(t, sdk.NewInt(60000), fm.GetFee(&msg))
+}
+
+func TestMsgMulti_Proto(t *testing.T) {
+	defer func(cdc *codec.Codec) { ModuleCdc = cdc }(ModuleCdc)
+	cdc := codec.NewCodec(codecTypes.NewInterfaceRegistry())
+	RegisterCodec(cdc)
+	nodesTypes.RegisterCodec(cdc)
+	a := sdk.Address(crypto.GenerateEd25519PrivKey().PublicKey().Address())
+	b := sdk.Address(crypto.GenerateEd25519PrivKey().PublicKey().Address())
+	msg := NewMsgMulti(newTestSend(a, b, 1), newTestSend(a, b, 2))
+	bz, err := msg.Marshal()
+	require.Nil(t, err)
+	var res MsgMulti
+	require.Nil(t, res.Unmarshal(bz))
+	require.Equal(t, msg, res)
+	// the sign bytes change with the messages
+	require.NotEqual(t, msg.GetSignBytes(), NewMsgMulti(newTestSend(a, b, 2), newTestSend(a, b, 1)).GetSignBytes())
+}
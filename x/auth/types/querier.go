@@ -6,7 +6,8 @@ import (
 
 // query endpoints supported by the auth Querier
 const (
-	QueryAccount = "account"
+	QueryAccount    = "account"
+	QueryAllowances = "allowances"
 )
 
 // QueryAccountParams defines the params for querying accounts.
@@ -18,3 +19,15 @@ type QueryAccountParams struct {
 func NewQueryAccountParams(addr sdk.Address) QueryAccountParams {
 	return QueryAccountParams{Address: addr}
 }
+
+// QueryAllowancesParams defines the params for querying the active fee allowances, a nil granter or grantee matches
+// every account.
+type QueryAllowancesParams struct {
+	Granter sdk.Address `json:"granter"`
+	Grantee sdk.Address `json:"grantee"`
+}
+
+// NewQueryAllowancesParams creates a new instance of QueryAllowancesParams.
+func NewQueryAllowancesParams(granter, grantee sdk.Address) QueryAllowancesParams {
+	return QueryAllowancesParams{Granter: granter, Grantee: grantee}
+}
@@ -44,6 +44,12 @@ func CountSubKeys(pub crypto.PubKey) int {
 
 // StdSignBytes returns the bytes to sign for a transaction.
 func StdSignBytes(chainID string, entropy int64, fee sdk.Coins, msg sdk.Msg, memo string) ([]byte, error) {
+	return StdSignBytesWithFeePayer(chainID, entropy, fee, msg, memo, nil)
+}
+
+// StdSignBytesWithFeePayer returns the bytes to sign for a transaction whose fees are paid by the fee payer. Without a
+// fee payer the bytes are the same as the StdSignBytes.
+func StdSignBytesWithFeePayer(chainID string, entropy int64, fee sdk.Coins, msg sdk.Msg, memo string, feePayer sdk.Address) ([]byte, error) {
 	msgsBytes := msg.GetSignBytes()
 	var feeBytes sdk.Raw
 	feeBytes, err := fee.MarshalJSON()
@@ -51,11 +57,12 @@ func StdSignBytes(chainID string, entropy int64, fee sdk.Coins, msg sdk.Msg, mem
 		return nil, fmt.Errorf("could not marshal fee to json for StdSignBytes function: %v", err.Error())
 	}
 	bz, err := ModuleCdc.MarshalJSON(StdSignDoc{
-		ChainID: chainID,
-		Fee:     feeBytes,
-		Memo:    memo,
-		Msg:     msgsBytes,
-		Entropy: entropy,
+		ChainID:  chainID,
+		Fee:      feeBytes,
+		Memo:     memo,
+		Msg:      msgsBytes,
+		Entropy:  entropy,
+		FeePayer: feePayer,
 	})
 	if err != nil {
 		return nil, fmt.Errorf("could not marshal bytes to json for StdSignDoc function: %v", err.Error())
@@ -74,6 +81,7 @@ type StdTx struct {
 	Signature StdSignature `json:"signature" yaml:"signature"`
 	Memo      string       `json:"memo" yaml:"memo"`
 	Entropy   int64        `json:"entropy" yaml:"entropy"`
+	FeePayer  sdk.Address  `json:"fee_payer,omitempty" yaml:"fee_payer"` // optional account paying the fees from its fee allowance
 }
 
 func (tx *StdTx) Reset() {
@@ -148,6 +156,7 @@ func (tx StdTx) ToProto() (ProtoStdTx, error) {
 		Signature: tx.Signature.ToProto(),
 		Memo:      tx.Memo,
 		Entropy:   tx.Entropy,
+		FeePayer:  tx.FeePayer,
 	}, nil
 }
 
@@ -156,6 +165,20 @@ func (tx StdTx) WithSignature(sig StdSignature) (StdTx, error) {
 	return tx, nil
 }
 
+// WithFeePayer returns a copy of the transaction with its fees paid by the fee payer
+func (tx StdTx) WithFeePayer(feePayer sdk.Address) StdTx {
+	tx.FeePayer = feePayer
+	return tx
+}
+
+// GetFeePayer returns the account paying the fees, nil if the signer pays them
+func (tx StdTx) GetFeePayer() sdk.Address {
+	if len(tx.FeePayer) == 0 {
+		return nil
+	}
+	return tx.FeePayer
+}
+
 func (tx StdTx) GetEntropy() int64 {
 	return tx.Entropy
 }
@@ -188,6 +211,11 @@ func (tx StdTx) ValidateBasic() sdk.Error {
 	if len(tx.Signature.Signature) == 0 {
 		return sdk.ErrUnauthorized("empty signature")
 	}
+	if tx.GetFeePayer() != nil {
+		if err := sdk.VerifyAddressFormat(tx.FeePayer); err != nil {
+			return sdk.ErrInvalidAddress(fmt.Sprintf("invalid fee payer %s: %s", tx.FeePayer, err.Error()))
+		}
+	}
 	return nil
 }
 
@@ -207,6 +235,7 @@ func (ptx ProtoStdTx) FromProto() (StdTx, error) {
 		Signature: ss,
 		Memo:      ptx.Memo,
 		Entropy:   ptx.Entropy,
+		FeePayer:  ptx.FeePayer,
 	}, nil
 }
 
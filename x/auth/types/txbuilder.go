@@ -20,6 +20,7 @@ type TxBuilder struct {
 	chainID   string
 	memo      string
 	fees      sdk.Coins
+	feePayer  sdk.Address
 }
 
 // NewTxBuilder returns a new initialized TxBuilder.
@@ -52,6 +53,9 @@ func (bldr TxBuilder) Memo() string { return bldr.memo }
 // Fees returns the fees for the transaction
 func (bldr TxBuilder) Fees() sdk.Coins { return bldr.fees }
 
+// FeePayer returns the account paying the fees, nil if the signer pays them
+func (bldr TxBuilder) FeePayer() sdk.Address { return bldr.feePayer }
+
 // WithTxEncoder returns a copy of the context with an updated codec.
 func (bldr TxBuilder) WithTxEncoder(txEncoder sdk.TxEncoder) TxBuilder {
 	bldr.txEncoder = txEncoder
@@ -82,6 +86,12 @@ func (bldr TxBuilder) WithKeybase(keybase crkeys.Keybase) TxBuilder {
 	return bldr
 }
 
+// WithFeePayer returns a copy of the context with the fees paid by the fee payer out of its fee allowance.
+func (bldr TxBuilder) WithFeePayer(feePayer sdk.Address) TxBuilder {
+	bldr.feePayer = feePayer
+	return bldr
+}
+
 // WithMemo returns a copy of the context with an updated memo.
 func (bldr TxBuilder) WithMemo(memo string) TxBuilder {
 	bldr.memo = strings.TrimSpace(memo)
@@ -95,7 +105,7 @@ func (bldr TxBuilder) BuildAndSign(address sdk.Address, privateKey crypto.Privat
 		return nil, errors.New("cant build and sign transaciton: the chainID is empty")
 	}
 	entropy := rand.Int64()
-	bytesToSign, err := StdSignBytes(bldr.chainID, entropy, bldr.fees, msg, bldr.memo)
+	bytesToSign, err := StdSignBytesWithFeePayer(bldr.chainID, entropy, bldr.fees, msg, bldr.memo, bldr.feePayer)
 	if err != nil {
 		return nil, err
 	}
@@ -107,10 +117,11 @@ func (bldr TxBuilder) BuildAndSign(address sdk.Address, privateKey crypto.Privat
 		Signature: sigBytes,
 		PublicKey: privateKey.PublicKey(),
 	}
+	tx := NewTx(msg, bldr.fees, sig, bldr.memo, entropy).(StdTx).WithFeePayer(bldr.feePayer)
 	if legacyCodec {
-		return bldr.txEncoder(NewTx(msg, bldr.fees, sig, bldr.memo, entropy), 0)
+		return bldr.txEncoder(tx, 0)
 	}
-	return bldr.txEncoder(NewTx(msg, bldr.fees, sig, bldr.memo, entropy), -1)
+	return bldr.txEncoder(tx, -1)
 }
 
 // BuildAndSignWithKeyBase builds a single message to be signed, and signs a transaction
@@ -123,7 +134,7 @@ func (bldr TxBuilder) BuildAndSignWithKeyBase(address sdk.Address, passphrase st
 		return nil, errors.New("cant build and sign transaciton: the chainID is empty")
 	}
 	entropy := rand.Int64()
-	bytesToSign, err := StdSignBytes(bldr.chainID, entropy, bldr.fees, msg, bldr.memo)
+	bytesToSign, err := StdSignBytesWithFeePayer(bldr.chainID, entropy, bldr.fees, msg, bldr.memo, bldr.feePayer)
 	if err != nil {
 		return nil, err
 	}
@@ -135,10 +146,11 @@ func (bldr TxBuilder) BuildAndSignWithKeyBase(address sdk.Address, passphrase st
 		Signature: sigBytes,
 		PublicKey: pk,
 	}
+	tx := NewTx(msg, bldr.fees, sig, bldr.memo, entropy).(StdTx).WithFeePayer(bldr.feePayer)
 	if legacyCodec {
-		return bldr.txEncoder(NewTx(msg, bldr.fees, sig, bldr.memo, entropy), 0)
+		return bldr.txEncoder(tx, 0)
 	}
-	return bldr.txEncoder(NewTx(msg, bldr.fees, sig, bldr.memo, entropy), -1)
+	return bldr.txEncoder(tx, -1)
 }
 
 func (bldr TxBuilder) SignMultisigTransaction(address sdk.Address, keys []crypto.PublicKey, passphrase string, txBytes []byte, legacyCodec bool) (signedTx []byte, err error) {
@@ -163,7 +175,7 @@ func (bldr TxBuilder) SignMultisigTransaction(address sdk.Address, keys []crypto
 	}
 	tx := t.(StdTx)
 	// get the sign bytes from the transaction
-	bytesToSign, err := StdSignBytes(bldr.chainID, tx.GetEntropy(), tx.GetFee(), tx.GetMsg(), tx.GetMemo())
+	bytesToSign, err := StdSignBytesWithFeePayer(bldr.chainID, tx.GetEntropy(), tx.GetFee(), tx.GetMsg(), tx.GetMemo(), tx.GetFeePayer())
 	if err != nil {
 		return nil, err
 	}
@@ -212,7 +224,7 @@ func (bldr TxBuilder) BuildAndSignMultisigTransaction(address sdk.Address, publi
 	// bulid the transaction from scratch
 	entropy := rand.Int64()
 	fee := sdk.NewCoins(sdk.NewCoin(sdk.DefaultStakeDenom, sdk.NewInt(fees)))
-	signBz, err := StdSignBytes(bldr.chainID, entropy, fee, m, bldr.memo)
+	signBz, err := StdSignBytesWithFeePayer(bldr.chainID, entropy, fee, m, bldr.memo, bldr.feePayer)
 	if err != nil {
 		return nil, err
 	}
@@ -229,7 +241,7 @@ func (bldr TxBuilder) BuildAndSignMultisigTransaction(address sdk.Address, publi
 		Signature: ms.Marshal(),
 	}
 	// create a new standard transaction object
-	tx := NewTx(m, fee, sig, "", entropy)
+	tx := NewTx(m, fee, sig, "", entropy).(StdTx).WithFeePayer(bldr.feePayer)
 	// encode it using the default encoder
 	if legacyCodec {
 		return bldr.TxEncoder()(tx, 0)
@@ -0,0 +1,428 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/pokt-network/pocket-core/codec"
+	sdk "github.com/pokt-network/pocket-core/types"
+	"github.com/pokt-network/pocket-core/x/auth/exported"
+)
+
+//-----------------------------------------------------------------------------
+// BaseVestingAccount
+
+// BaseVestingAccount - the common fields and logic of the vesting accounts. The times are unix seconds
+type BaseVestingAccount struct {
+	*BaseAccount
+	OriginalVesting  sdk.Coins `json:"original_vesting" yaml:"original_vesting"`   // coins locked at the creation of the account
+	DelegatedFree    sdk.Coins `json:"delegated_free" yaml:"delegated_free"`       // vested coins that are staked
+	DelegatedVesting sdk.Coins `json:"delegated_vesting" yaml:"delegated_vesting"` // locked coins that are staked
+	EndTime          int64     `json:"end_time" yaml:"end_time"`                   // every coin is vested at the end time
+}
+
+// NewBaseVestingAccount - returns the vesting base of the account, the original vesting coins must be held by the account
+func NewBaseVestingAccount(baseAccount *BaseAccount, originalVesting sdk.Coins, endTime int64) *BaseVestingAccount {
+	return &BaseVestingAccount{
+		BaseAccount:     baseAccount,
+		OriginalVesting: originalVesting,
+		EndTime:         endTime,
+	}
+}
+
+// spendableCoins returns the coins of the account that are not locked, the locked coins that are staked are not held
+// by the account so they are subtracted from the locked ones
+func (bva BaseVestingAccount) spendableCoins(vestingCoins sdk.Coins) sdk.Coins {
+	var spendableCoins sdk.Coins
+	for _, coin := range bva.GetCoins() {
+		locked := sdk.MaxInt(vestingCoins.AmountOf(coin.Denom).Sub(bva.DelegatedVesting.AmountOf(coin.Denom)), sdk.ZeroInt())
+		spendable := coin.Amount.Sub(locked)
+		if spendable.IsPositive() {
+			spendableCoins = spendableCoins.Add(sdk.NewCoins(sdk.NewCoin(coin.Denom, spendable)))
+		}
+	}
+	return spendableCoins
+}
+
+// trackDelegation tracks the staking of the amount, the locked coins are staked before the vested ones. Must be called
+// while the account still holds the amount
+func (bva *BaseVestingAccount) trackDelegation(vestingCoins, amount sdk.Coins) {
+	for _, coin := range amount {
+		// the locked coins not staked yet
+		locked := sdk.MaxInt(vestingCoins.AmountOf(coin.Denom).Sub(bva.DelegatedVesting.AmountOf(coin.Denom)), sdk.ZeroInt())
+		delVesting := sdk.MinInt(locked, coin.Amount)
+		delFree := coin.Amount.Sub(delVesting)
+		if delVesting.IsPositive() {
+			bva.DelegatedVesting = bva.DelegatedVesting.Add(sdk.NewCoins(sdk.NewCoin(coin.Denom, delVesting)))
+		}
+		if delFree.IsPositive() {
+			bva.DelegatedFree = bva.DelegatedFree.Add(sdk.NewCoins(sdk.NewCoin(coin.Denom, delFree)))
+		}
+	}
+}
+
+// TrackUndelegation - tracks the unstaking of the amount, the vested coins are unstaked before the locked ones. The
+// slashed coins are never unstaked so the amount may be lower than the staked coins
+func (bva *BaseVestingAccount) TrackUndelegation(amount sdk.Coins) {
+	for _, coin := range amount {
+		undelFree := sdk.MinInt(bva.DelegatedFree.AmountOf(coin.Denom), coin.Amount)
+		undelVesting := sdk.MinInt(bva.DelegatedVesting.AmountOf(coin.Denom), coin.Amount.Sub(undelFree))
+		if undelFree.IsPositive() {
+			bva.DelegatedFree = bva.DelegatedFree.Sub(sdk.NewCoins(sdk.NewCoin(coin.Denom, undelFree)))
+		}
+		if undelVesting.IsPositive() {
+			bva.DelegatedVesting = bva.DelegatedVesting.Sub(sdk.NewCoins(sdk.NewCoin(coin.Denom, undelVesting)))
+		}
+	}
+}
+
+// GetOriginalVesting - Implements exported.VestingAccount
+func (bva BaseVestingAccount) GetOriginalVesting() sdk.Coins {
+	return bva.OriginalVesting
+}
+
+// GetDelegatedFree - Implements exported.VestingAccount
+func (bva BaseVestingAccount) GetDelegatedFree() sdk.Coins {
+	return bva.DelegatedFree
+}
+
+// GetDelegatedVesting - Implements exported.VestingAccount
+func (bva BaseVestingAccount) GetDelegatedVesting() sdk.Coins {
+	return bva.DelegatedVesting
+}
+
+// GetEndTime - Implements exported.VestingAccount
+func (bva BaseVestingAccount) GetEndTime() int64 {
+	return bva.EndTime
+}
+
+// Validate - checks the vesting fields of the account
+func (bva BaseVestingAccount) Validate() error {
+	if bva.BaseAccount == nil {
+		return errors.New("the vesting account has no base account")
+	}
+	if bva.EndTime <= 0 {
+		return fmt.Errorf("the end time must be positive: %d", bva.EndTime)
+	}
+	if bva.OriginalVesting.Empty() || !bva.OriginalVesting.IsValid() {
+		return fmt.Errorf("the original vesting coins are invalid: %s", bva.OriginalVesting)
+	}
+	if !bva.DelegatedFree.IsValid() || !bva.DelegatedVesting.IsValid() {
+		return fmt.Errorf("the delegated coins are invalid: free %s, vesting %s", bva.DelegatedFree, bva.DelegatedVesting)
+	}
+	if !bva.DelegatedVesting.IsAllLTE(bva.OriginalVesting) {
+		return fmt.Errorf("the delegated vesting coins %s exceed the original vesting coins %s", bva.DelegatedVesting, bva.OriginalVesting)
+	}
+	return nil
+}
+
+func (bva BaseVestingAccount) toProto(startTime int64) ProtoVestingAccount {
+	ba := bva.BaseAccount.ToProto()
+	return ProtoVestingAccount{
+		EndTime:          bva.EndTime,
+		Address:          ba.Address,
+		PubKey:           ba.PubKey,
+		Coins:            ba.Coins,
+		OriginalVesting:  bva.OriginalVesting,
+		DelegatedFree:    bva.DelegatedFree,
+		DelegatedVesting: bva.DelegatedVesting,
+		StartTime:        startTime,
+	}
+}
+
+func (m *ProtoVestingAccount) baseVestingAccount() (*BaseVestingAccount, error) {
+	if m.EndTime == 0 {
+		return nil, errors.New("not a vesting account: the end time is zero")
+	}
+	pba := ProtoBaseAccount{Address: m.Address, PubKey: m.PubKey, Coins: m.Coins}
+	ba, err := pba.FromProto()
+	if err != nil {
+		return nil, err
+	}
+	return &BaseVestingAccount{
+		BaseAccount:      &ba,
+		OriginalVesting:  m.OriginalVesting,
+		DelegatedFree:    m.DelegatedFree,
+		DelegatedVesting: m.DelegatedVesting,
+		EndTime:          m.EndTime,
+	}, nil
+}
+
+// "FromProto" - Returns the continuous vesting account, or the delayed one if it has no start time
+func (m *ProtoVestingAccount) FromProto() (exported.VestingAccount, error) {
+	bva, err := m.baseVestingAccount()
+	if err != nil {
+		return nil, err
+	}
+	if m.StartTime == 0 {
+		return &DelayedVestingAccount{BaseVestingAccount: bva}, nil
+	}
+	return &ContinuousVestingAccount{BaseVestingAccount: bva, StartTime: m.StartTime}, nil
+}
+
+func (bva BaseVestingAccount) string(kind string, startTime int64) string {
+	var pubkey string
+	if bva.PubKey != nil {
+		pubkey = bva.PubKey.RawString()
+	}
+	return fmt.Sprintf(`%s Vesting Account:
+  Address:           %s
+  Pubkey:            %s
+  Coins:             %s
+  Original Vesting:  %s
+  Delegated Free:    %s
+  Delegated Vesting: %s
+  Start Time:        %d
+  End Time:          %d`,
+		kind, bva.Address, pubkey, bva.Coins, bva.OriginalVesting, bva.DelegatedFree, bva.DelegatedVesting, startTime, bva.EndTime,
+	)
+}
+
+//-----------------------------------------------------------------------------
+// ContinuousVestingAccount
+
+var _ exported.VestingAccount = (*ContinuousVestingAccount)(nil)
+var _ codec.ProtoMarshaler = &ContinuousVestingAccount{}
+
+// ContinuousVestingAccount - a vesting account whose coins vest linearly from the start time to the end time
+type ContinuousVestingAccount struct {
+	*BaseVestingAccount
+	StartTime int64 `json:"start_time" yaml:"start_time"`
+}
+
+// NewContinuousVestingAccount - returns a vesting account locking the original vesting coins of the base account from
+// the start time to the end time (unix seconds)
+func NewContinuousVestingAccount(baseAccount *BaseAccount, originalVesting sdk.Coins, startTime, endTime int64) *ContinuousVestingAccount {
+	return &ContinuousVestingAccount{
+		BaseVestingAccount: NewBaseVestingAccount(baseAccount, originalVesting, endTime),
+		StartTime:          startTime,
+	}
+}
+
+// GetVestedCoins - Implements exported.VestingAccount, the coins vest linearly from the start time to the end time
+func (cva ContinuousVestingAccount) GetVestedCoins(blockTime time.Time) sdk.Coins {
+	var vestedCoins sdk.Coins
+	now := blockTime.Unix()
+	if now <= cva.StartTime {
+		return vestedCoins
+	}
+	if now >= cva.EndTime {
+		return cva.OriginalVesting
+	}
+	elapsed, duration := sdk.NewInt(now-cva.StartTime), sdk.NewInt(cva.EndTime-cva.StartTime)
+	for _, coin := range cva.OriginalVesting {
+		vested := coin.Amount.Mul(elapsed).Quo(duration)
+		if vested.IsPositive() {
+			vestedCoins = vestedCoins.Add(sdk.NewCoins(sdk.NewCoin(coin.Denom, vested)))
+		}
+	}
+	return vestedCoins
+}
+
+// GetVestingCoins - Implements exported.VestingAccount
+func (cva ContinuousVestingAccount) GetVestingCoins(blockTime time.Time) sdk.Coins {
+	return cva.OriginalVesting.Sub(cva.GetVestedCoins(blockTime))
+}
+
+// SpendableCoins - Implements exported.Account, the locked coins can't be spent
+func (cva ContinuousVestingAccount) SpendableCoins(blockTime time.Time) sdk.Coins {
+	return cva.spendableCoins(cva.GetVestingCoins(blockTime))
+}
+
+// TrackDelegation - Implements exported.VestingAccount
+func (cva *ContinuousVestingAccount) TrackDelegation(blockTime time.Time, amount sdk.Coins) {
+	cva.trackDelegation(cva.GetVestingCoins(blockTime), amount)
+}
+
+// GetStartTime - Implements exported.VestingAccount
+func (cva ContinuousVestingAccount) GetStartTime() int64 {
+	return cva.StartTime
+}
+
+// Validate - checks the vesting fields of the account
+func (cva ContinuousVestingAccount) Validate() error {
+	if cva.BaseVestingAccount == nil {
+		return errors.New("the vesting account has no base vesting account")
+	}
+	if cva.StartTime <= 0 || cva.StartTime >= cva.EndTime {
+		return fmt.Errorf("the start time %d must be positive and before the end time %d", cva.StartTime, cva.EndTime)
+	}
+	return cva.BaseVestingAccount.Validate()
+}
+
+// String implements fmt.Stringer
+func (cva ContinuousVestingAccount) String() string {
+	return cva.string("Continuous", cva.StartTime)
+}
+
+func (cva ContinuousVestingAccount) ToProto() ProtoVestingAccount {
+	return cva.toProto(cva.StartTime)
+}
+
+func (cva *ContinuousVestingAccount) Reset() {
+	*cva = ContinuousVestingAccount{}
+}
+
+func (cva *ContinuousVestingAccount) ProtoMessage() {
+	p := cva.ToProto()
+	p.ProtoMessage()
+}
+
+func (cva *ContinuousVestingAccount) Marshal() ([]byte, error) {
+	p := cva.ToProto()
+	return p.Marshal()
+}
+
+func (cva *ContinuousVestingAccount) MarshalTo(data []byte) (n int, err error) {
+	p := cva.ToProto()
+	return p.MarshalTo(data)
+}
+
+func (cva *ContinuousVestingAccount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	p := cva.ToProto()
+	return p.MarshalToSizedBuffer(dAtA)
+}
+
+func (cva *ContinuousVestingAccount) Size() int {
+	p := cva.ToProto()
+	return p.Size()
+}
+
+func (cva *ContinuousVestingAccount) Unmarshal(data []byte) error {
+	var pva ProtoVestingAccount
+	err := pva.Unmarshal(data)
+	if err != nil {
+		return err
+	}
+	if pva.StartTime == 0 {
+		return errors.New("not a continuous vesting account: the start time is zero")
+	}
+	bva, err := pva.baseVestingAccount()
+	if err != nil {
+		return err
+	}
+	*cva = ContinuousVestingAccount{BaseVestingAccount: bva, StartTime: pva.StartTime}
+	return nil
+}
+
+//-----------------------------------------------------------------------------
+// DelayedVestingAccount
+
+var _ exported.VestingAccount = (*DelayedVestingAccount)(nil)
+var _ codec.ProtoMarshaler = &DelayedVestingAccount{}
+
+// DelayedVestingAccount - a vesting account whose coins all vest at the end time
+type DelayedVestingAccount struct {
+	*BaseVestingAccount
+}
+
+// NewDelayedVestingAccount - returns a vesting account locking the original vesting coins of the base account until the
+// end time (unix seconds)
+func NewDelayedVestingAccount(baseAccount *BaseAccount, originalVesting sdk.Coins, endTime int64) *DelayedVestingAccount {
+	return &DelayedVestingAccount{
+		BaseVestingAccount: NewBaseVestingAccount(baseAccount, originalVesting, endTime),
+	}
+}
+
+// GetVestedCoins - Implements exported.VestingAccount, every coin vests at the end time
+func (dva DelayedVestingAccount) GetVestedCoins(blockTime time.Time) sdk.Coins {
+	if blockTime.Unix() >= dva.EndTime {
+		return dva.OriginalVesting
+	}
+	return nil
+}
+
+// GetVestingCoins - Implements exported.VestingAccount
+func (dva DelayedVestingAccount) GetVestingCoins(blockTime time.Time) sdk.Coins {
+	return dva.OriginalVesting.Sub(dva.GetVestedCoins(blockTime))
+}
+
+// SpendableCoins - Implements exported.Account, the locked coins can't be spent
+func (dva DelayedVestingAccount) SpendableCoins(blockTime time.Time) sdk.Coins {
+	return dva.spendableCoins(dva.GetVestingCoins(blockTime))
+}
+
+// TrackDelegation - Implements exported.VestingAccount
+func (dva *DelayedVestingAccount) TrackDelegation(blockTime time.Time, amount sdk.Coins) {
+	dva.trackDelegation(dva.GetVestingCoins(blockTime), amount)
+}
+
+// GetStartTime - Implements exported.VestingAccount, a delayed vesting account has no start time
+func (dva DelayedVestingAccount) GetStartTime() int64 {
+	return 0
+}
+
+// Validate - checks the vesting fields of the account
+func (dva DelayedVestingAccount) Validate() error {
+	if dva.BaseVestingAccount == nil {
+		return errors.New("the vesting account has no base vesting account")
+	}
+	return dva.BaseVestingAccount.Validate()
+}
+
+// String implements fmt.Stringer
+func (dva DelayedVestingAccount) String() string {
+	return dva.string("Delayed", 0)
+}
+
+func (dva DelayedVestingAccount) ToProto() ProtoVestingAccount {
+	return dva.toProto(0)
+}
+
+func (dva *DelayedVestingAccount) Reset() {
+	*dva = DelayedVestingAccount{}
+}
+
+func (dva *DelayedVestingAccount) ProtoMessage() {
+	p := dva.ToProto()
+	p.ProtoMessage()
+}
+
+func (dva *DelayedVestingAccount) Marshal() ([]byte, error) {
+	p := dva.ToProto()
+	return p.Marshal()
+}
+
+func (dva *DelayedVestingAccount) MarshalTo(data []byte) (n int, err error) {
+	p := dva.ToProto()
+	return p.MarshalTo(data)
+}
+
+func (dva *DelayedVestingAccount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	p := dva.ToProto()
+	return p.MarshalToSizedBuffer(dAtA)
+}
+
+func (dva *DelayedVestingAccount) Size() int {
+	p := dva.ToProto()
+	return p.Size()
+}
+
+func (dva *DelayedVestingAccount) Unmarshal(data []byte) error {
+	var pva ProtoVestingAccount
+	err := pva.Unmarshal(data)
+	if err != nil {
+		return err
+	}
+	if pva.StartTime != 0 {
+		return errors.New("not a delayed vesting account: the start time is set")
+	}
+	bva, err := pva.baseVestingAccount()
+	if err != nil {
+		return err
+	}
+	*dva = DelayedVestingAccount{BaseVestingAccount: bva}
+	return nil
+}
+
+// ValidateVestingAccount - checks the vesting fields of the account if it is a vesting account
+func ValidateVestingAccount(acc exported.Account) error {
+	switch a := acc.(type) {
+	case *ContinuousVestingAccount:
+		return a.Validate()
+	case *DelayedVestingAccount:
+		return a.Validate()
+	}
+	return nil
+}
@@ -0,0 +1,135 @@
+package types
+
+import (
+	"testing"
+	"time"
+
+	"github.com/pokt-network/pocket-core/crypto"
+	sdk "github.com/pokt-network/pocket-core/types"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestVestingBase(amount int64) *BaseAccount {
+	pk := crypto.GenerateEd25519PrivKey().PublicKey()
+	acc := NewBaseAccountWithAddress(sdk.Address(pk.Address()))
+	acc.PubKey = pk
+	acc.Coins = sdk.NewCoins(sdk.NewCoin(sdk.DefaultStakeDenom, sdk.NewInt(amount)))
+	return &acc
+}
+
+func stakeCoins(amount int64) sdk.Coins {
+	return sdk.NewCoins(sdk.NewCoin(sdk.DefaultStakeDenom, sdk.NewInt(amount)))
+}
+
+func TestContinuousVestingAccount_VestedCoins(t *testing.T) {
+	start := time.Unix(1000, 0)
+	cva := NewContinuousVestingAccount(newTestVestingBase(150), stakeCoins(100), start.Unix(), start.Unix()+100)
+	require.Nil(t, cva.Validate())
+	// before the start nothing is vested
+	require.True(t, cva.GetVestedCoins(start).Empty())
+	require.Equal(t, stakeCoins(100), cva.GetVestingCoins(start))
+	require.Equal(t, stakeCoins(50), cva.SpendableCoins(start))
+	// halfway half is vested
+	half := start.Add(50 * time.Second)
+	require.Equal(t, stakeCoins(50), cva.GetVestedCoins(half))
+	require.Equal(t, stakeCoins(100), cva.SpendableCoins(half))
+	// at the end everything is vested
+	end := start.Add(100 * time.Second)
+	require.Equal(t, stakeCoins(100), cva.GetVestedCoins(end))
+	require.True(t, cva.GetVestingCoins(end).Empty())
+	require.Equal(t, stakeCoins(150), cva.SpendableCoins(end))
+}
+
+func TestDelayedVestingAccount_VestedCoins(t *testing.T) {
+	end := time.Unix(1000, 0)
+	dva := NewDelayedVestingAccount(newTestVestingBase(100), stakeCoins(100), end.Unix())
+	require.Nil(t, dva.Validate())
+	require.True(t, dva.GetVestedCoins(end.Add(-time.Second)).Empty())
+	require.True(t, dva.SpendableCoins(end.Add(-time.Second)).Empty())
+	require.Equal(t, stakeCoins(100), dva.GetVestedCoins(end))
+	require.Equal(t, stakeCoins(100), dva.SpendableCoins(end))
+}
+
+func TestVestingAccount_TrackDelegation(t *testing.T) {
+	end := time.Unix(1000, 0)
+	dva := NewDelayedVestingAccount(newTestVestingBase(150), stakeCoins(100), end.Unix())
+	before := end.Add(-time.Second)
+	// the locked coins are staked first
+	dva.TrackDelegation(before, stakeCoins(120))
+	require.Equal(t, stakeCoins(100), dva.DelegatedVesting)
+	require.Equal(t, stakeCoins(20), dva.DelegatedFree)
+	require.Nil(t, dva.SetCoins(stakeCoins(30)))
+	// the locked coins are staked so the coins held are spendable
+	require.Equal(t, stakeCoins(30), dva.SpendableCoins(before))
+	// the free coins are unstaked first
+	dva.TrackUndelegation(stakeCoins(50))
+	require.True(t, dva.DelegatedFree.Empty())
+	require.Equal(t, stakeCoins(70), dva.DelegatedVesting)
+	require.Nil(t, dva.SetCoins(stakeCoins(80)))
+	require.Equal(t, stakeCoins(50), dva.SpendableCoins(before))
+	// the unstaked amount can't exceed the staked coins
+	dva.TrackUndelegation(stakeCoins(1000))
+	require.True(t, dva.DelegatedVesting.Empty())
+}
+
+func TestVestingAccount_Validate(t *testing.T) {
+	tests := []struct {
+		name  string
+		acc   interface{ Validate() error }
+		valid bool
+	}{
+		{"continuous", NewContinuousVestingAccount(newTestVestingBase(1), stakeCoins(1), 10, 20), true},
+		{"delayed", NewDelayedVestingAccount(newTestVestingBase(1), stakeCoins(1), 20), true},
+		{"no start time", NewContinuousVestingAccount(newTestVestingBase(1), stakeCoins(1), 0, 20), false},
+		{"start after end", NewContinuousVestingAccount(newTestVestingBase(1), stakeCoins(1), 30, 20), false},
+		{"no end time", NewDelayedVestingAccount(newTestVestingBase(1), stakeCoins(1), 0), false},
+		{"no original vesting", NewDelayedVestingAccount(newTestVestingBase(1), nil, 20), false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.valid, tt.acc.Validate() == nil)
+		})
+	}
+}
+
+func TestVestingAccount_Proto(t *testing.T) {
+	cva := NewContinuousVestingAccount(newTestVestingBase(150), stakeCoins(100), 10, 20)
+	cva.TrackDelegation(time.Unix(15, 0), stakeCoins(60))
+	bz, err := cva.Marshal()
+	require.Nil(t, err)
+	var resC ContinuousVestingAccount
+	require.Nil(t, resC.Unmarshal(bz))
+	require.Equal(t, *cva, resC)
+	// a continuous vesting account is not a delayed one, nor a base or a module account
+	require.NotNil(t, (&DelayedVestingAccount{}).Unmarshal(bz))
+	require.NotNil(t, (&BaseAccount{}).Unmarshal(bz))
+	require.NotNil(t, (&ModuleAccount{}).Unmarshal(bz))
+	dva := NewDelayedVestingAccount(newTestVestingBase(150), stakeCoins(100), 20)
+	bz, err = dva.Marshal()
+	require.Nil(t, err)
+	var resD DelayedVestingAccount
+	require.Nil(t, resD.Unmarshal(bz))
+	require.Equal(t, *dva, resD)
+	require.NotNil(t, (&ContinuousVestingAccount{}).Unmarshal(bz))
+	// a base account is not a vesting account
+	base := newTestVestingBase(1)
+	bz, err = base.Marshal()
+	require.Nil(t, err)
+	require.NotNil(t, (&DelayedVestingAccount{}).Unmarshal(bz))
+}
+
+func TestVestingAccount_GenesisJSON(t *testing.T) {
+	cva := NewContinuousVestingAccount(newTestVestingBase(150), stakeCoins(100), 10, 20)
+	dva := NewDelayedVestingAccount(newTestVestingBase(150), stakeCoins(100), 20)
+	genesis := DefaultGenesisState()
+	genesis.Accounts = Accounts{cva, dva, newTestVestingBase(1)}
+	bz, err := ModuleCdc.MarshalJSON(genesis)
+	require.Nil(t, err)
+	var res GenesisState
+	require.Nil(t, ModuleCdc.UnmarshalJSON(bz, &res))
+	require.Equal(t, genesis.Accounts, res.Accounts)
+	require.Nil(t, ValidateGenesis(res))
+	// an invalid vesting account fails the genesis validation
+	genesis.Accounts = Accounts{NewDelayedVestingAccount(newTestVestingBase(150), stakeCoins(100), 0)}
+	require.NotNil(t, ValidateGenesis(genesis))
+}
@@ -2,6 +2,7 @@ package gov
 
 import (
 	"fmt"
+	"github.com/pokt-network/pocket-core/codec"
 	"github.com/pokt-network/pocket-core/crypto"
 	"reflect"
 
@@ -24,6 +25,14 @@ func NewHandler(k keeper.Keeper) sdk.Handler {
 			return handleMsgDaoTransfer(ctx, msg, k)
 		case types.MsgUpgrade:
 			return handleMsgUpgrade(ctx, msg, k)
+		case types.MsgSubmitProposal:
+			return handleMsgSubmitProposal(ctx, msg, k)
+		case types.MsgVote:
+			return handleMsgVote(ctx, msg, k)
+		case types.MsgScheduleParamChange:
+			return handleMsgScheduleParamChange(ctx, msg, k)
+		case types.MsgCancelParamChange:
+			return handleMsgCancelParamChange(ctx, msg, k)
 		default:
 			errMsg := fmt.Sprintf("unrecognized gov message type: %T", msg)
 			return sdk.ErrUnknownRequest(errMsg).Result()
@@ -52,3 +61,71 @@ func handleMsgDaoTransfer(ctx sdk.Ctx, msg types.MsgDAOTransfer, k keeper.Keeper
 func handleMsgUpgrade(ctx sdk.Ctx, msg types.MsgUpgrade, k keeper.Keeper) sdk.Result {
 	return k.HandleUpgrade(ctx, types.NewACLKey(ModuleName, string(types.UpgradeKey)), msg.Upgrade, msg.Address)
 }
+
+func handleMsgSubmitProposal(ctx sdk.Ctx, msg types.MsgSubmitProposal, k keeper.Keeper) sdk.Result {
+	if !k.GetCodec().IsAfterNamedFeatureActivationHeight(ctx.BlockHeight(), codec.GovProposalKey) {
+		return types.ErrProposalsNotActive(types.ModuleName).Result()
+	}
+	proposal, err := k.SubmitProposal(ctx, msg.Proposer, msg.Content)
+	if err != nil {
+		return err.Result()
+	}
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventSubmitProposal,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		sdk.NewAttribute(sdk.AttributeKeySender, msg.Proposer.String()),
+		sdk.NewAttribute(types.AttributeProposalID, fmt.Sprintf("%d", proposal.ID)),
+	))
+	return sdk.Result{Events: ctx.EventManager().Events()}
+}
+
+func handleMsgVote(ctx sdk.Ctx, msg types.MsgVote, k keeper.Keeper) sdk.Result {
+	if !k.GetCodec().IsAfterNamedFeatureActivationHeight(ctx.BlockHeight(), codec.GovProposalKey) {
+		return types.ErrProposalsNotActive(types.ModuleName).Result()
+	}
+	if err := k.AddVote(ctx, msg.ProposalID, msg.Voter, msg.Option); err != nil {
+		return err.Result()
+	}
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventVote,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		sdk.NewAttribute(sdk.AttributeKeySender, msg.Voter.String()),
+		sdk.NewAttribute(types.AttributeProposalID, fmt.Sprintf("%d", msg.ProposalID)),
+		sdk.NewAttribute(types.AttributeVoteOption, msg.Option),
+	))
+	return sdk.Result{Events: ctx.EventManager().Events()}
+}
+
+func handleMsgScheduleParamChange(ctx sdk.Ctx, msg types.MsgScheduleParamChange, k keeper.Keeper) sdk.Result {
+	if !k.GetCodec().IsAfterNamedFeatureActivationHeight(ctx.BlockHeight(), codec.ScheduledParamChangeKey) {
+		return types.ErrScheduledChangesNotActive(types.ModuleName).Result()
+	}
+	if err := k.ScheduleParamChange(ctx, msg.ParamKey, msg.ParamVal, msg.Height, msg.FromAddress); err != nil {
+		return err.Result()
+	}
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventScheduleParam,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		sdk.NewAttribute(sdk.AttributeKeySender, msg.FromAddress.String()),
+		sdk.NewAttribute(types.AttributeParamKey, msg.ParamKey),
+		sdk.NewAttribute(types.AttributeHeight, fmt.Sprintf("%d", msg.Height)),
+	))
+	return sdk.Result{Events: ctx.EventManager().Events()}
+}
+
+func handleMsgCancelParamChange(ctx sdk.Ctx, msg types.MsgCancelParamChange, k keeper.Keeper) sdk.Result {
+	if !k.GetCodec().IsAfterNamedFeatureActivationHeight(ctx.BlockHeight(), codec.ScheduledParamChangeKey) {
+		return types.ErrScheduledChangesNotActive(types.ModuleName).Result()
+	}
+	if err := k.CancelParamChange(ctx, msg.ParamKey, msg.Height, msg.FromAddress); err != nil {
+		return err.Result()
+	}
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventCancelParam,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		sdk.NewAttribute(sdk.AttributeKeySender, msg.FromAddress.String()),
+		sdk.NewAttribute(types.AttributeParamKey, msg.ParamKey),
+		sdk.NewAttribute(types.AttributeHeight, fmt.Sprintf("%d", msg.Height)),
+	))
+	return sdk.Result{Events: ctx.EventManager().Events()}
+}
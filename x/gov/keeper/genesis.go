@@ -26,10 +26,45 @@ func (k Keeper) InitGenesis(ctx sdk.Ctx, data types.GenesisState) []abci.Validat
 	if err != nil {
 		k.Logger(ctx).Error(fmt.Errorf("unable to set dao tokens: %s", err.Error()).Error())
 	}
+	// the proposals in their voting period go back in the queue of the active proposals
+	store := ctx.KVStore(k.key)
+	for _, proposal := range data.Proposals {
+		k.SetProposal(ctx, proposal)
+		if proposal.Status == types.ProposalStatusVoting {
+			_ = store.Set(types.ActiveProposalKey(proposal.VotingEndHeight, proposal.ID), types.ProposalKey(proposal.ID))
+		}
+	}
+	for _, vote := range data.Votes {
+		bz, err := k.cdc.MarshalBinaryBare(&vote, ctx.BlockHeight())
+		if err != nil {
+			k.Logger(ctx).Error(fmt.Errorf("unable to set the vote of %s on proposal %d: %s", vote.Voter, vote.ProposalID, err.Error()).Error())
+			os.Exit(1)
+		}
+		_ = store.Set(types.VoteKey(vote.ProposalID, vote.Voter), bz)
+	}
+	if data.NextProposalID > 0 {
+		k.setNextProposalID(ctx, data.NextProposalID)
+	}
+	for _, change := range data.ScheduledChanges {
+		bz, err := k.cdc.MarshalBinaryBare(&change, ctx.BlockHeight())
+		if err != nil {
+			k.Logger(ctx).Error(fmt.Errorf("unable to set the scheduled change of %s at height %d: %s", change.ParamKey, change.Height, err.Error()).Error())
+			os.Exit(1)
+		}
+		_ = store.Set(types.ScheduledChangeKey(change.Height, change.ParamKey), bz)
+	}
 	return []abci.ValidatorUpdate{}
 }
 
 // ExportGenesis returns a GenesisState for a given context and keeper
 func (k Keeper) ExportGenesis(ctx sdk.Ctx) types.GenesisState {
-	return types.NewGenesisState(k.GetParams(ctx), k.GetDAOTokens(ctx))
+	gs := types.NewGenesisState(k.GetParams(ctx), k.GetDAOTokens(ctx))
+	gs.Proposals = k.GetProposals(ctx, "")
+	gs.Votes = make([]types.Vote, 0)
+	for _, proposal := range gs.Proposals {
+		gs.Votes = append(gs.Votes, k.GetVotes(ctx, proposal.ID)...)
+	}
+	gs.NextProposalID = k.getNextProposalID(ctx)
+	gs.ScheduledChanges = k.GetScheduledParamChanges(ctx)
+	return gs
 }
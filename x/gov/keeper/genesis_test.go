@@ -4,6 +4,7 @@ import (
 	sdk "github.com/pokt-network/pocket-core/types"
 	"github.com/pokt-network/pocket-core/x/gov/types"
 	"github.com/stretchr/testify/assert"
+	"github.com/tendermint/go-amino"
 	abci "github.com/tendermint/tendermint/abci/types"
 	"testing"
 )
@@ -29,3 +30,59 @@ func TestExportGenesis(t *testing.T) {
 	assert.Equal(t, k.ExportGenesis(ctx).Params.ACL.String(), d.Params.ACL.String())
 	assert.Equal(t, k.ExportGenesis(ctx).DAOTokens.Int64(), d.DAOTokens.Int64())
 }
+
+func TestGenesis_Proposals(t *testing.T) {
+	ctx, k, vals := createTestKeeperWithValidators(t, 100, 100)
+	proposer := getRandomValidatorAddress()
+	content := newTestDAOOwnerChange(t, proposer, getRandomValidatorAddress())
+	p1, err := k.SubmitProposal(ctx, proposer, &content)
+	assert.Nil(t, err)
+	p2, err := k.SubmitProposal(ctx, proposer, &content)
+	assert.Nil(t, err)
+	assert.Nil(t, k.AddVote(ctx, p1.ID, vals[0], types.VoteOptionYes))
+	assert.Nil(t, k.AddVote(ctx, p1.ID, vals[1], types.VoteOptionYes))
+	assert.Nil(t, k.AddVote(ctx, p2.ID, vals[0], types.VoteOptionNo))
+	// round trip the genesis through its json
+	var gs types.GenesisState
+	types.ModuleCdc.MustUnmarshalJSON(types.ModuleCdc.MustMarshalJSON(k.ExportGenesis(ctx)), &gs)
+	assert.Len(t, gs.Proposals, 2)
+	assert.Len(t, gs.Votes, 3)
+	assert.Equal(t, p2.ID+1, gs.NextProposalID)
+	ctx2, k2 := createTestKeeperAndContext(t, false)
+	k2.PosKeeper = k.PosKeeper
+	k2.InitGenesis(ctx2, gs)
+	assert.Equal(t, k.GetProposals(ctx, ""), k2.GetProposals(ctx2, ""))
+	assert.Equal(t, k.GetVotes(ctx, p1.ID), k2.GetVotes(ctx2, p1.ID))
+	assert.Equal(t, k.GetVotes(ctx, p2.ID), k2.GetVotes(ctx2, p2.ID))
+	// the ids continue after the imported proposals
+	p3, err := k2.SubmitProposal(ctx2, proposer, &content)
+	assert.Nil(t, err)
+	assert.Equal(t, p2.ID+1, p3.ID)
+	// the imported proposals are still in the active queue
+	k2.EndVotingPeriods(ctx2.WithBlockHeight(p1.VotingEndHeight))
+	res, found := k2.GetProposal(ctx2, p1.ID)
+	assert.True(t, found)
+	assert.Equal(t, types.ProposalStatusPassed, res.Status)
+	res, found = k2.GetProposal(ctx2, p2.ID)
+	assert.True(t, found)
+	assert.Equal(t, types.ProposalStatusRejected, res.Status)
+}
+
+func TestGenesis_ScheduledChanges(t *testing.T) {
+	ctx, k := createTestKeeperAndContext(t, false)
+	aclKey := types.NewACLKey(types.ModuleName, string(types.DAOOwnerKey))
+	newOwner := getRandomValidatorAddress()
+	value, err := amino.MarshalJSON(newOwner)
+	assert.Nil(t, err)
+	height := ctx.BlockHeight() + 10
+	assert.Nil(t, k.ScheduleParamChange(ctx, aclKey, value, height, k.GetACL(ctx).GetOwner(aclKey)))
+	var gs types.GenesisState
+	types.ModuleCdc.MustUnmarshalJSON(types.ModuleCdc.MustMarshalJSON(k.ExportGenesis(ctx)), &gs)
+	assert.Len(t, gs.ScheduledChanges, 1)
+	ctx2, k2 := createTestKeeperAndContext(t, false)
+	k2.InitGenesis(ctx2, gs)
+	assert.Equal(t, k.GetScheduledParamChanges(ctx), k2.GetScheduledParamChanges(ctx2))
+	// the imported change is applied at its height
+	k2.ApplyScheduledParamChanges(ctx2.WithBlockHeight(height))
+	assert.Equal(t, newOwner, k2.GetDAOOwner(ctx2))
+}
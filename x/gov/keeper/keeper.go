@@ -17,6 +17,7 @@ type Keeper struct {
 	codespace  sdk.CodespaceType
 	paramstore sdk.Subspace
 	AuthKeeper types.AuthKeeper
+	PosKeeper  types.PosKeeper
 	spaces     map[string]sdk.Subspace
 }
 
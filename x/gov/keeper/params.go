@@ -20,15 +20,25 @@ func (k Keeper) GetACL(ctx sdk.Ctx) (res types.ACL) {
 // Get all parameteras as types.Params
 func (k Keeper) GetParams(ctx sdk.Ctx) types.Params {
 	return types.Params{
-		ACL:      k.GetACL(ctx),
-		Upgrade:  k.GetUpgrade(ctx),
-		DAOOwner: k.GetDAOOwner(ctx),
+		ACL:          k.GetACL(ctx),
+		Upgrade:      k.GetUpgrade(ctx),
+		DAOOwner:     k.GetDAOOwner(ctx),
+		VotingPeriod: k.GetVotingPeriod(ctx),
+		Quorum:       k.GetQuorum(ctx),
+		Threshold:    k.GetThreshold(ctx),
 	}
 }
 
 // set the params
 func (k Keeper) SetParams(ctx sdk.Ctx, params types.Params) {
-	k.paramstore.SetParamSet(ctx, &params)
+	if k.cdc.IsAfterNamedFeatureActivationHeight(ctx.BlockHeight(), codec.GovProposalKey) || params.VotingPeriod != 0 {
+		k.paramstore.SetParamSet(ctx, &params)
+		return
+	}
+	// the unset proposal params are not written before the proposals are activated so the past states stay the same
+	k.paramstore.Set(ctx, types.ACLKey, params.ACL)
+	k.paramstore.Set(ctx, types.DAOOwnerKey, params.DAOOwner)
+	k.paramstore.Set(ctx, types.UpgradeKey, params.Upgrade)
 }
 
 func (k Keeper) GetDAOOwner(ctx sdk.Ctx) (res sdk.Address) {
@@ -41,6 +51,21 @@ func (k Keeper) GetUpgrade(ctx sdk.Ctx) (res types.Upgrade) {
 	return
 }
 
+func (k Keeper) GetVotingPeriod(ctx sdk.Ctx) (res int64) {
+	k.paramstore.Get(ctx, types.VotingPeriodKey, &res)
+	return
+}
+
+func (k Keeper) GetQuorum(ctx sdk.Ctx) (res int64) {
+	k.paramstore.Get(ctx, types.QuorumKey, &res)
+	return
+}
+
+func (k Keeper) GetThreshold(ctx sdk.Ctx) (res int64) {
+	k.paramstore.Get(ctx, types.ThresholdKey, &res)
+	return
+}
+
 func (k Keeper) GetCodec() *codec.Codec {
 	return k.cdc
 }
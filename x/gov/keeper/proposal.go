@@ -0,0 +1,254 @@
+package keeper
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	sdk "github.com/pokt-network/pocket-core/types"
+	"github.com/pokt-network/pocket-core/x/gov/types"
+)
+
+// GetProposal returns the proposal with the id
+func (k Keeper) GetProposal(ctx sdk.Ctx, id int64) (proposal types.Proposal, found bool) {
+	store := ctx.KVStore(k.key)
+	bz, _ := store.Get(types.ProposalKey(id))
+	if bz == nil {
+		return proposal, false
+	}
+	if err := k.cdc.UnmarshalBinaryBare(bz, &proposal, ctx.BlockHeight()); err != nil {
+		ctx.Logger().Error(fmt.Sprintf("could not unmarshal the proposal %d: %s", id, err.Error()))
+		return proposal, false
+	}
+	return proposal, true
+}
+
+// SetProposal sets the proposal in the store
+func (k Keeper) SetProposal(ctx sdk.Ctx, proposal types.Proposal) {
+	store := ctx.KVStore(k.key)
+	bz, err := k.cdc.MarshalBinaryBare(&proposal, ctx.BlockHeight())
+	if err != nil {
+		ctx.Logger().Error(fmt.Sprintf("could not marshal the proposal %d: %s", proposal.ID, err.Error()))
+		return
+	}
+	_ = store.Set(types.ProposalKey(proposal.ID), bz)
+}
+
+// GetProposals returns the proposals with the status, or every proposal if the status is empty
+func (k Keeper) GetProposals(ctx sdk.Ctx, status string) (proposals []types.Proposal) {
+	proposals = make([]types.Proposal, 0)
+	store := ctx.KVStore(k.key)
+	iter, _ := sdk.KVStorePrefixIterator(store, types.ProposalKeyPrefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var proposal types.Proposal
+		if err := k.cdc.UnmarshalBinaryBare(iter.Value(), &proposal, ctx.BlockHeight()); err != nil {
+			ctx.Logger().Error(fmt.Sprintf("error while iterating proposals: unmarshalling proposal at height %d: %s", ctx.BlockHeight(), err.Error()))
+			continue
+		}
+		if status == "" || proposal.Status == status {
+			proposals = append(proposals, proposal)
+		}
+	}
+	return
+}
+
+// getNextProposalID returns the id of the next proposal, the ids start at 1
+func (k Keeper) getNextProposalID(ctx sdk.Ctx) int64 {
+	store := ctx.KVStore(k.key)
+	bz, _ := store.Get(types.ProposalIDKey)
+	if bz == nil {
+		return 1
+	}
+	return int64(binary.BigEndian.Uint64(bz))
+}
+
+func (k Keeper) setNextProposalID(ctx sdk.Ctx, id int64) {
+	store := ctx.KVStore(k.key)
+	_ = store.Set(types.ProposalIDKey, sdk.Uint64ToBigEndian(uint64(id)))
+}
+
+// SubmitProposal stores a new proposal and starts its voting period, the proposal is rejected if the limit of proposals
+// in their voting period is reached
+func (k Keeper) SubmitProposal(ctx sdk.Ctx, proposer sdk.Address, content sdk.Msg) (types.Proposal, sdk.Error) {
+	if err := k.validateProposalContent(ctx, content); err != nil {
+		return types.Proposal{}, err
+	}
+	if err := k.checkVotingProposalsLimit(ctx); err != nil {
+		return types.Proposal{}, err
+	}
+	id := k.getNextProposalID(ctx)
+	proposal := types.NewProposal(id, proposer, content, ctx.BlockHeight(), ctx.BlockHeight()+k.GetVotingPeriod(ctx))
+	k.SetProposal(ctx, proposal)
+	store := ctx.KVStore(k.key)
+	_ = store.Set(types.ActiveProposalKey(proposal.VotingEndHeight, id), types.ProposalKey(id))
+	k.setNextProposalID(ctx, id+1)
+	return proposal, nil
+}
+
+// checkVotingProposalsLimit returns an error if the number of proposals in their voting period is at the limit
+func (k Keeper) checkVotingProposalsLimit(ctx sdk.Ctx) sdk.Error {
+	store := ctx.KVStore(k.key)
+	iter, _ := sdk.KVStorePrefixIterator(store, types.ActiveProposalKeyPrefix)
+	defer iter.Close()
+	for count := 0; iter.Valid(); iter.Next() {
+		if count++; count >= types.MaxVotingProposals {
+			return types.ErrTooManyProposals(types.ModuleName, types.MaxVotingProposals)
+		}
+	}
+	return nil
+}
+
+// validateProposalContent checks that the content can be executed once the proposal passes
+func (k Keeper) validateProposalContent(ctx sdk.Ctx, content sdk.Msg) sdk.Error {
+	c, ok := types.ProposalContent(content)
+	if !ok {
+		return types.ErrInvalidProposalContent(types.ModuleName, fmt.Sprintf("%T is not a param change, a dao transfer or an upgrade", content))
+	}
+	if msg, ok := c.(types.MsgChangeParam); ok {
+		if k.GetACL(ctx).GetOwner(msg.ParamKey) == nil {
+			return types.ErrInvalidProposalContent(types.ModuleName, fmt.Sprintf("the param %s has no owner", msg.ParamKey))
+		}
+		subspaceName, paramKey := types.SplitACLKey(msg.ParamKey)
+		if err := k.validateProposalParam(subspaceName, paramKey, msg.ParamVal); err != nil {
+			return types.ErrInvalidProposalContent(types.ModuleName, err.Error())
+		}
+	}
+	return nil
+}
+
+// AddVote sets the vote of a staked validator on a proposal in its voting period, replacing its previous vote
+func (k Keeper) AddVote(ctx sdk.Ctx, id int64, voter sdk.Address, option string) sdk.Error {
+	proposal, found := k.GetProposal(ctx, id)
+	if !found {
+		return types.ErrProposalNotFound(types.ModuleName, id)
+	}
+	if proposal.Status != types.ProposalStatusVoting || ctx.BlockHeight() > proposal.VotingEndHeight {
+		return types.ErrInactiveProposal(types.ModuleName, id)
+	}
+	if err := types.ValidateVoteOption(option); err != nil {
+		return err
+	}
+	if !k.isVotingValidator(ctx, voter) {
+		return types.ErrNonValidatorVoter(types.ModuleName, voter)
+	}
+	vote := types.Vote{ProposalID: id, Voter: voter, Option: option}
+	bz, err := k.cdc.MarshalBinaryBare(&vote, ctx.BlockHeight())
+	if err != nil {
+		return sdk.ErrInternal(err.Error())
+	}
+	store := ctx.KVStore(k.key)
+	_ = store.Set(types.VoteKey(id, voter), bz)
+	return nil
+}
+
+// GetVotes returns the votes of a proposal
+func (k Keeper) GetVotes(ctx sdk.Ctx, id int64) (votes []types.Vote) {
+	votes = make([]types.Vote, 0)
+	store := ctx.KVStore(k.key)
+	iter, _ := sdk.KVStorePrefixIterator(store, types.VotesKey(id))
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var vote types.Vote
+		if err := k.cdc.UnmarshalBinaryBare(iter.Value(), &vote, ctx.BlockHeight()); err != nil {
+			ctx.Logger().Error(fmt.Sprintf("error while iterating votes: unmarshalling vote at height %d: %s", ctx.BlockHeight(), err.Error()))
+			continue
+		}
+		votes = append(votes, vote)
+	}
+	return
+}
+
+// isVotingValidator returns whether the address is a staked validator that is not jailed
+func (k Keeper) isVotingValidator(ctx sdk.Ctx, addr sdk.Address) bool {
+	val := k.PosKeeper.Validator(ctx, addr)
+	return val != nil && val.IsStaked() && !val.IsJailed()
+}
+
+// Tally weights the votes of a proposal with the current stake of the voters, the validators that unstaked or got
+// jailed since they voted are not counted
+func (k Keeper) Tally(ctx sdk.Ctx, proposal types.Proposal) (tally types.TallyResult, passes bool) {
+	totalStake := sdk.ZeroInt()
+	for _, val := range k.PosKeeper.GetStakedValidators(ctx) {
+		if !val.IsJailed() {
+			totalStake = totalStake.Add(val.GetTokens())
+		}
+	}
+	tally = types.EmptyTallyResult()
+	for _, vote := range k.GetVotes(ctx, proposal.ID) {
+		val := k.PosKeeper.Validator(ctx, vote.Voter)
+		if val == nil || !val.IsStaked() || val.IsJailed() {
+			continue
+		}
+		tally = tally.Add(vote.Option, val.GetTokens())
+	}
+	return tally, tally.Passes(totalStake, k.GetQuorum(ctx), k.GetThreshold(ctx))
+}
+
+// EndVotingPeriods tallies the proposals whose voting period ends at the block height and executes the ones that pass
+func (k Keeper) EndVotingPeriods(ctx sdk.Ctx) {
+	store := ctx.KVStore(k.key)
+	iter, _ := store.Iterator(types.ActiveProposalKeyPrefix, sdk.PrefixEndBytes(types.ActiveProposalsByHeightKey(ctx.BlockHeight())))
+	ended := make([][]byte, 0)
+	for ; iter.Valid(); iter.Next() {
+		ended = append(ended, iter.Key())
+	}
+	iter.Close()
+	for _, key := range ended {
+		id := int64(binary.BigEndian.Uint64(key[len(key)-8:]))
+		_ = store.Delete(key)
+		proposal, found := k.GetProposal(ctx, id)
+		if !found {
+			continue
+		}
+		tally, passes := k.Tally(ctx, proposal)
+		proposal.FinalTally = tally
+		proposal.Status = types.ProposalStatusRejected
+		if passes {
+			proposal.Status = types.ProposalStatusPassed
+			// the content is executed atomically, if it fails none of it is applied
+			cacheCtx, writeCache := ctx.CacheContext()
+			if res := k.executeProposal(cacheCtx, proposal); res.IsOK() {
+				writeCache()
+				ctx.EventManager().EmitEvents(res.Events)
+			} else {
+				ctx.Logger().Error(fmt.Sprintf("the execution of the proposal %d failed: %s", id, res.Log))
+				proposal.Status = types.ProposalStatusFailed
+			}
+		}
+		k.SetProposal(ctx, proposal)
+		ctx.EventManager().EmitEvent(sdk.NewEvent(
+			types.EventProposalResult,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(types.AttributeProposalID, fmt.Sprintf("%d", id)),
+			sdk.NewAttribute(types.AttributeStatus, proposal.Status),
+		))
+	}
+}
+
+// executeProposal executes the content of a passed proposal through the paths of its message, as if it was sent by
+// the owner of the param
+func (k Keeper) executeProposal(ctx sdk.Ctx, proposal types.Proposal) sdk.Result {
+	if err := k.validateProposalContent(ctx, proposal.Content); err != nil {
+		return err.Result()
+	}
+	content, _ := types.ProposalContent(proposal.Content)
+	switch msg := content.(type) {
+	case types.MsgChangeParam:
+		return k.ModifyParam(ctx, msg.ParamKey, msg.ParamVal, k.GetACL(ctx).GetOwner(msg.ParamKey))
+	case types.MsgDAOTransfer:
+		da, err := types.DAOActionFromString(msg.Action)
+		if err != nil {
+			return err.Result()
+		}
+		switch da {
+		case types.DAOTransfer:
+			return k.DAOTransferFrom(ctx, k.GetDAOOwner(ctx), msg.ToAddress, msg.Amount)
+		case types.DAOBurn:
+			return k.DAOBurn(ctx, k.GetDAOOwner(ctx), msg.Amount)
+		}
+	case types.MsgUpgrade:
+		aclKey := types.NewACLKey(types.ModuleName, string(types.UpgradeKey))
+		return k.HandleUpgrade(ctx, aclKey, msg.Upgrade, k.GetACL(ctx).GetOwner(aclKey))
+	}
+	return types.ErrInvalidProposalContent(types.ModuleName, fmt.Sprintf("%T is not a param change, a dao transfer or an upgrade", content)).Result()
+}
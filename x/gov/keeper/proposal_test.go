@@ -0,0 +1,183 @@
+package keeper
+
+import (
+	"testing"
+
+	sdk "github.com/pokt-network/pocket-core/types"
+	"github.com/pokt-network/pocket-core/x/gov/types"
+	nodesExported "github.com/pokt-network/pocket-core/x/nodes/exported"
+	nodesTypes "github.com/pokt-network/pocket-core/x/nodes/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/tendermint/go-amino"
+)
+
+type mockPosKeeper struct {
+	validators map[string]nodesTypes.Validator
+}
+
+func (m mockPosKeeper) Validator(_ sdk.Ctx, addr sdk.Address) nodesExported.ValidatorI {
+	val, found := m.validators[addr.String()]
+	if !found {
+		return nil
+	}
+	return val
+}
+
+func (m mockPosKeeper) GetStakedValidators(_ sdk.Ctx) (validators []nodesExported.ValidatorI) {
+	for _, val := range m.validators {
+		if val.IsStaked() {
+			validators = append(validators, val)
+		}
+	}
+	return
+}
+
+// creates a keeper with the validators staked with the tokens
+func createTestKeeperWithValidators(t *testing.T, tokens ...int64) (sdk.Context, Keeper, []sdk.Address) {
+	ctx, k := createTestKeeperAndContext(t, false)
+	pk := mockPosKeeper{validators: make(map[string]nodesTypes.Validator)}
+	addrs := make([]sdk.Address, 0)
+	for _, amount := range tokens {
+		addr := getRandomValidatorAddress()
+		pk.validators[addr.String()] = nodesTypes.Validator{Address: addr, Status: sdk.Staked, StakedTokens: sdk.NewInt(amount)}
+		addrs = append(addrs, addr)
+	}
+	k.PosKeeper = pk
+	return ctx, k, addrs
+}
+
+func newTestDAOOwnerChange(t *testing.T, proposer, newOwner sdk.Address) types.MsgChangeParam {
+	jbyte, err := amino.MarshalJSON(newOwner)
+	assert.Nil(t, err)
+	return types.MsgChangeParam{
+		FromAddress: proposer,
+		ParamKey:    types.NewACLKey(types.ModuleName, string(types.DAOOwnerKey)),
+		ParamVal:    jbyte,
+	}
+}
+
+func TestKeeper_SubmitProposal(t *testing.T) {
+	ctx, k, _ := createTestKeeperWithValidators(t, 100)
+	proposer := getRandomValidatorAddress()
+	content := newTestDAOOwnerChange(t, proposer, getRandomValidatorAddress())
+	p, err := k.SubmitProposal(ctx, proposer, &content)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(1), p.ID)
+	assert.Equal(t, ctx.BlockHeight()+types.DefaultVotingPeriod, p.VotingEndHeight)
+	p2, err := k.SubmitProposal(ctx, proposer, &content)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(2), p2.ID)
+	res, found := k.GetProposal(ctx, 1)
+	assert.True(t, found)
+	assert.Equal(t, types.ProposalStatusVoting, res.Status)
+	resContent, ok := types.ProposalContent(res.Content)
+	assert.True(t, ok)
+	assert.Equal(t, content, resContent)
+	assert.Len(t, k.GetProposals(ctx, types.ProposalStatusVoting), 2)
+	assert.Len(t, k.GetProposals(ctx, types.ProposalStatusPassed), 0)
+	// a param without owner can't be proposed
+	content.ParamKey = "auth/FakeParam"
+	_, err = k.SubmitProposal(ctx, proposer, &content)
+	assert.NotNil(t, err)
+	// only the params, dao transfers and upgrades can be proposed
+	_, err = k.SubmitProposal(ctx, proposer, &types.MsgVote{Voter: proposer, ProposalID: 1, Option: types.VoteOptionYes})
+	assert.NotNil(t, err)
+}
+
+func TestKeeper_SubmitProposalLimit(t *testing.T) {
+	ctx, k, _ := createTestKeeperWithValidators(t, 100)
+	proposer := getRandomValidatorAddress()
+	content := newTestDAOOwnerChange(t, proposer, getRandomValidatorAddress())
+	var first types.Proposal
+	for i := 0; i < types.MaxVotingProposals; i++ {
+		p, err := k.SubmitProposal(ctx.WithBlockHeight(ctx.BlockHeight()+int64(i)), proposer, &content)
+		assert.Nil(t, err)
+		if i == 0 {
+			first = p
+		}
+	}
+	_, err := k.SubmitProposal(ctx, proposer, &content)
+	assert.NotNil(t, err)
+	assert.Equal(t, types.CodeTooManyProposals, err.Code())
+	// the end of a voting period frees a slot
+	k.EndVotingPeriods(ctx.WithBlockHeight(first.VotingEndHeight))
+	_, err = k.SubmitProposal(ctx.WithBlockHeight(first.VotingEndHeight), proposer, &content)
+	assert.Nil(t, err)
+}
+
+func TestKeeper_AddVote(t *testing.T) {
+	ctx, k, vals := createTestKeeperWithValidators(t, 100, 100)
+	proposer := getRandomValidatorAddress()
+	content := newTestDAOOwnerChange(t, proposer, getRandomValidatorAddress())
+	p, err := k.SubmitProposal(ctx, proposer, &content)
+	assert.Nil(t, err)
+	assert.Nil(t, k.AddVote(ctx, p.ID, vals[0], types.VoteOptionYes))
+	// the vote is replaced
+	assert.Nil(t, k.AddVote(ctx, p.ID, vals[0], types.VoteOptionNo))
+	votes := k.GetVotes(ctx, p.ID)
+	assert.Len(t, votes, 1)
+	assert.Equal(t, types.VoteOptionNo, votes[0].Option)
+	// not a validator
+	assert.NotNil(t, k.AddVote(ctx, p.ID, getRandomValidatorAddress(), types.VoteOptionYes))
+	// not a proposal
+	assert.NotNil(t, k.AddVote(ctx, p.ID+1, vals[1], types.VoteOptionYes))
+	// not an option
+	assert.NotNil(t, k.AddVote(ctx, p.ID, vals[1], "maybe"))
+	// after the voting period
+	assert.NotNil(t, k.AddVote(ctx.WithBlockHeight(p.VotingEndHeight+1), p.ID, vals[1], types.VoteOptionYes))
+}
+
+func TestKeeper_EndVotingPeriods(t *testing.T) {
+	ctx, k, vals := createTestKeeperWithValidators(t, 40, 30, 30)
+	proposer := getRandomValidatorAddress()
+	newOwner := getRandomValidatorAddress()
+	content := newTestDAOOwnerChange(t, proposer, newOwner)
+	passing, err := k.SubmitProposal(ctx, proposer, &content)
+	assert.Nil(t, err)
+	rejected, err := k.SubmitProposal(ctx, proposer, &content)
+	assert.Nil(t, err)
+	assert.Nil(t, k.AddVote(ctx, passing.ID, vals[0], types.VoteOptionYes))
+	assert.Nil(t, k.AddVote(ctx, passing.ID, vals[1], types.VoteOptionNo))
+	assert.Nil(t, k.AddVote(ctx, rejected.ID, vals[0], types.VoteOptionNo))
+	assert.Nil(t, k.AddVote(ctx, rejected.ID, vals[1], types.VoteOptionYes))
+	// nothing ends before the voting end height
+	k.EndVotingPeriods(ctx.WithBlockHeight(passing.VotingEndHeight - 1))
+	assert.Len(t, k.GetProposals(ctx, types.ProposalStatusVoting), 2)
+	endCtx := ctx.WithBlockHeight(passing.VotingEndHeight)
+	k.EndVotingPeriods(endCtx)
+	res, found := k.GetProposal(endCtx, passing.ID)
+	assert.True(t, found)
+	assert.Equal(t, types.ProposalStatusPassed, res.Status)
+	assert.True(t, res.FinalTally.Yes.Equal(sdk.NewInt(40)))
+	assert.True(t, res.FinalTally.No.Equal(sdk.NewInt(30)))
+	res, found = k.GetProposal(endCtx, rejected.ID)
+	assert.True(t, found)
+	assert.Equal(t, types.ProposalStatusRejected, res.Status)
+	// the passed proposal is executed by the owner of the param
+	assert.Equal(t, newOwner, k.GetDAOOwner(endCtx))
+	// the ended proposals are not tallied again
+	k.EndVotingPeriods(endCtx.WithBlockHeight(passing.VotingEndHeight + 1))
+	res, _ = k.GetProposal(endCtx, rejected.ID)
+	assert.Equal(t, types.ProposalStatusRejected, res.Status)
+}
+
+func TestKeeper_EndVotingPeriodsFailedExecution(t *testing.T) {
+	ctx, k, vals := createTestKeeperWithValidators(t, 100)
+	proposer := getRandomValidatorAddress()
+	// the dao holds no tokens so the transfer fails
+	content := types.MsgDAOTransfer{
+		FromAddress: proposer,
+		ToAddress:   getRandomValidatorAddress(),
+		Amount:      sdk.NewInt(1000),
+		Action:      types.DAOTransferString,
+	}
+	p, err := k.SubmitProposal(ctx, proposer, &content)
+	assert.Nil(t, err)
+	assert.Nil(t, k.AddVote(ctx, p.ID, vals[0], types.VoteOptionYes))
+	endCtx := ctx.WithBlockHeight(p.VotingEndHeight)
+	k.EndVotingPeriods(endCtx)
+	res, found := k.GetProposal(endCtx, p.ID)
+	assert.True(t, found)
+	assert.Equal(t, types.ProposalStatusFailed, res.Status)
+	assert.True(t, k.GetDAOTokens(endCtx).IsZero())
+}
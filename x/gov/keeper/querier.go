@@ -1,6 +1,8 @@
 package keeper
 
 import (
+	"fmt"
+
 	"github.com/pokt-network/pocket-core/codec"
 	sdk "github.com/pokt-network/pocket-core/types"
 	"github.com/pokt-network/pocket-core/x/gov/types"
@@ -19,6 +21,14 @@ func NewQuerier(k Keeper) sdk.Querier {
 			return queryDAOOwner(ctx, k)
 		case types.QueryUpgrade:
 			return queryUpgrade(ctx, k)
+		case types.QueryProposals:
+			return queryProposals(ctx, req, k)
+		case types.QueryProposal:
+			return queryProposal(ctx, req, k)
+		case types.QueryVotes:
+			return queryVotes(ctx, req, k)
+		case types.QuerySchedule:
+			return queryScheduledChanges(ctx, k)
 		default:
 			return nil, sdk.ErrUnknownRequest("unknown gov query endpoint")
 		}
@@ -60,3 +70,54 @@ func queryUpgrade(ctx sdk.Ctx, k Keeper) ([]byte, sdk.Error) {
 	}
 	return res, nil
 }
+
+func queryProposals(ctx sdk.Ctx, req abci.RequestQuery, k Keeper) ([]byte, sdk.Error) {
+	var params types.QueryProposalsParams
+	if err := types.ModuleCdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdk.ErrInternal(fmt.Sprintf("failed to parse params: %s", err))
+	}
+	proposals := k.GetProposals(ctx, params.Status)
+	res, err := codec.MarshalJSONIndent(types.ModuleCdc, proposals)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("failed to JSON marshal result: %s", err.Error()))
+	}
+	return res, nil
+}
+
+func queryProposal(ctx sdk.Ctx, req abci.RequestQuery, k Keeper) ([]byte, sdk.Error) {
+	var params types.QueryProposalParams
+	if err := types.ModuleCdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdk.ErrInternal(fmt.Sprintf("failed to parse params: %s", err))
+	}
+	proposal, found := k.GetProposal(ctx, params.ID)
+	if !found {
+		return nil, types.ErrProposalNotFound(types.ModuleName, params.ID)
+	}
+	res, err := codec.MarshalJSONIndent(types.ModuleCdc, proposal)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("failed to JSON marshal result: %s", err.Error()))
+	}
+	return res, nil
+}
+
+func queryVotes(ctx sdk.Ctx, req abci.RequestQuery, k Keeper) ([]byte, sdk.Error) {
+	var params types.QueryVotesParams
+	if err := types.ModuleCdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdk.ErrInternal(fmt.Sprintf("failed to parse params: %s", err))
+	}
+	votes := k.GetVotes(ctx, params.ProposalID)
+	res, err := codec.MarshalJSONIndent(types.ModuleCdc, votes)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("failed to JSON marshal result: %s", err.Error()))
+	}
+	return res, nil
+}
+
+func queryScheduledChanges(ctx sdk.Ctx, k Keeper) ([]byte, sdk.Error) {
+	changes := k.GetScheduledParamChanges(ctx)
+	res, err := codec.MarshalJSONIndent(types.ModuleCdc, changes)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("failed to JSON marshal result: %s", err.Error()))
+	}
+	return res, nil
+}
@@ -0,0 +1,113 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/pokt-network/pocket-core/types"
+	"github.com/pokt-network/pocket-core/x/gov/types"
+)
+
+const (
+	scheduledChangeApplied = "applied"
+	scheduledChangeFailed  = "failed"
+)
+
+// ScheduleParamChange stores a param change of the owner of the param, applied at the beginning of the block at the height.
+// A change of the same param at the same height is replaced
+func (k Keeper) ScheduleParamChange(ctx sdk.Ctx, aclKey string, paramValue []byte, height int64, owner sdk.Address) sdk.Error {
+	if height <= ctx.BlockHeight() {
+		return types.ErrInvalidScheduleHeight(types.ModuleName, height, ctx.BlockHeight())
+	}
+	if err := k.VerifyACL(ctx, aclKey, owner); err != nil {
+		return err
+	}
+	subspaceName, paramKey := types.SplitACLKey(aclKey)
+	space, ok := k.GetSubspace(subspaceName)
+	if !ok {
+		return types.ErrSubspaceNotFound(types.ModuleName, subspaceName)
+	}
+	// the value must decode to the param, it is tried on a cache that is discarded
+	cacheCtx, _ := ctx.CacheContext()
+	if err := space.Update(cacheCtx, []byte(paramKey), paramValue); err != nil {
+		return types.ErrSettingParameter(types.ModuleName, aclKey, "", string(paramValue), err.Error())
+	}
+	if err := k.validateProposalParam(subspaceName, paramKey, paramValue); err != nil {
+		return types.ErrSettingParameter(types.ModuleName, aclKey, "", string(paramValue), err.Error())
+	}
+	change := types.NewScheduledParamChange(height, aclKey, paramValue, owner)
+	bz, err := k.cdc.MarshalBinaryBare(&change, ctx.BlockHeight())
+	if err != nil {
+		return sdk.ErrInternal(err.Error())
+	}
+	store := ctx.KVStore(k.key)
+	_ = store.Set(types.ScheduledChangeKey(height, aclKey), bz)
+	return nil
+}
+
+// CancelParamChange removes a scheduled param change, only the current owner of the param can cancel it
+func (k Keeper) CancelParamChange(ctx sdk.Ctx, aclKey string, height int64, owner sdk.Address) sdk.Error {
+	store := ctx.KVStore(k.key)
+	key := types.ScheduledChangeKey(height, aclKey)
+	if bz, _ := store.Get(key); bz == nil {
+		return types.ErrScheduledChangeNotFound(types.ModuleName, aclKey, height)
+	}
+	if err := k.VerifyACL(ctx, aclKey, owner); err != nil {
+		return err
+	}
+	_ = store.Delete(key)
+	return nil
+}
+
+// GetScheduledParamChanges returns the param changes that are not applied yet, sorted by height
+func (k Keeper) GetScheduledParamChanges(ctx sdk.Ctx) (changes []types.ScheduledParamChange) {
+	changes = make([]types.ScheduledParamChange, 0)
+	store := ctx.KVStore(k.key)
+	iter, _ := sdk.KVStorePrefixIterator(store, types.ScheduledChangeKeyPrefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var change types.ScheduledParamChange
+		if err := k.cdc.UnmarshalBinaryBare(iter.Value(), &change, ctx.BlockHeight()); err != nil {
+			ctx.Logger().Error(fmt.Sprintf("error while iterating scheduled param changes: unmarshalling change at height %d: %s", ctx.BlockHeight(), err.Error()))
+			continue
+		}
+		changes = append(changes, change)
+	}
+	return
+}
+
+// ApplyScheduledParamChanges applies the param changes scheduled at or before the block height. The change is made as
+// the account that scheduled it, so it fails if the account is no longer the owner of the param
+func (k Keeper) ApplyScheduledParamChanges(ctx sdk.Ctx) {
+	store := ctx.KVStore(k.key)
+	iter, _ := store.Iterator(types.ScheduledChangeKeyPrefix, sdk.PrefixEndBytes(types.ScheduledChangesByHeightKey(ctx.BlockHeight())))
+	keys, values := make([][]byte, 0), make([][]byte, 0)
+	for ; iter.Valid(); iter.Next() {
+		keys = append(keys, iter.Key())
+		values = append(values, iter.Value())
+	}
+	iter.Close()
+	for i, key := range keys {
+		_ = store.Delete(key)
+		var change types.ScheduledParamChange
+		if err := k.cdc.UnmarshalBinaryBare(values[i], &change, ctx.BlockHeight()); err != nil {
+			ctx.Logger().Error(fmt.Sprintf("could not unmarshal the scheduled param change at height %d: %s", ctx.BlockHeight(), err.Error()))
+			continue
+		}
+		status := scheduledChangeApplied
+		cacheCtx, writeCache := ctx.CacheContext()
+		if res := k.ModifyParam(cacheCtx, change.ParamKey, change.ParamVal, change.Owner); res.IsOK() {
+			writeCache()
+			ctx.EventManager().EmitEvents(res.Events)
+		} else {
+			ctx.Logger().Error(fmt.Sprintf("the scheduled change of %s at height %d failed: %s", change.ParamKey, change.Height, res.Log))
+			status = scheduledChangeFailed
+		}
+		ctx.EventManager().EmitEvent(sdk.NewEvent(
+			types.EventScheduledParam,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(types.AttributeParamKey, change.ParamKey),
+			sdk.NewAttribute(types.AttributeHeight, fmt.Sprintf("%d", change.Height)),
+			sdk.NewAttribute(types.AttributeStatus, status),
+		))
+	}
+}
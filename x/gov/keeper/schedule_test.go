@@ -0,0 +1,89 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/pokt-network/pocket-core/x/gov/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/tendermint/go-amino"
+)
+
+func TestKeeper_ScheduleParamChange(t *testing.T) {
+	ctx, k := createTestKeeperAndContext(t, false)
+	aclKey := types.NewACLKey(types.ModuleName, string(types.DAOOwnerKey))
+	owner := k.GetACL(ctx).GetOwner(aclKey)
+	value, err := amino.MarshalJSON(getRandomValidatorAddress())
+	assert.Nil(t, err)
+	// not the owner of the param
+	assert.NotNil(t, k.ScheduleParamChange(ctx, aclKey, value, ctx.BlockHeight()+10, getRandomValidatorAddress()))
+	// not a future height
+	assert.NotNil(t, k.ScheduleParamChange(ctx, aclKey, value, ctx.BlockHeight(), owner))
+	// not a value of the param
+	assert.NotNil(t, k.ScheduleParamChange(ctx, aclKey, []byte(`{"not":"an address"}`), ctx.BlockHeight()+10, owner))
+	assert.Len(t, k.GetScheduledParamChanges(ctx), 0)
+	assert.Nil(t, k.ScheduleParamChange(ctx, aclKey, value, ctx.BlockHeight()+20, owner))
+	assert.Nil(t, k.ScheduleParamChange(ctx, aclKey, value, ctx.BlockHeight()+10, owner))
+	// the change at the same height is replaced
+	assert.Nil(t, k.ScheduleParamChange(ctx, aclKey, value, ctx.BlockHeight()+10, owner))
+	changes := k.GetScheduledParamChanges(ctx)
+	assert.Len(t, changes, 2)
+	assert.Equal(t, ctx.BlockHeight()+10, changes[0].Height)
+	assert.Equal(t, ctx.BlockHeight()+20, changes[1].Height)
+	assert.Equal(t, owner, changes[0].Owner)
+}
+
+func TestKeeper_CancelParamChange(t *testing.T) {
+	ctx, k := createTestKeeperAndContext(t, false)
+	aclKey := types.NewACLKey(types.ModuleName, string(types.DAOOwnerKey))
+	owner := k.GetACL(ctx).GetOwner(aclKey)
+	value, err := amino.MarshalJSON(getRandomValidatorAddress())
+	assert.Nil(t, err)
+	height := ctx.BlockHeight() + 10
+	assert.Nil(t, k.ScheduleParamChange(ctx, aclKey, value, height, owner))
+	// not the owner of the param
+	assert.NotNil(t, k.CancelParamChange(ctx, aclKey, height, getRandomValidatorAddress()))
+	// nothing scheduled at the height
+	assert.NotNil(t, k.CancelParamChange(ctx, aclKey, height+1, owner))
+	assert.Nil(t, k.CancelParamChange(ctx, aclKey, height, owner))
+	assert.Len(t, k.GetScheduledParamChanges(ctx), 0)
+}
+
+func TestKeeper_ApplyScheduledParamChanges(t *testing.T) {
+	ctx, k := createTestKeeperAndContext(t, false)
+	aclKey := types.NewACLKey(types.ModuleName, string(types.DAOOwnerKey))
+	owner := k.GetACL(ctx).GetOwner(aclKey)
+	daoOwner := k.GetDAOOwner(ctx)
+	newOwner := getRandomValidatorAddress()
+	value, err := amino.MarshalJSON(newOwner)
+	assert.Nil(t, err)
+	height := ctx.BlockHeight() + 10
+	assert.Nil(t, k.ScheduleParamChange(ctx, aclKey, value, height, owner))
+	// nothing is applied before the height
+	k.ApplyScheduledParamChanges(ctx.WithBlockHeight(height - 1))
+	assert.Len(t, k.GetScheduledParamChanges(ctx), 1)
+	assert.Equal(t, daoOwner, k.GetDAOOwner(ctx))
+	applyCtx := ctx.WithBlockHeight(height)
+	k.ApplyScheduledParamChanges(applyCtx)
+	assert.Len(t, k.GetScheduledParamChanges(applyCtx), 0)
+	assert.Equal(t, newOwner, k.GetDAOOwner(applyCtx))
+}
+
+func TestKeeper_ApplyScheduledParamChangesNotOwner(t *testing.T) {
+	ctx, k := createTestKeeperAndContext(t, false)
+	aclKey := types.NewACLKey(types.ModuleName, string(types.DAOOwnerKey))
+	owner := k.GetACL(ctx).GetOwner(aclKey)
+	daoOwner := k.GetDAOOwner(ctx)
+	value, err := amino.MarshalJSON(getRandomValidatorAddress())
+	assert.Nil(t, err)
+	height := ctx.BlockHeight() + 10
+	assert.Nil(t, k.ScheduleParamChange(ctx, aclKey, value, height, owner))
+	// the ownership of the param changes before the height
+	acl := k.GetACL(ctx)
+	acl.SetOwner(aclKey, getRandomValidatorAddress())
+	k.paramstore.Set(ctx, types.ACLKey, acl)
+	applyCtx := ctx.WithBlockHeight(height)
+	k.ApplyScheduledParamChanges(applyCtx)
+	// the failed change is removed and the param is unchanged
+	assert.Len(t, k.GetScheduledParamChanges(applyCtx), 0)
+	assert.Equal(t, daoOwner, k.GetDAOOwner(applyCtx))
+}
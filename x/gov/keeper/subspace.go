@@ -213,7 +213,12 @@ func (k Keeper) ModifyParam(ctx sdk.Ctx, aclKey string, paramValue []byte, owner
 		k.Logger(ctx).Error(types.ErrSubspaceNotFound(types.ModuleName, subspaceName).Error())
 		os.Exit(1)
 	}
-	_ = space.Update(ctx, []byte(paramKey), paramValue)
+	if err := k.validateProposalParam(subspaceName, paramKey, paramValue); err != nil {
+		return types.ErrSettingParameter(types.ModuleName, aclKey, "", string(paramValue), err.Error()).Result()
+	}
+	if err := space.Update(ctx, []byte(paramKey), paramValue); err != nil {
+		return types.ErrSettingParameter(types.ModuleName, aclKey, "", string(paramValue), err.Error()).Result()
+	}
 	k.spaces[subspaceName] = space
 	// create the event
 	ctx.EventManager().EmitEvents(sdk.Events{
@@ -231,3 +236,19 @@ func (k Keeper) ModifyParam(ctx sdk.Ctx, aclKey string, paramValue []byte, owner
 	})
 	return sdk.Result{Events: ctx.EventManager().Events()}
 }
+
+// validateProposalParam checks the range of the new value of a proposal param, the other params are not checked
+func (k Keeper) validateProposalParam(subspaceName, paramKey string, paramValue []byte) error {
+	if subspaceName != types.ModuleName {
+		return nil
+	}
+	switch paramKey {
+	case string(types.VotingPeriodKey), string(types.QuorumKey), string(types.ThresholdKey):
+		var value int64
+		if err := k.cdc.UnmarshalJSON(paramValue, &value); err != nil {
+			return err
+		}
+		return types.ValidateProposalParam(paramKey, value)
+	}
+	return nil
+}
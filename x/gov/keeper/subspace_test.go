@@ -36,4 +36,50 @@ func TestModifyParam(t *testing.T) {
 			),
 		),
 	)
+	// a value that doesn't decode to the param is an error and the param is kept
+	res = k.ModifyParam(ctx, aclKey, []byte(`{"not":"an address"}`), k.GetACL(ctx).GetOwner(aclKey))
+	assert.False(t, res.IsOK())
+	assert.Equal(t, addr, k.GetDAOOwner(ctx))
+}
+
+func TestModifyParam_ProposalParams(t *testing.T) {
+	ctx, k := createTestKeeperAndContext(t, false)
+	owner := getRandomValidatorAddress()
+	acl := k.GetACL(ctx)
+	for _, key := range [][]byte{types.VotingPeriodKey, types.QuorumKey, types.ThresholdKey} {
+		acl.SetOwner(types.NewACLKey(types.ModuleName, string(key)), owner)
+	}
+	k.paramstore.Set(ctx, types.ACLKey, &acl)
+	tests := []struct {
+		name  string
+		key   []byte
+		value int64
+		valid bool
+	}{
+		{"negative voting period", types.VotingPeriodKey, -1, false},
+		{"zero voting period", types.VotingPeriodKey, 0, false},
+		{"voting period", types.VotingPeriodKey, 100, true},
+		{"negative quorum", types.QuorumKey, -1, false},
+		{"quorum above 100", types.QuorumKey, 101, false},
+		{"quorum", types.QuorumKey, 100, true},
+		{"threshold of 100", types.ThresholdKey, 100, false},
+		{"threshold", types.ThresholdKey, 66, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var before int64
+			k.paramstore.GetIfExists(ctx, tt.key, &before)
+			jbyte, _ := amino.MarshalJSON(tt.value)
+			res := k.ModifyParam(ctx, types.NewACLKey(types.ModuleName, string(tt.key)), jbyte, owner)
+			var after int64
+			k.paramstore.GetIfExists(ctx, tt.key, &after)
+			if tt.valid {
+				assert.True(t, res.IsOK(), res.Log)
+				assert.Equal(t, tt.value, after)
+			} else {
+				assert.False(t, res.IsOK())
+				assert.Equal(t, before, after)
+			}
+		})
+	}
 }
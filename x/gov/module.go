@@ -161,10 +161,24 @@ func ActivateAdditionalParametersACL(ctx sdk.Ctx, am AppModule) {
 		params.ACL.SetOwner(types.NewACLKey(types.NodesSubspace, "ServicerStakeFloorMultiplierExponent"), am.keeper.GetDAOOwner(ctx))
 		am.keeper.SetParams(ctx, params)
 	}
+	//activate the proposal params
+	if am.keeper.GetCodec().IsOnNamedFeatureActivationHeight(ctx.BlockHeight(), codec.GovProposalKey) {
+		params := am.keeper.GetParams(ctx)
+		params.VotingPeriod = types.DefaultVotingPeriod
+		params.Quorum = types.DefaultQuorum
+		params.Threshold = types.DefaultThreshold
+		params.ACL.SetOwner(types.NewACLKey(types.ModuleName, string(types.VotingPeriodKey)), am.keeper.GetDAOOwner(ctx))
+		params.ACL.SetOwner(types.NewACLKey(types.ModuleName, string(types.QuorumKey)), am.keeper.GetDAOOwner(ctx))
+		params.ACL.SetOwner(types.NewACLKey(types.ModuleName, string(types.ThresholdKey)), am.keeper.GetDAOOwner(ctx))
+		am.keeper.SetParams(ctx, params)
+	}
 }
 
 // EndBlock returns the end blocker for the staking module. It returns no validator
 // updates.
 func (am AppModule) EndBlock(ctx sdk.Ctx, req abci.RequestEndBlock) []abci.ValidatorUpdate {
+	if am.keeper.GetCodec().IsAfterNamedFeatureActivationHeight(ctx.BlockHeight(), codec.GovProposalKey) {
+		am.keeper.EndVotingPeriods(ctx)
+	}
 	return []abci.ValidatorUpdate{}
 }
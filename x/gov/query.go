@@ -59,3 +59,63 @@ func QueryUpgrade(cdc *codec.Codec, tmNode rpcclient.Client, height int64) (upgr
 	}
 	return u, err
 }
+
+func QueryProposals(cdc *codec.Codec, tmNode rpcclient.Client, status string, height int64) (proposals []types.Proposal, err error) {
+	cliCtx := util.NewCLIContext(tmNode, nil, "").WithCodec(cdc).WithHeight(height)
+	params, err := cdc.MarshalJSON(types.QueryProposalsParams{Status: status})
+	if err != nil {
+		return nil, err
+	}
+	proposalsBz, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.StoreKey, types.QueryProposals), params)
+	if err != nil {
+		return nil, err
+	}
+	if err := cdc.UnmarshalJSON(proposalsBz, &proposals); err != nil {
+		return nil, err
+	}
+	return proposals, nil
+}
+
+func QueryProposal(cdc *codec.Codec, tmNode rpcclient.Client, id int64, height int64) (proposal types.Proposal, err error) {
+	cliCtx := util.NewCLIContext(tmNode, nil, "").WithCodec(cdc).WithHeight(height)
+	params, err := cdc.MarshalJSON(types.QueryProposalParams{ID: id})
+	if err != nil {
+		return proposal, err
+	}
+	proposalBz, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.StoreKey, types.QueryProposal), params)
+	if err != nil {
+		return proposal, err
+	}
+	if err := cdc.UnmarshalJSON(proposalBz, &proposal); err != nil {
+		return proposal, err
+	}
+	return proposal, nil
+}
+
+func QueryVotes(cdc *codec.Codec, tmNode rpcclient.Client, proposalID int64, height int64) (votes []types.Vote, err error) {
+	cliCtx := util.NewCLIContext(tmNode, nil, "").WithCodec(cdc).WithHeight(height)
+	params, err := cdc.MarshalJSON(types.QueryVotesParams{ProposalID: proposalID})
+	if err != nil {
+		return nil, err
+	}
+	votesBz, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.StoreKey, types.QueryVotes), params)
+	if err != nil {
+		return nil, err
+	}
+	if err := cdc.UnmarshalJSON(votesBz, &votes); err != nil {
+		return nil, err
+	}
+	return votes, nil
+}
+
+func QueryScheduledParamChanges(cdc *codec.Codec, tmNode rpcclient.Client, height int64) (changes []types.ScheduledParamChange, err error) {
+	cliCtx := util.NewCLIContext(tmNode, nil, "").WithCodec(cdc).WithHeight(height)
+	changesBz, _, err := cliCtx.Query(fmt.Sprintf("custom/%s/%s", types.StoreKey, types.QuerySchedule))
+	if err != nil {
+		return nil, err
+	}
+	if err := cdc.UnmarshalJSON(changesBz, &changes); err != nil {
+		return nil, err
+	}
+	return changes, nil
+}
@@ -59,6 +59,39 @@ func UpgradeTx(cdc *codec.Codec, tmNode client.Client, keybase keys.Keybase, fro
 	return util.CompleteAndBroadcastTxCLI(txBuilder, cliCtx, &msg, legacyCodec)
 }
 
+func ScheduleParamChangeTx(cdc *codec.Codec, tmNode client.Client, keybase keys.Keybase, fromAddress sdk.Address, aclKey string, paramValue interface{}, height int64, passphrase string, fee int64, legacyCodec bool) (*sdk.TxResponse, error) {
+	valueBytes, err := cdc.MarshalJSON(paramValue)
+	if err != nil {
+		return nil, err
+	}
+	msg := types.MsgScheduleParamChange{
+		FromAddress: fromAddress,
+		ParamKey:    aclKey,
+		ParamVal:    valueBytes,
+		Height:      height,
+	}
+	txBuilder, cliCtx := newTx(cdc, &msg, fromAddress, tmNode, keybase, passphrase, fee)
+	err = msg.ValidateBasic()
+	if err != nil {
+		return nil, err
+	}
+	return util.CompleteAndBroadcastTxCLI(txBuilder, cliCtx, &msg, legacyCodec)
+}
+
+func CancelParamChangeTx(cdc *codec.Codec, tmNode client.Client, keybase keys.Keybase, fromAddress sdk.Address, aclKey string, height int64, passphrase string, fee int64, legacyCodec bool) (*sdk.TxResponse, error) {
+	msg := types.MsgCancelParamChange{
+		FromAddress: fromAddress,
+		ParamKey:    aclKey,
+		Height:      height,
+	}
+	txBuilder, cliCtx := newTx(cdc, &msg, fromAddress, tmNode, keybase, passphrase, fee)
+	err := msg.ValidateBasic()
+	if err != nil {
+		return nil, err
+	}
+	return util.CompleteAndBroadcastTxCLI(txBuilder, cliCtx, &msg, legacyCodec)
+}
+
 func newTx(cdc *codec.Codec, msg sdk.ProtoMsg, fromAddr sdk.Address, tmNode client.Client, keybase keys.Keybase, passphrase string, fee int64) (txBuilder auth.TxBuilder, cliCtx util.CLIContext) {
 	genDoc, err := tmNode.Genesis()
 	if err != nil {
@@ -18,8 +18,58 @@ const (
 	CodeZeroHeightUpgrade             sdk.CodeType = 9
 	CodeEmptyVersionUpgrade           sdk.CodeType = 10
 	CodeUnauthorizedHeightParamChange sdk.CodeType = 11
+	CodeProposalsNotActive            sdk.CodeType = 12
+	CodeInvalidProposalContent        sdk.CodeType = 13
+	CodeProposalNotFound              sdk.CodeType = 14
+	CodeInactiveProposal              sdk.CodeType = 15
+	CodeInvalidVoteOption             sdk.CodeType = 16
+	CodeNonValidatorVoter             sdk.CodeType = 17
+	CodeScheduledChangesNotActive     sdk.CodeType = 18
+	CodeInvalidScheduleHeight         sdk.CodeType = 19
+	CodeScheduledChangeNotFound       sdk.CodeType = 20
+	CodeTooManyProposals              sdk.CodeType = 21
 )
 
+func ErrProposalsNotActive(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeProposalsNotActive, "proposals are not active yet")
+}
+
+func ErrInvalidProposalContent(codespace sdk.CodespaceType, reason string) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidProposalContent, "invalid proposal content: "+reason)
+}
+
+func ErrProposalNotFound(codespace sdk.CodespaceType, id int64) sdk.Error {
+	return sdk.NewError(codespace, CodeProposalNotFound, fmt.Sprintf("the proposal %d cannot be found", id))
+}
+
+func ErrInactiveProposal(codespace sdk.CodespaceType, id int64) sdk.Error {
+	return sdk.NewError(codespace, CodeInactiveProposal, fmt.Sprintf("the proposal %d is not in its voting period", id))
+}
+
+func ErrTooManyProposals(codespace sdk.CodespaceType, max int) sdk.Error {
+	return sdk.NewError(codespace, CodeTooManyProposals, fmt.Sprintf("the limit of %d proposals in their voting period is reached", max))
+}
+
+func ErrInvalidVoteOption(codespace sdk.CodespaceType, option string) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidVoteOption, "invalid vote option: "+option)
+}
+
+func ErrNonValidatorVoter(codespace sdk.CodespaceType, voter sdk.Address) sdk.Error {
+	return sdk.NewError(codespace, CodeNonValidatorVoter, fmt.Sprintf("the voter %s is not a staked validator", voter))
+}
+
+func ErrScheduledChangesNotActive(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeScheduledChangesNotActive, "scheduled param changes are not active yet")
+}
+
+func ErrInvalidScheduleHeight(codespace sdk.CodespaceType, height, currentHeight int64) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidScheduleHeight, fmt.Sprintf("the height %d of the param change must be after the current height %d", height, currentHeight))
+}
+
+func ErrScheduledChangeNotFound(codespace sdk.CodespaceType, paramKey string, height int64) sdk.Error {
+	return sdk.NewError(codespace, CodeScheduledChangeNotFound, fmt.Sprintf("no change of %s is scheduled at height %d", paramKey, height))
+}
+
 func ErrZeroHeightUpgrade(codespace sdk.CodespaceType) sdk.Error {
 	return sdk.NewError(codespace, CodeZeroHeightUpgrade, "the upgrade Height must not be zero")
 }
@@ -7,5 +7,16 @@ const (
 	EventParamChange       = "param_change"
 	EventUpgrade           = "upgrade"
 	EventMustUpgrade       = "must_upgrade"
+	EventSubmitProposal    = "submit_proposal"
+	EventVote              = "proposal_vote"
+	EventProposalResult    = "proposal_result"
+	EventScheduleParam     = "schedule_param_change"
+	EventCancelParam       = "cancel_param_change"
+	EventScheduledParam    = "scheduled_param_change"
+	AttributeProposalID    = "proposal_id"
+	AttributeVoteOption    = "option"
+	AttributeStatus        = "status"
+	AttributeParamKey      = "param_key"
+	AttributeHeight        = "height"
 	AttributeValueCategory = ModuleName
 )
@@ -28,15 +28,10 @@ type AuthKeeper interface {
 	BurnCoins(ctx sdk.Ctx, name string, amt sdk.Coins) sdk.Error
 }
 
+// PosKeeper defines the expected staking keeper, the staked validators vote on the proposals with their stake
 type PosKeeper interface {
-	RewardForRelays(ctx sdk.Ctx, relays sdk.BigInt, address sdk.Address)
-	GetStakedTokens(ctx sdk.Ctx) sdk.BigInt
+	// get a validator by its address
 	Validator(ctx sdk.Ctx, addr sdk.Address) nodesExported.ValidatorI
-	TotalTokens(ctx sdk.Ctx) sdk.BigInt
-	BurnForChallenge(ctx sdk.Ctx, challenges sdk.BigInt, address sdk.Address)
-	JailValidator(ctx sdk.Ctx, addr sdk.Address)
-	AllValidators(ctx sdk.Ctx) (validators []nodesExported.ValidatorI)
+	// get the staked validators
 	GetStakedValidators(ctx sdk.Ctx) (validators []nodesExported.ValidatorI)
-	SessionBlockFrequency(ctx sdk.Ctx) (res int64)
-	StakeDenom(ctx sdk.Ctx) (res string)
 }
@@ -1,15 +1,23 @@
 package types
 
 const (
-	DAOTransferFee    = 10000
-	MsgChangeParamFee = 10000
-	MsgUpgradeFee     = 10000
+	DAOTransferFee       = 10000
+	MsgChangeParamFee    = 10000
+	MsgUpgradeFee        = 10000
+	MsgSubmitProposalFee = 10000
+	MsgVoteFee           = 10000
+	MsgScheduleParamFee  = 10000
+	MsgCancelParamFee    = 10000
 )
 
 var (
 	GovFeeMap = map[string]int64{
-		MsgDAOTransferName: DAOTransferFee,
-		MsgChangeParamName: MsgChangeParamFee,
-		MsgUpgradeName:     MsgUpgradeFee,
+		MsgDAOTransferName:    DAOTransferFee,
+		MsgChangeParamName:    MsgChangeParamFee,
+		MsgUpgradeName:        MsgUpgradeFee,
+		MsgSubmitProposalName: MsgSubmitProposalFee,
+		MsgVoteName:           MsgVoteFee,
+		MsgScheduleParamName:  MsgScheduleParamFee,
+		MsgCancelParamName:    MsgCancelParamFee,
 	}
 )
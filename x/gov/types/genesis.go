@@ -7,8 +7,12 @@ import (
 
 // GenesisState - all auth state that must be provided at genesis
 type GenesisState struct {
-	Params    Params     `json:"params" yaml:"params"`
-	DAOTokens sdk.BigInt `json:"DAO_Tokens"`
+	Params           Params                 `json:"params" yaml:"params"`
+	DAOTokens        sdk.BigInt             `json:"DAO_Tokens"`
+	Proposals        []Proposal             `json:"proposals,omitempty" yaml:"proposals"`
+	Votes            []Vote                 `json:"votes,omitempty" yaml:"votes"`
+	NextProposalID   int64                  `json:"next_proposal_id,omitempty" yaml:"next_proposal_id"`
+	ScheduledChanges []ScheduledParamChange `json:"scheduled_changes,omitempty" yaml:"scheduled_changes"`
 }
 
 // NewGenesisState - Create a new genesis state
@@ -30,5 +34,23 @@ func ValidateGenesis(data GenesisState) error {
 	if data.Params.ACL == nil {
 		return ErrInvalidACL(ModuleName, fmt.Errorf("nil acl"))
 	}
+	ids := make(map[int64]struct{}, len(data.Proposals))
+	for _, p := range data.Proposals {
+		if p.ID <= 0 || p.ID >= data.NextProposalID {
+			return fmt.Errorf("the proposal id %d is not below the next proposal id %d", p.ID, data.NextProposalID)
+		}
+		if _, ok := ids[p.ID]; ok {
+			return fmt.Errorf("duplicate proposal id %d", p.ID)
+		}
+		ids[p.ID] = struct{}{}
+	}
+	for _, v := range data.Votes {
+		if _, ok := ids[v.ProposalID]; !ok {
+			return fmt.Errorf("the vote of %s is for the unknown proposal %d", v.Voter, v.ProposalID)
+		}
+		if err := ValidateVoteOption(v.Option); err != nil {
+			return err
+		}
+	}
 	return nil
 }
@@ -0,0 +1,57 @@
+package types
+
+import (
+	"encoding/binary"
+
+	sdk "github.com/pokt-network/pocket-core/types"
+)
+
+var (
+	ProposalKeyPrefix        = []byte{0x01} // prefix for each key to a proposal
+	ActiveProposalKeyPrefix  = []byte{0x02} // prefix for the proposals in their voting period, sorted by voting end height
+	VoteKeyPrefix            = []byte{0x03} // prefix for the votes of a proposal
+	ProposalIDKey            = []byte{0x04} // key for the id of the next proposal
+	ScheduledChangeKeyPrefix = []byte{0x05} // prefix for the scheduled param changes, sorted by height
+)
+
+// ProposalKey turn a proposal id to the key used to get the proposal from the store
+func ProposalKey(id int64) []byte {
+	return append(append([]byte{}, ProposalKeyPrefix...), idBytes(id)...)
+}
+
+// ActiveProposalsByHeightKey turn a voting end height to the prefix of the keys of the proposals ending at or before it
+func ActiveProposalsByHeightKey(votingEndHeight int64) []byte {
+	return append(append([]byte{}, ActiveProposalKeyPrefix...), idBytes(votingEndHeight)...)
+}
+
+// ActiveProposalKey turn a voting end height and a proposal id to the key of the proposal in the voting queue
+func ActiveProposalKey(votingEndHeight, id int64) []byte {
+	return append(ActiveProposalsByHeightKey(votingEndHeight), idBytes(id)...)
+}
+
+// VotesKey turn a proposal id to the prefix of the keys of all its votes
+func VotesKey(id int64) []byte {
+	return append(append([]byte{}, VoteKeyPrefix...), idBytes(id)...)
+}
+
+// VoteKey turn a proposal id and a voter to the key used to get the vote from the store
+func VoteKey(id int64, voter sdk.Address) []byte {
+	return append(VotesKey(id), voter.Bytes()...)
+}
+
+// ScheduledChangesByHeightKey turn a height to the prefix of the keys of the param changes scheduled at it
+func ScheduledChangesByHeightKey(height int64) []byte {
+	return append(append([]byte{}, ScheduledChangeKeyPrefix...), idBytes(height)...)
+}
+
+// ScheduledChangeKey turn a height and an acl key to the key used to get the scheduled param change from the store
+func ScheduledChangeKey(height int64, paramKey string) []byte {
+	return append(ScheduledChangesByHeightKey(height), []byte(paramKey)...)
+}
+
+// big endian so the keys iterate in order
+func idBytes(i int64) []byte {
+	b := make([]byte, 8)
+	binary.BigEndian.PutUint64(b, uint64(i))
+	return b
+}
@@ -12,13 +12,21 @@ import (
 const DefaultParamspace = ModuleName
 
 // Default parameter values
-const ()
+const (
+	DefaultVotingPeriod = int64(672) // a week of 15 minute blocks
+	DefaultQuorum       = int64(33)
+	DefaultThreshold    = int64(50)
+)
 
 // Parameter keys
 var (
 	ACLKey      = []byte("acl")
 	DAOOwnerKey = []byte("daoOwner")
 	UpgradeKey  = []byte("upgrade")
+	// the proposal params, they are only set once the proposals are activated
+	VotingPeriodKey = []byte("votingPeriod")
+	QuorumKey       = []byte("quorum")
+	ThresholdKey    = []byte("threshold")
 )
 
 var _ sdk.ParamSet = (*Params)(nil)
@@ -28,6 +36,12 @@ type Params struct {
 	ACL      ACL         `json:"acl"`
 	DAOOwner sdk.Address `json:"dao_owner"`
 	Upgrade  Upgrade     `json:"upgrade"`
+	// the number of blocks a proposal is voted for
+	VotingPeriod int64 `json:"voting_period"`
+	// the % of the staked tokens that must vote for a proposal to be valid
+	Quorum int64 `json:"quorum"`
+	// the % of the yes and no votes that must be yes for a proposal to pass
+	Threshold int64 `json:"threshold"`
 }
 
 // NewParams creates a new Params object
@@ -51,6 +65,9 @@ func (p *Params) ParamSetPairs() sdk.ParamSetPairs {
 		{Key: ACLKey, Value: &p.ACL},
 		{Key: DAOOwnerKey, Value: &p.DAOOwner},
 		{Key: UpgradeKey, Value: &p.Upgrade},
+		{Key: VotingPeriodKey, Value: &p.VotingPeriod},
+		{Key: QuorumKey, Value: &p.Quorum},
+		{Key: ThresholdKey, Value: &p.Threshold},
 	}
 }
 
@@ -64,10 +81,33 @@ func DefaultParams() Params {
 	acl := ACL(make([]ACLPair, 0))
 	u := NewUpgrade(0, "")
 	return Params{
-		ACL:      acl,
-		DAOOwner: sdk.Address{},
-		Upgrade:  u,
+		ACL:          acl,
+		DAOOwner:     sdk.Address{},
+		Upgrade:      u,
+		VotingPeriod: DefaultVotingPeriod,
+		Quorum:       DefaultQuorum,
+		Threshold:    DefaultThreshold,
+	}
+}
+
+// ValidateProposalParam returns an error if the value of a proposal param is out of its range: the voting period must be
+// positive, the quorum at most 100 and the threshold below 100 for a proposal to be able to pass
+func ValidateProposalParam(key string, value int64) error {
+	switch key {
+	case string(VotingPeriodKey):
+		if value <= 0 {
+			return fmt.Errorf("the voting period %d must be positive", value)
+		}
+	case string(QuorumKey):
+		if value < 0 || value > 100 {
+			return fmt.Errorf("the quorum %d must be between 0 and 100", value)
+		}
+	case string(ThresholdKey):
+		if value < 0 || value >= 100 {
+			return fmt.Errorf("the threshold %d must be between 0 and 99", value)
+		}
 	}
+	return nil
 }
 
 // String implements the stringer interface.
@@ -77,5 +117,8 @@ func (p Params) String() string {
 	sb.WriteString(fmt.Sprintf("ACLKey: %v\n", p.ACL))
 	sb.WriteString(fmt.Sprintf("DAOOwnerKey: %s\n", p.DAOOwner))
 	sb.WriteString(fmt.Sprintf("UpgradeKey: %v\n", p.Upgrade))
+	sb.WriteString(fmt.Sprintf("VotingPeriodKey: %d\n", p.VotingPeriod))
+	sb.WriteString(fmt.Sprintf("QuorumKey: %d\n", p.Quorum))
+	sb.WriteString(fmt.Sprintf("ThresholdKey: %d\n", p.Threshold))
 	return sb.String()
 }
@@ -0,0 +1,438 @@
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+
+	"github.com/pokt-network/pocket-core/codec"
+	"github.com/pokt-network/pocket-core/codec/types"
+	sdk "github.com/pokt-network/pocket-core/types"
+)
+
+// ensure ProtoMsg interface compliance at compile time
+var (
+	_ sdk.ProtoMsg = &MsgSubmitProposal{}
+	_ sdk.ProtoMsg = &MsgVote{}
+)
+
+const (
+	MsgSubmitProposalName = "submit_proposal"
+	MsgVoteName           = "vote"
+)
+
+// MaxVotingProposals is the number of proposals that can be in their voting period at once, proposals have no deposit
+const MaxVotingProposals = 10
+
+// proposal status
+const (
+	ProposalStatusVoting   = "voting"   // the proposal is in its voting period
+	ProposalStatusPassed   = "passed"   // the proposal passed and was executed
+	ProposalStatusRejected = "rejected" // the proposal didn't reach the quorum or the threshold
+	ProposalStatusFailed   = "failed"   // the proposal passed but its execution failed
+)
+
+// vote options
+const (
+	VoteOptionYes     = "yes"
+	VoteOptionNo      = "no"
+	VoteOptionAbstain = "abstain"
+)
+
+// ValidateVoteOption returns an error if the option is not yes, no or abstain
+func ValidateVoteOption(option string) sdk.Error {
+	switch option {
+	case VoteOptionYes, VoteOptionNo, VoteOptionAbstain:
+		return nil
+	}
+	return ErrInvalidVoteOption(ModuleName, option)
+}
+
+// ProposalContent returns the content of a proposal as a value, ok is false if the message is not a param change, a
+// dao transfer or an upgrade
+func ProposalContent(msg sdk.Msg) (content sdk.Msg, ok bool) {
+	if msg == nil {
+		return nil, false
+	}
+	if reflect.ValueOf(msg).Kind() == reflect.Ptr {
+		if reflect.ValueOf(msg).IsNil() {
+			return nil, false
+		}
+		msg = reflect.Indirect(reflect.ValueOf(msg)).Interface().(sdk.Msg)
+	}
+	switch msg.(type) {
+	case MsgChangeParam, MsgDAOTransfer, MsgUpgrade:
+		return msg, true
+	}
+	return nil, false
+}
+
+//----------------------------------------------------------------------------------------------------------------------
+
+// MsgSubmitProposal structure for submitting a param change, dao transfer or upgrade to the vote of the staked
+// validators. Once passed the content is executed as if it was sent by the owner of the param
+type MsgSubmitProposal struct {
+	Proposer sdk.Address `json:"proposer"`
+	Content  sdk.Msg     `json:"content"`
+}
+
+var _ codec.ProtoMarshaler = &MsgSubmitProposal{}
+
+func (msg *MsgSubmitProposal) Marshal() ([]byte, error) {
+	p, err := msg.ToProto()
+	if err != nil {
+		return nil, err
+	}
+	return p.Marshal()
+}
+
+func (msg *MsgSubmitProposal) MarshalTo(data []byte) (n int, err error) {
+	p, err := msg.ToProto()
+	if err != nil {
+		return 0, err
+	}
+	return p.MarshalTo(data)
+}
+
+func (msg *MsgSubmitProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	p, err := msg.ToProto()
+	if err != nil {
+		return 0, err
+	}
+	return p.MarshalToSizedBuffer(dAtA)
+}
+
+func (msg *MsgSubmitProposal) Size() int {
+	p, _ := msg.ToProto()
+	return p.Size()
+}
+
+func (msg *MsgSubmitProposal) Unmarshal(data []byte) error {
+	var p ProtoMsgSubmitProposal
+	err := p.Unmarshal(data)
+	if err != nil {
+		return err
+	}
+	m, err := p.FromProto()
+	if err != nil {
+		return err
+	}
+	*msg = m
+	return nil
+}
+
+func (msg *MsgSubmitProposal) Reset() {
+	*msg = MsgSubmitProposal{}
+}
+
+func (msg *MsgSubmitProposal) ProtoMessage() {
+	p, _ := msg.ToProto()
+	p.ProtoMessage()
+}
+
+func (msg *MsgSubmitProposal) XXX_MessageName() string {
+	p, _ := msg.ToProto()
+	return p.XXX_MessageName()
+}
+
+func (msg MsgSubmitProposal) String() string {
+	p, _ := msg.ToProto()
+	return p.String()
+}
+
+// ToProto packs the content into an any
+func (msg MsgSubmitProposal) ToProto() (ProtoMsgSubmitProposal, error) {
+	any, err := packContent(msg.Content)
+	if err != nil {
+		return ProtoMsgSubmitProposal{}, err
+	}
+	return ProtoMsgSubmitProposal{Proposer: msg.Proposer, Content: any}, nil
+}
+
+// FromProto unpacks the content from its any
+func (pmsg ProtoMsgSubmitProposal) FromProto() (MsgSubmitProposal, error) {
+	content, err := unpackContent(pmsg.Content)
+	if err != nil {
+		return MsgSubmitProposal{}, err
+	}
+	return MsgSubmitProposal{Proposer: pmsg.Proposer, Content: content}, nil
+}
+
+// Route provides router key for msg
+func (msg MsgSubmitProposal) Route() string { return RouterKey }
+
+// Type provides msg name
+func (msg MsgSubmitProposal) Type() string { return MsgSubmitProposalName }
+
+// GetFee get fee for msg
+func (msg MsgSubmitProposal) GetFee() sdk.BigInt {
+	return sdk.NewInt(GovFeeMap[msg.Type()])
+}
+
+// GetSigners return address(es) that must sign over msg.GetSignBytes()
+func (msg MsgSubmitProposal) GetSigners() []sdk.Address {
+	return []sdk.Address{msg.Proposer}
+}
+
+// GetSigners return address(es) that must sign over msg.GetSignBytes()
+func (msg MsgSubmitProposal) GetRecipient() sdk.Address {
+	return nil
+}
+
+// GetSignBytes returns the message bytes to sign over, the content is signed over its own sign bytes
+func (msg MsgSubmitProposal) GetSignBytes() []byte {
+	var content json.RawMessage
+	if msg.Content != nil {
+		content = msg.Content.GetSignBytes()
+	}
+	bz, err := json.Marshal(struct {
+		Proposer sdk.Address     `json:"proposer"`
+		Content  json.RawMessage `json:"content"`
+	}{msg.Proposer, content})
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic quick validity check, the content must be valid on its own and sent by the proposer
+func (msg MsgSubmitProposal) ValidateBasic() sdk.Error {
+	if msg.Proposer == nil {
+		return sdk.ErrInvalidAddress("nil proposer address")
+	}
+	content, ok := ProposalContent(msg.Content)
+	if !ok {
+		return ErrInvalidProposalContent(ModuleName, fmt.Sprintf("%T is not a param change, a dao transfer or an upgrade", msg.Content))
+	}
+	if err := content.ValidateBasic(); err != nil {
+		return err
+	}
+	if signers := content.GetSigners(); len(signers) != 1 || !signers[0].Equals(msg.Proposer) {
+		return ErrInvalidProposalContent(ModuleName, "the content must be sent by the proposer")
+	}
+	return nil
+}
+
+//----------------------------------------------------------------------------------------------------------------------
+
+// MsgVote structure for voting on a proposal
+// type MsgVote struct {
+// 	Voter      sdk.Address `json:"voter"`
+// 	ProposalID int64       `json:"proposal_id"`
+// 	Option     string      `json:"option"`
+// }
+
+// Route provides router key for msg
+func (msg MsgVote) Route() string { return RouterKey }
+
+// Type provides msg name
+func (msg MsgVote) Type() string { return MsgVoteName }
+
+// GetFee get fee for msg
+func (msg MsgVote) GetFee() sdk.BigInt {
+	return sdk.NewInt(GovFeeMap[msg.Type()])
+}
+
+// GetSigners return address(es) that must sign over msg.GetSignBytes()
+func (msg MsgVote) GetSigners() []sdk.Address {
+	return []sdk.Address{msg.Voter}
+}
+
+// GetSigners return address(es) that must sign over msg.GetSignBytes()
+func (msg MsgVote) GetRecipient() sdk.Address {
+	return nil
+}
+
+// GetSignBytes returns the message bytes to sign over.
+func (msg MsgVote) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic quick validity check
+func (msg MsgVote) ValidateBasic() sdk.Error {
+	if msg.Voter == nil {
+		return sdk.ErrInvalidAddress("nil voter address")
+	}
+	if msg.ProposalID <= 0 {
+		return ErrProposalNotFound(ModuleName, msg.ProposalID)
+	}
+	return ValidateVoteOption(msg.Option)
+}
+
+//----------------------------------------------------------------------------------------------------------------------
+
+// Proposal is a submitted param change, dao transfer or upgrade along with its voting period and outcome
+type Proposal struct {
+	ID              int64       `json:"id"`
+	Proposer        sdk.Address `json:"proposer"`
+	Content         sdk.Msg     `json:"content"`
+	SubmitHeight    int64       `json:"submit_height"`
+	VotingEndHeight int64       `json:"voting_end_height"`
+	Status          string      `json:"status"`
+	FinalTally      TallyResult `json:"final_tally"`
+}
+
+var _ codec.ProtoMarshaler = &Proposal{}
+
+// NewProposal returns a proposal in its voting period
+func NewProposal(id int64, proposer sdk.Address, content sdk.Msg, submitHeight, votingEndHeight int64) Proposal {
+	return Proposal{
+		ID:              id,
+		Proposer:        proposer,
+		Content:         content,
+		SubmitHeight:    submitHeight,
+		VotingEndHeight: votingEndHeight,
+		Status:          ProposalStatusVoting,
+		FinalTally:      EmptyTallyResult(),
+	}
+}
+
+func (p *Proposal) Marshal() ([]byte, error) {
+	pp, err := p.ToProto()
+	if err != nil {
+		return nil, err
+	}
+	return pp.Marshal()
+}
+
+func (p *Proposal) MarshalTo(data []byte) (n int, err error) {
+	pp, err := p.ToProto()
+	if err != nil {
+		return 0, err
+	}
+	return pp.MarshalTo(data)
+}
+
+func (p *Proposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	pp, err := p.ToProto()
+	if err != nil {
+		return 0, err
+	}
+	return pp.MarshalToSizedBuffer(dAtA)
+}
+
+func (p *Proposal) Size() int {
+	pp, _ := p.ToProto()
+	return pp.Size()
+}
+
+func (p *Proposal) Unmarshal(data []byte) error {
+	var pp ProtoProposal
+	err := pp.Unmarshal(data)
+	if err != nil {
+		return err
+	}
+	res, err := pp.FromProto()
+	if err != nil {
+		return err
+	}
+	*p = res
+	return nil
+}
+
+func (p *Proposal) Reset() {
+	*p = Proposal{}
+}
+
+func (p *Proposal) ProtoMessage() {
+	pp, _ := p.ToProto()
+	pp.ProtoMessage()
+}
+
+func (p Proposal) String() string {
+	pp, _ := p.ToProto()
+	return pp.String()
+}
+
+// ToProto packs the content into an any
+func (p Proposal) ToProto() (ProtoProposal, error) {
+	any, err := packContent(p.Content)
+	if err != nil {
+		return ProtoProposal{}, err
+	}
+	return ProtoProposal{
+		ID:              p.ID,
+		Proposer:        p.Proposer,
+		Content:         any,
+		SubmitHeight:    p.SubmitHeight,
+		VotingEndHeight: p.VotingEndHeight,
+		Status:          p.Status,
+		FinalTally:      p.FinalTally,
+	}, nil
+}
+
+// FromProto unpacks the content from its any
+func (pp ProtoProposal) FromProto() (Proposal, error) {
+	content, err := unpackContent(pp.Content)
+	if err != nil {
+		return Proposal{}, err
+	}
+	return Proposal{
+		ID:              pp.ID,
+		Proposer:        pp.Proposer,
+		Content:         content,
+		SubmitHeight:    pp.SubmitHeight,
+		VotingEndHeight: pp.VotingEndHeight,
+		Status:          pp.Status,
+		FinalTally:      pp.FinalTally,
+	}, nil
+}
+
+// EmptyTallyResult returns a tally without any vote
+func EmptyTallyResult() TallyResult {
+	return TallyResult{Yes: sdk.ZeroInt(), No: sdk.ZeroInt(), Abstain: sdk.ZeroInt()}
+}
+
+// Add adds the stake of a vote to the tally
+func (t TallyResult) Add(option string, stake sdk.BigInt) TallyResult {
+	switch option {
+	case VoteOptionYes:
+		t.Yes = t.Yes.Add(stake)
+	case VoteOptionNo:
+		t.No = t.No.Add(stake)
+	case VoteOptionAbstain:
+		t.Abstain = t.Abstain.Add(stake)
+	}
+	return t
+}
+
+// Passes returns whether the tally reaches the quorum (a % of the total stake) and the threshold (a % of the yes and no
+// votes that are yes)
+func (t TallyResult) Passes(totalStake sdk.BigInt, quorum, threshold int64) bool {
+	voted := t.Yes.Add(t.No).Add(t.Abstain)
+	if !totalStake.IsPositive() || voted.MulRaw(100).LT(totalStake.MulRaw(quorum)) {
+		return false
+	}
+	yesAndNo := t.Yes.Add(t.No)
+	if !yesAndNo.IsPositive() {
+		return false
+	}
+	return t.Yes.MulRaw(100).GT(yesAndNo.MulRaw(threshold))
+}
+
+func packContent(content sdk.Msg) (types.Any, error) {
+	// the amino codec decodes the content as a value
+	if c, ok := ProposalContent(content); ok {
+		ptr := reflect.New(reflect.TypeOf(c))
+		ptr.Elem().Set(reflect.ValueOf(c))
+		content = ptr.Interface().(sdk.Msg)
+	}
+	pMsg, ok := content.(sdk.ProtoMsg)
+	if !ok {
+		return types.Any{}, fmt.Errorf("unable to convert sdk.Msg to sdk.ProtoMsg: %v", content)
+	}
+	any, err := types.NewAnyWithValue(pMsg)
+	if err != nil {
+		return types.Any{}, fmt.Errorf("unable to convert sdk.ProtoMsg into any %v", pMsg)
+	}
+	return *any, nil
+}
+
+func unpackContent(any types.Any) (sdk.Msg, error) {
+	var content sdk.ProtoMsg
+	if err := ModuleCdc.ProtoCodec().UnpackAny(&any, &content); err != nil {
+		return nil, err
+	}
+	return content, nil
+}
@@ -0,0 +1,99 @@
+package types
+
+import (
+	"testing"
+
+	sdk "github.com/pokt-network/pocket-core/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMsgSubmitProposal_ValidateBasic(t *testing.T) {
+	cdc := makeTestCodec()
+	bytes, _ := cdc.MarshalJSON(int64(10))
+	proposer := getRandomValidatorAddress()
+	content := MsgChangeParam{
+		FromAddress: proposer,
+		ParamKey:    "auth/TxSigLimit",
+		ParamVal:    bytes,
+	}
+	m := MsgSubmitProposal{Proposer: proposer, Content: &content}
+	assert.Nil(t, m.ValidateBasic())
+	m = MsgSubmitProposal{Proposer: proposer, Content: content}
+	assert.Nil(t, m.ValidateBasic())
+	m = MsgSubmitProposal{Content: &content}
+	assert.NotNil(t, m.ValidateBasic())
+	m = MsgSubmitProposal{Proposer: getRandomValidatorAddress(), Content: &content}
+	assert.NotNil(t, m.ValidateBasic())
+	m = MsgSubmitProposal{Proposer: proposer, Content: &MsgVote{Voter: proposer, ProposalID: 1, Option: VoteOptionYes}}
+	assert.NotNil(t, m.ValidateBasic())
+	m = MsgSubmitProposal{Proposer: proposer}
+	assert.NotNil(t, m.ValidateBasic())
+}
+
+func TestMsgVote_ValidateBasic(t *testing.T) {
+	m := MsgVote{Voter: getRandomValidatorAddress(), ProposalID: 1, Option: VoteOptionYes}
+	assert.Nil(t, m.ValidateBasic())
+	m = MsgVote{ProposalID: 1, Option: VoteOptionYes}
+	assert.NotNil(t, m.ValidateBasic())
+	m = MsgVote{Voter: getRandomValidatorAddress(), Option: VoteOptionYes}
+	assert.NotNil(t, m.ValidateBasic())
+	m = MsgVote{Voter: getRandomValidatorAddress(), ProposalID: 1, Option: "maybe"}
+	assert.NotNil(t, m.ValidateBasic())
+}
+
+func TestMsgSubmitProposal_Proto(t *testing.T) {
+	proposer := getRandomValidatorAddress()
+	content := MsgDAOTransfer{
+		FromAddress: proposer,
+		ToAddress:   getRandomValidatorAddress(),
+		Amount:      sdk.OneInt(),
+		Action:      DAOTransferString,
+	}
+	m := MsgSubmitProposal{Proposer: proposer, Content: &content}
+	bz, err := m.Marshal()
+	assert.Nil(t, err)
+	var res MsgSubmitProposal
+	assert.Nil(t, res.Unmarshal(bz))
+	assert.Equal(t, proposer, res.Proposer)
+	resContent, ok := ProposalContent(res.Content)
+	assert.True(t, ok)
+	assert.Equal(t, content, resContent)
+}
+
+func TestProposal_Proto(t *testing.T) {
+	proposer := getRandomValidatorAddress()
+	content := MsgUpgrade{Address: proposer, Upgrade: NewUpgrade(100, "0.0.1")}
+	p := NewProposal(1, proposer, content, 10, 20)
+	p.FinalTally = p.FinalTally.Add(VoteOptionYes, sdk.NewInt(5))
+	bz, err := p.Marshal()
+	assert.Nil(t, err)
+	var res Proposal
+	assert.Nil(t, res.Unmarshal(bz))
+	assert.Equal(t, p.ID, res.ID)
+	assert.Equal(t, p.VotingEndHeight, res.VotingEndHeight)
+	assert.Equal(t, ProposalStatusVoting, res.Status)
+	assert.True(t, res.FinalTally.Yes.Equal(sdk.NewInt(5)))
+	resContent, ok := ProposalContent(res.Content)
+	assert.True(t, ok)
+	assert.Equal(t, content, resContent)
+}
+
+func TestTallyResult_Passes(t *testing.T) {
+	tests := []struct {
+		name   string
+		tally  TallyResult
+		passes bool
+	}{
+		{"no votes", EmptyTallyResult(), false},
+		{"under the quorum", EmptyTallyResult().Add(VoteOptionYes, sdk.NewInt(32)), false},
+		{"only abstain", EmptyTallyResult().Add(VoteOptionAbstain, sdk.NewInt(50)), false},
+		{"yes at the threshold", EmptyTallyResult().Add(VoteOptionYes, sdk.NewInt(20)).Add(VoteOptionNo, sdk.NewInt(20)), false},
+		{"yes over the threshold", EmptyTallyResult().Add(VoteOptionYes, sdk.NewInt(21)).Add(VoteOptionNo, sdk.NewInt(20)), true},
+		{"quorum reached with abstain", EmptyTallyResult().Add(VoteOptionYes, sdk.NewInt(3)).Add(VoteOptionAbstain, sdk.NewInt(30)), true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.passes, tt.tally.Passes(sdk.NewInt(100), DefaultQuorum, DefaultThreshold))
+		})
+	}
+}
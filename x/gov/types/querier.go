@@ -16,6 +16,10 @@ const (
 	QueryDAO                           = "dao"
 	QueryUpgrade                       = "upgrade"
 	QueryDAOOwner                      = "daoOwner"
+	QueryProposals                     = "proposals"
+	QueryProposal                      = "proposal"
+	QueryVotes                         = "votes"
+	QuerySchedule                      = "schedule"
 )
 
 type QueryACLParams struct{}
@@ -23,3 +27,16 @@ type QueryACLParams struct{}
 type QueryDAOParams struct{}
 
 type QueryUpgradeParams struct{}
+
+// QueryProposalsParams the status of the proposals to query, empty returns every proposal
+type QueryProposalsParams struct {
+	Status string `json:"status"`
+}
+
+type QueryProposalParams struct {
+	ID int64 `json:"id"`
+}
+
+type QueryVotesParams struct {
+	ProposalID int64 `json:"proposal_id"`
+}
@@ -0,0 +1,137 @@
+package types
+
+import (
+	sdk "github.com/pokt-network/pocket-core/types"
+)
+
+// ensure ProtoMsg interface compliance at compile time
+var (
+	_ sdk.ProtoMsg = &MsgScheduleParamChange{}
+	_ sdk.ProtoMsg = &MsgCancelParamChange{}
+)
+
+const (
+	MsgScheduleParamName = "schedule_param_change"
+	MsgCancelParamName   = "cancel_param_change"
+)
+
+//----------------------------------------------------------------------------------------------------------------------
+// MsgScheduleParamChange structure for changing a governance parameter at the beginning of the block at the height
+// type MsgScheduleParamChange struct {
+// 	FromAddress sdk.Address `json:"address"`
+// 	ParamKey    string      `json:"param_key"`
+// 	ParamVal    []byte      `json:"param_value"`
+// 	Height      int64       `json:"height"`
+// }
+
+// Route provides router key for msg
+func (msg MsgScheduleParamChange) Route() string { return RouterKey }
+
+// Type provides msg name
+func (msg MsgScheduleParamChange) Type() string { return MsgScheduleParamName }
+
+// GetFee get fee for msg
+func (msg MsgScheduleParamChange) GetFee() sdk.BigInt {
+	return sdk.NewInt(GovFeeMap[msg.Type()])
+}
+
+// GetSigners return address(es) that must sign over msg.GetSignBytes()
+func (msg MsgScheduleParamChange) GetSigners() []sdk.Address {
+	return []sdk.Address{msg.FromAddress}
+}
+
+// GetRecipient return the recipient of the msg, none for a param change
+func (msg MsgScheduleParamChange) GetRecipient() sdk.Address {
+	return nil
+}
+
+// GetSignBytes returns the message bytes to sign over.
+func (msg MsgScheduleParamChange) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic quick validity check
+func (msg MsgScheduleParamChange) ValidateBasic() sdk.Error {
+	if msg.FromAddress == nil {
+		return sdk.ErrInvalidAddress("nil address")
+	}
+	if msg.ParamKey == "" {
+		return ErrEmptyKey(ModuleName)
+	}
+	if msg.ParamVal == nil {
+		return ErrEmptyValue(ModuleName)
+	}
+	if msg.Height <= 0 {
+		return ErrInvalidScheduleHeight(ModuleName, msg.Height, 0)
+	}
+	return nil
+}
+
+//----------------------------------------------------------------------------------------------------------------------
+// MsgCancelParamChange structure for cancelling a scheduled change of a governance parameter
+// type MsgCancelParamChange struct {
+// 	FromAddress sdk.Address `json:"address"`
+// 	ParamKey    string      `json:"param_key"`
+// 	Height      int64       `json:"height"`
+// }
+
+// Route provides router key for msg
+func (msg MsgCancelParamChange) Route() string { return RouterKey }
+
+// Type provides msg name
+func (msg MsgCancelParamChange) Type() string { return MsgCancelParamName }
+
+// GetFee get fee for msg
+func (msg MsgCancelParamChange) GetFee() sdk.BigInt {
+	return sdk.NewInt(GovFeeMap[msg.Type()])
+}
+
+// GetSigners return address(es) that must sign over msg.GetSignBytes()
+func (msg MsgCancelParamChange) GetSigners() []sdk.Address {
+	return []sdk.Address{msg.FromAddress}
+}
+
+// GetRecipient return the recipient of the msg, none for a param change
+func (msg MsgCancelParamChange) GetRecipient() sdk.Address {
+	return nil
+}
+
+// GetSignBytes returns the message bytes to sign over.
+func (msg MsgCancelParamChange) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic quick validity check
+func (msg MsgCancelParamChange) ValidateBasic() sdk.Error {
+	if msg.FromAddress == nil {
+		return sdk.ErrInvalidAddress("nil address")
+	}
+	if msg.ParamKey == "" {
+		return ErrEmptyKey(ModuleName)
+	}
+	if msg.Height <= 0 {
+		return ErrScheduledChangeNotFound(ModuleName, msg.ParamKey, msg.Height)
+	}
+	return nil
+}
+
+//----------------------------------------------------------------------------------------------------------------------
+// ScheduledParamChange structure for a param change waiting for its height
+// type ScheduledParamChange struct {
+// 	Height   int64       `json:"height"`
+// 	ParamKey string      `json:"param_key"`
+// 	ParamVal []byte      `json:"param_value"`
+// 	Owner    sdk.Address `json:"owner"`
+// }
+
+// NewScheduledParamChange returns the change scheduled by the owner of the param
+func NewScheduledParamChange(height int64, paramKey string, paramVal []byte, owner sdk.Address) ScheduledParamChange {
+	return ScheduledParamChange{
+		Height:   height,
+		ParamKey: paramKey,
+		ParamVal: paramVal,
+		Owner:    owner,
+	}
+}
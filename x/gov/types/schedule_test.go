@@ -0,0 +1,33 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMsgScheduleParamChange_ValidateBasic(t *testing.T) {
+	cdc := makeTestCodec()
+	bytes, _ := cdc.MarshalJSON(int64(10))
+	m := MsgScheduleParamChange{FromAddress: getRandomValidatorAddress(), ParamKey: "auth/TxSigLimit", ParamVal: bytes, Height: 10}
+	assert.Nil(t, m.ValidateBasic())
+	m = MsgScheduleParamChange{ParamKey: "auth/TxSigLimit", ParamVal: bytes, Height: 10}
+	assert.NotNil(t, m.ValidateBasic())
+	m = MsgScheduleParamChange{FromAddress: getRandomValidatorAddress(), ParamVal: bytes, Height: 10}
+	assert.NotNil(t, m.ValidateBasic())
+	m = MsgScheduleParamChange{FromAddress: getRandomValidatorAddress(), ParamKey: "auth/TxSigLimit", Height: 10}
+	assert.NotNil(t, m.ValidateBasic())
+	m = MsgScheduleParamChange{FromAddress: getRandomValidatorAddress(), ParamKey: "auth/TxSigLimit", ParamVal: bytes}
+	assert.NotNil(t, m.ValidateBasic())
+}
+
+func TestMsgCancelParamChange_ValidateBasic(t *testing.T) {
+	m := MsgCancelParamChange{FromAddress: getRandomValidatorAddress(), ParamKey: "auth/TxSigLimit", Height: 10}
+	assert.Nil(t, m.ValidateBasic())
+	m = MsgCancelParamChange{ParamKey: "auth/TxSigLimit", Height: 10}
+	assert.NotNil(t, m.ValidateBasic())
+	m = MsgCancelParamChange{FromAddress: getRandomValidatorAddress(), Height: 10}
+	assert.NotNil(t, m.ValidateBasic())
+	m = MsgCancelParamChange{FromAddress: getRandomValidatorAddress(), ParamKey: "auth/TxSigLimit"}
+	assert.NotNil(t, m.ValidateBasic())
+}
@@ -21,23 +21,7 @@ func (k Keeper) RewardForRelays(ctx sdk.Ctx, relays sdk.BigInt, address sdk.Addr
 		address = k.GetOutputAddressFromValidator(validator)
 	}
 
-	var coins sdk.BigInt
-
-	//check if PIP22 is enabled, if so scale the rewards
-	if k.Cdc.IsAfterNamedFeatureActivationHeight(ctx.BlockHeight(), codec.RSCALKey) {
-		stake := validator.GetTokens()
-		//floorstake to the lowest bin multiple or take ceiling, whicherver is smaller
-		flooredStake := sdk.MinInt(stake.Sub(stake.Mod(k.ServicerStakeFloorMultiplier(ctx))), k.ServicerStakeWeightCeiling(ctx).Sub(k.ServicerStakeWeightCeiling(ctx).Mod(k.ServicerStakeFloorMultiplier(ctx))))
-		//Convert from tokens to a BIN number
-		bin := flooredStake.Quo(k.ServicerStakeFloorMultiplier(ctx))
-		//calculate the weight value, weight will be a floatng point number so cast to DEC here and then truncate back to big int
-		weight := bin.ToDec().FracPow(k.ServicerStakeFloorMultiplierExponent(ctx), Pip22ExponentDenominator).Quo(k.ServicerStakeWeightMultiplier(ctx))
-		coinsDecimal := k.RelaysToTokensMultiplier(ctx).ToDec().Mul(relays.ToDec()).Mul(weight)
-		//truncate back to int
-		coins = coinsDecimal.TruncateInt()
-	} else {
-		coins = k.RelaysToTokensMultiplier(ctx).Mul(relays)
-	}
+	coins := k.RelaysToTokens(ctx, relays, validator.GetTokens())
 
 	toNode, toFeeCollector := k.NodeReward(ctx, coins)
 	if toNode.IsPositive() {
@@ -49,6 +33,51 @@ func (k Keeper) RewardForRelays(ctx sdk.Ctx, relays sdk.BigInt, address sdk.Addr
 	return toNode
 }
 
+// RelaysToTokens - The tokens minted for relays served by a node with the stake, before the dao and proposer allocations
+func (k Keeper) RelaysToTokens(ctx sdk.Ctx, relays sdk.BigInt, stake sdk.BigInt) sdk.BigInt {
+	//check if PIP22 is enabled, if so scale the rewards
+	if k.Cdc.IsAfterNamedFeatureActivationHeight(ctx.BlockHeight(), codec.RSCALKey) {
+		coinsDecimal := k.RelaysToTokensMultiplier(ctx).ToDec().Mul(relays.ToDec()).Mul(k.ServicerStakeWeight(ctx, stake))
+		//truncate back to int
+		return coinsDecimal.TruncateInt()
+	}
+	return k.RelaysToTokensMultiplier(ctx).Mul(relays)
+}
+
+// ServicerStakeWeight - The weight of the relays of a node with the stake (PIP22), 1 before the stake weighting
+func (k Keeper) ServicerStakeWeight(ctx sdk.Ctx, stake sdk.BigInt) sdk.BigDec {
+	if !k.Cdc.IsAfterNamedFeatureActivationHeight(ctx.BlockHeight(), codec.RSCALKey) {
+		return sdk.OneDec()
+	}
+	//floorstake to the lowest bin multiple or take ceiling, whicherver is smaller
+	flooredStake := sdk.MinInt(stake.Sub(stake.Mod(k.ServicerStakeFloorMultiplier(ctx))), k.ServicerStakeWeightCeiling(ctx).Sub(k.ServicerStakeWeightCeiling(ctx).Mod(k.ServicerStakeFloorMultiplier(ctx))))
+	//Convert from tokens to a BIN number
+	bin := flooredStake.Quo(k.ServicerStakeFloorMultiplier(ctx))
+	//calculate the weight value, weight will be a floatng point number so cast to DEC here
+	return bin.ToDec().FracPow(k.ServicerStakeFloorMultiplierExponent(ctx), Pip22ExponentDenominator).Quo(k.ServicerStakeWeightMultiplier(ctx))
+}
+
+// EstimateReward - Projects the tokens minted for relays served by a node with the stake, split the way they are
+// distributed: the servicer share is minted right away, the dao and proposer shares go through the fee collector
+func (k Keeper) EstimateReward(ctx sdk.Ctx, relays sdk.BigInt, stake sdk.BigInt) types.RewardEstimate {
+	minted := k.RelaysToTokens(ctx, relays, stake)
+	toNode, toFeeCollector := k.NodeReward(ctx, minted)
+	daoCut, proposerCut := sdk.ZeroInt(), toFeeCollector
+	daoAllocation := sdk.NewDec(k.DAOAllocation(ctx))
+	if daoAndProposerAllocation := daoAllocation.Add(sdk.NewDec(k.ProposerAllocation(ctx))); daoAndProposerAllocation.IsPositive() {
+		// same as the block reward: the dao cut is truncated and the proposer gets whatever is left
+		daoCut = toFeeCollector.ToDec().Mul(daoAllocation.Quo(daoAndProposerAllocation)).TruncateInt()
+		proposerCut = toFeeCollector.Sub(daoCut)
+	}
+	return types.RewardEstimate{
+		Relays:   relays,
+		Minted:   minted,
+		Servicer: toNode,
+		DAO:      daoCut,
+		Proposer: proposerCut,
+	}
+}
+
 // blockReward - Handles distribution of the collected fees
 func (k Keeper) blockReward(ctx sdk.Ctx, previousProposer sdk.Address) {
 	feesCollector := k.getFeePool(ctx)
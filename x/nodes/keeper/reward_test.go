@@ -406,3 +406,30 @@ func TestKeeper_rewardFromRelaysPIP22EXP(t *testing.T) {
 		})
 	}
 }
+
+func TestKeeper_EstimateReward(t *testing.T) {
+	codec.UpgradeFeatureMap[codec.RSCALKey] = 3
+	context, _, keeper := createTestInput(t, true)
+	context = context.WithBlockHeight(3)
+	p := keeper.GetParams(context)
+	p.ServicerStakeFloorMultiplier = types.DefaultServicerStakeFloorMultiplier
+	p.ServicerStakeWeightMultiplier = types.DefaultServicerStakeWeightMultiplier
+	p.ServicerStakeFloorMultiplierExponent = sdk.NewDecWithPrec(50, 2)
+	p.ServicerStakeWeightCeiling = 60000000000
+	keeper.SetParams(context, p)
+	validator := getStakedValidator()
+	validator.StakedTokens = keeper.ServicerStakeFloorMultiplier(context).Mul(sdk.NewInt(3))
+	keeper.SetValidator(context, validator)
+	// the estimate matches the tokens minted for the relays
+	estimate := keeper.EstimateReward(context, sdk.NewInt(1000), validator.GetTokens())
+	feesBefore := keeper.getFeePool(context).GetCoins().AmountOf("upokt")
+	toNode := keeper.RewardForRelays(context, sdk.NewInt(1000), validator.GetAddress())
+	assert.True(t, estimate.Servicer.Equal(toNode))
+	assert.True(t, estimate.Servicer.Equal(sdk.NewInt(1541525)))
+	fees := keeper.getFeePool(context).GetCoins().AmountOf("upokt").Sub(feesBefore)
+	assert.True(t, estimate.DAO.Add(estimate.Proposer).Equal(fees))
+	assert.True(t, estimate.Minted.Equal(estimate.Servicer.Add(fees)))
+	assert.True(t, estimate.DAO.GT(estimate.Proposer))
+	// a stake past the ceiling weighs as much as the ceiling
+	assert.True(t, keeper.ServicerStakeWeight(context, sdk.NewInt(p.ServicerStakeWeightCeiling)).Equal(keeper.ServicerStakeWeight(context, sdk.NewInt(p.ServicerStakeWeightCeiling*2))))
+}
@@ -0,0 +1,94 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/pokt-network/pocket-core/codec"
+	sdk "github.com/pokt-network/pocket-core/types"
+	"github.com/pokt-network/pocket-core/x/auth"
+	"github.com/pokt-network/pocket-core/x/nodes/types"
+)
+
+// SimulateStake - Runs the validation of a stake (or edit stake) message against the world state, as if it was sent
+// by the signer, and reports every rule it breaks. Nothing is written to the world state and no signature is needed
+func (k Keeper) SimulateStake(ctx sdk.Ctx, msg types.MsgStake, signer sdk.Address) types.StakeSimulation {
+	sim := types.StakeSimulation{
+		Failures:         make([]types.StakeRuleFailure, 0),
+		Fee:              msg.GetFee(),
+		CurrentStake:     sdk.ZeroInt(),
+		ResultingStake:   msg.Value,
+		Cost:             msg.Value,
+		CurrentWeight:    sdk.ZeroDec(),
+		MinimumEditStake: sdk.ZeroInt(),
+	}
+	fail := func(err sdk.Error) {
+		sim.Failures = append(sim.Failures, types.NewStakeRuleFailure(err))
+	}
+	if msg.PublicKey == nil || msg.PublicKey.RawString() == "" {
+		fail(types.ErrNilValidatorAddr(k.Codespace()))
+		return sim
+	}
+	address := sdk.Address(msg.PublicKey.Address())
+	sim.Custodial = msg.Output == nil || msg.Output.Equals(address)
+	// the checks of the basic validation, without stopping at the first one that fails
+	if !msg.Value.IsPositive() {
+		fail(types.ErrBadDelegationAmount(k.Codespace()))
+	}
+	if len(msg.Chains) == 0 {
+		fail(types.ErrNoChains(k.Codespace()))
+	}
+	for _, chain := range msg.Chains {
+		if err := types.ValidateNetworkIdentifier(chain); err != nil {
+			fail(err)
+		}
+	}
+	if err := types.ValidateServiceURL(msg.ServiceUrl); err != nil {
+		fail(err)
+	}
+	if k.Cdc.IsAfterNonCustodialUpgrade(ctx.BlockHeight()) {
+		if err := msg.CheckServiceUrlLength(msg.ServiceUrl); err != nil {
+			fail(err)
+		}
+	}
+	if current, found := k.GetValidator(ctx, address); found && current.IsStaked() {
+		sim.CurrentStake = current.StakedTokens
+		sim.CurrentWeight = k.ServicerStakeWeight(ctx, current.StakedTokens)
+		sim.MinimumEditStake = k.minimumEditStake(ctx, current.StakedTokens)
+		if ctx.IsAfterUpgradeHeight() {
+			sim.EditStake = true
+			sim.Cost = sdk.MaxInt(msg.Value.Sub(current.StakedTokens), sdk.ZeroInt())
+		}
+	}
+	sim.ResultingWeight = k.ServicerStakeWeight(ctx, sim.ResultingStake)
+	// the fee is deducted before the message is handled, so the stake is validated without it
+	cacheCtx, _ := ctx.CacheContext()
+	payer := signer
+	if !k.Cdc.IsAfterNonCustodialUpgrade(ctx.BlockHeight()) {
+		payer = address
+	}
+	fee := sdk.NewCoins(sdk.NewCoin(k.StakeDenom(ctx), sim.Fee))
+	if err := k.AccountKeeper.SendCoinsFromAccountToModule(cacheCtx, payer, auth.FeeCollectorName, fee); err != nil {
+		fail(sdk.ErrInsufficientFee(fmt.Sprintf("the signer can't pay the fee of %s: %v", sim.Fee.String(), err)))
+	}
+	validator := types.NewValidator(address, msg.PublicKey, msg.Chains, msg.ServiceUrl, sdk.ZeroInt(), msg.Output)
+	for _, err := range k.validatorStakingErrors(cacheCtx, validator, msg.Value, signer) {
+		fail(err)
+	}
+	sim.Valid = len(sim.Failures) == 0
+	return sim
+}
+
+// minimumEditStake - The smallest stake an edit of a node staked with the tokens accepts: with the stake weighting
+// it must move the node into the next bin, unless it's past the ceiling
+func (k Keeper) minimumEditStake(ctx sdk.Ctx, tokens sdk.BigInt) sdk.BigInt {
+	if !k.Cdc.IsAfterNamedFeatureActivationHeight(ctx.BlockHeight(), codec.RSCALKey) || !k.Cdc.IsAfterNamedFeatureActivationHeight(ctx.BlockHeight(), codec.VEDITKey) {
+		return tokens
+	}
+	floor := k.ServicerStakeFloorMultiplier(ctx)
+	next := tokens.Sub(tokens.Mod(floor)).Add(floor)
+	if ceiling := k.ServicerStakeWeightCeiling(ctx); next.GT(ceiling) {
+		// an edit at or above the ceiling is accepted if it doesn't lower the stake
+		return sdk.MaxInt(tokens, ceiling)
+	}
+	return next
+}
@@ -0,0 +1,73 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/pokt-network/pocket-core/codec"
+	sdk "github.com/pokt-network/pocket-core/types"
+	"github.com/pokt-network/pocket-core/x/nodes/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestKeeper_SimulateStake(t *testing.T) {
+	codec.TestMode = -3
+	codec.UpgradeHeight = -1
+	stakeAmount := sdk.NewInt(100000000000)
+	context, _, keeper := createTestInput(t, true)
+	val := getUnstakedValidator()
+	val.StakedTokens = sdk.ZeroInt()
+	val.OutputAddress = val.Address
+	coins := sdk.NewCoins(sdk.NewCoin(keeper.StakeDenom(context), stakeAmount.Add(sdk.NewInt(1000000))))
+	assert.Nil(t, keeper.AccountKeeper.MintCoins(context, types.StakedPoolName, coins))
+	assert.Nil(t, keeper.AccountKeeper.SendCoinsFromModuleToAccount(context, types.StakedPoolName, val.Address, coins))
+	msg := types.MsgStake{
+		PublicKey:  val.PublicKey,
+		Chains:     val.Chains,
+		Value:      stakeAmount,
+		ServiceUrl: val.ServiceURL,
+		Output:     val.Address,
+	}
+	// a new stake
+	sim := keeper.SimulateStake(context, msg, val.Address)
+	assert.True(t, sim.Valid, sim.Failures)
+	assert.False(t, sim.EditStake)
+	assert.True(t, sim.Custodial)
+	assert.Equal(t, stakeAmount, sim.Cost)
+	assert.Equal(t, keeper.ServicerStakeWeight(context, stakeAmount), sim.ResultingWeight)
+	// nothing is written to the world state
+	assert.Equal(t, coins.AmountOf(keeper.StakeDenom(context)), keeper.GetBalance(context, val.Address))
+	_, found := keeper.GetValidator(context, val.Address)
+	assert.False(t, found)
+	assert.Nil(t, keeper.StakeValidator(context, val, stakeAmount, val.PublicKey))
+	// an edit stake that breaks several rules at once
+	edit := msg
+	edit.Value = stakeAmount.Sub(sdk.OneInt())
+	edit.Chains = nil
+	edit.Output = sdk.Address(getRandomPubKey().Address())
+	sim = keeper.SimulateStake(context, edit, val.Address)
+	assert.False(t, sim.Valid)
+	assert.True(t, sim.EditStake)
+	assert.False(t, sim.Custodial)
+	assert.Equal(t, stakeAmount, sim.CurrentStake)
+	assert.True(t, sim.Cost.IsZero())
+	codes := make([]sdk.CodeType, 0)
+	for _, f := range sim.Failures {
+		codes = append(codes, f.Code)
+	}
+	assert.Contains(t, codes, types.ErrNoChains(types.DefaultCodespace).Code())
+	assert.Contains(t, codes, types.ErrMinimumEditStake(types.DefaultCodespace).Code())
+	assert.Contains(t, codes, types.ErrUnequalOutputAddr(types.DefaultCodespace).Code())
+	// the consensus validation stops at the first of them
+	validator := types.NewValidator(val.Address, val.PublicKey, edit.Chains, edit.ServiceUrl, sdk.ZeroInt(), edit.Output)
+	err := keeper.ValidateValidatorStaking(context, validator, edit.Value, val.Address)
+	assert.NotNil(t, err)
+	assert.Contains(t, codes, err.Code())
+	// a bump the signer can't pay for
+	bump := msg
+	bump.Value = stakeAmount.Add(sdk.NewInt(2000000))
+	sim = keeper.SimulateStake(context, bump, val.Address)
+	assert.False(t, sim.Valid)
+	assert.Equal(t, sdk.NewInt(2000000), sim.Cost)
+	assert.Len(t, sim.Failures, 1)
+	assert.Equal(t, types.ErrNotEnoughCoins(types.DefaultCodespace).Code(), sim.Failures[0].Code)
+}
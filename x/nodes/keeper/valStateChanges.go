@@ -12,6 +12,7 @@ import (
 
 	"github.com/pokt-network/pocket-core/codec"
 	sdk "github.com/pokt-network/pocket-core/types"
+	authexported "github.com/pokt-network/pocket-core/x/auth/exported"
 	"github.com/pokt-network/pocket-core/x/nodes/types"
 )
 
@@ -110,26 +111,32 @@ func (k Keeper) UpdateTendermintValidators(ctx sdk.Ctx) (updates []abci.Validato
 
 // ValidateValidatorStaking - Check Validator before staking
 func (k Keeper) ValidateValidatorStaking(ctx sdk.Ctx, validator types.Validator, amount sdk.BigInt, signerAddress sdk.Address) sdk.Error {
+	if errs := k.validatorStakingErrors(ctx, validator, amount, signerAddress); len(errs) != 0 {
+		return errs[0]
+	}
+	return nil
+}
+
+// validatorStakingErrors - Returns every staking rule the validator breaks, in the order they are checked
+func (k Keeper) validatorStakingErrors(ctx sdk.Ctx, validator types.Validator, amount sdk.BigInt, signerAddress sdk.Address) (errs []sdk.Error) {
 
 	//check the "new" validator's signature validity
 	//will recheck if validator exists
 	err, valid := ValidateValidatorMsgSigner(validator, signerAddress, k)
 	if !valid {
-		return err
+		errs = append(errs, err)
 	}
 
 	//check that we don't allow nil output if we are after noncustodial upgrade
 	//so we won't accept stakes/edits with nil outputAddress
 	if k.Cdc.IsAfterNonCustodialUpgrade(ctx.BlockHeight()) {
 		if validator.OutputAddress == nil {
-			return types.ErrNilOutputAddr(k.codespace)
+			errs = append(errs, types.ErrNilOutputAddr(k.codespace))
 		}
 	}
 
-	coin := sdk.NewCoins(sdk.NewCoin(k.StakeDenom(ctx), amount))
-
 	if int64(len(validator.Chains)) > k.MaxChains(ctx) {
-		return types.ErrTooManyChains(types.ModuleName)
+		errs = append(errs, types.ErrTooManyChains(types.ModuleName))
 	}
 
 	// check to see if the public key has already been register for that validator
@@ -138,45 +145,64 @@ func (k Keeper) ValidateValidatorStaking(ctx sdk.Ctx, validator types.Validator,
 		//check again based on the found "state" validator
 		err, valid := ValidateValidatorMsgSigner(val, signerAddress, k)
 		if !valid {
-			return err
+			errs = append(errs, err)
 		}
 		// edit stake in 6.X upgrade
 		if ctx.IsAfterUpgradeHeight() && val.IsStaked() {
-			return k.ValidateEditStake(ctx, val, validator, amount, signerAddress)
+			return append(errs, k.editStakeErrors(ctx, val, validator, amount, signerAddress)...)
 		}
 		if !val.IsUnstaked() { // unstaking or already staked but before the upgrade
-			return types.ErrValidatorStatus(k.codespace)
+			errs = append(errs, types.ErrValidatorStatus(k.codespace))
 		}
 	} else {
 		// check the consensus params
 		if ctx.ConsensusParams() != nil {
 			tmPubKey, err := crypto.CheckConsensusPubKey(validator.PublicKey.PubKey())
 			if err != nil {
-				return types.ErrValidatorPubKeyTypeNotSupported(k.Codespace(),
+				errs = append(errs, types.ErrValidatorPubKeyTypeNotSupported(k.Codespace(),
 					err.Error(),
-					ctx.ConsensusParams().Validator.PubKeyTypes)
-			}
-			if !strings.StringInSlice(tmPubKey.Type, ctx.ConsensusParams().Validator.PubKeyTypes) {
-				return types.ErrValidatorPubKeyTypeNotSupported(k.Codespace(),
+					ctx.ConsensusParams().Validator.PubKeyTypes))
+			} else if !strings.StringInSlice(tmPubKey.Type, ctx.ConsensusParams().Validator.PubKeyTypes) {
+				errs = append(errs, types.ErrValidatorPubKeyTypeNotSupported(k.Codespace(),
 					tmPubKey.Type,
-					ctx.ConsensusParams().Validator.PubKeyTypes)
+					ctx.ConsensusParams().Validator.PubKeyTypes))
 			}
 		}
 	}
 	if amount.LT(sdk.NewInt(k.MinimumStake(ctx))) {
-		return types.ErrMinimumStake(k.codespace)
+		errs = append(errs, types.ErrMinimumStake(k.codespace))
 	}
+	if !amount.IsPositive() {
+		return errs
+	}
+	coin := sdk.NewCoins(sdk.NewCoin(k.StakeDenom(ctx), amount))
 	if k.Cdc.IsAfterNonCustodialUpgrade(ctx.BlockHeight()) {
 		if !k.AccountKeeper.HasCoins(ctx, signerAddress, coin) {
-			return types.ErrNotEnoughCoins(k.codespace)
+			errs = append(errs, types.ErrNotEnoughCoins(k.codespace))
 		}
 	} else {
 		if !k.AccountKeeper.HasCoins(ctx, validator.Address, coin) {
-			return types.ErrNotEnoughCoins(k.codespace)
+			errs = append(errs, types.ErrNotEnoughCoins(k.codespace))
 		}
 	}
+	if !k.isVestingOutput(ctx, signerAddress, validator) {
+		errs = append(errs, types.ErrVestingOutputAddr(k.codespace))
+	}
+	return errs
+}
 
-	return nil
+// isVestingOutput - Returns false if the signer is a vesting account and the staked coins are unstaked to another
+// account, the locked coins of the signer would be spendable by the other account
+func (k Keeper) isVestingOutput(ctx sdk.Ctx, signer sdk.Address, validators ...types.Validator) bool {
+	if _, ok := k.AccountKeeper.GetAccount(ctx, signer).(authexported.VestingAccount); !ok {
+		return true
+	}
+	for _, validator := range validators {
+		if !k.GetOutputAddressFromValidator(validator).Equals(signer) {
+			return false
+		}
+	}
+	return true
 }
 
 //ValidateValidatorMsgSigner Check Validator Signature
@@ -196,10 +222,19 @@ func ValidateValidatorMsgSigner(validator types.Validator, signerAddress sdk.Add
 
 // ValidateEditStake - Validate the updates to a current staked validator
 func (k Keeper) ValidateEditStake(ctx sdk.Ctx, currentValidator, newValidtor types.Validator, amount sdk.BigInt, signer sdk.Address) sdk.Error {
+	if errs := k.editStakeErrors(ctx, currentValidator, newValidtor, amount, signer); len(errs) != 0 {
+		return errs[0]
+	}
+	return nil
+}
+
+// editStakeErrors - Returns every edit stake rule the updates to a current staked validator break, in the order they
+// are checked
+func (k Keeper) editStakeErrors(ctx sdk.Ctx, currentValidator, newValidtor types.Validator, amount sdk.BigInt, signer sdk.Address) (errs []sdk.Error) {
 	// ensure not staking less
 	diff := amount.Sub(currentValidator.StakedTokens)
 	if diff.IsNegative() {
-		return types.ErrMinimumEditStake(k.codespace)
+		errs = append(errs, types.ErrMinimumEditStake(k.codespace))
 	}
 
 	if k.Cdc.IsAfterNamedFeatureActivationHeight(ctx.BlockHeight(), codec.RSCALKey) && k.Cdc.IsAfterNamedFeatureActivationHeight(ctx.BlockHeight(), codec.VEDITKey) {
@@ -208,39 +243,42 @@ func (k Keeper) ValidateEditStake(ctx sdk.Ctx, currentValidator, newValidtor typ
 			//grab the bin and check if we are in a new bin
 			flooredNewStake := amount.Sub(amount.Mod(k.ServicerStakeFloorMultiplier(ctx)))
 			if flooredNewStake.LTE(currentValidator.StakedTokens) {
-				return types.ErrSameBinEditStake(k.codespace)
+				errs = append(errs, types.ErrSameBinEditStake(k.codespace))
 			}
 		}
 	}
 
 	// if stake bump
-	if !diff.IsZero() {
+	if diff.IsPositive() {
 		// ensure account has enough coins for bump
 		coin := sdk.NewCoins(sdk.NewCoin(k.StakeDenom(ctx), diff))
 		if k.Cdc.IsAfterNonCustodialUpgrade(ctx.BlockHeight()) {
 			if !k.AccountKeeper.HasCoins(ctx, signer, coin) {
-				return types.ErrNotEnoughCoins(k.Codespace())
+				errs = append(errs, types.ErrNotEnoughCoins(k.Codespace()))
 			}
 		} else {
 			if !k.AccountKeeper.HasCoins(ctx, currentValidator.Address, coin) {
-				return types.ErrNotEnoughCoins(k.Codespace())
+				errs = append(errs, types.ErrNotEnoughCoins(k.Codespace()))
 			}
 		}
+		// the output address is kept once set, so checking both covers the edit that sets it
+		if !k.isVestingOutput(ctx, signer, currentValidator, newValidtor) {
+			errs = append(errs, types.ErrVestingOutputAddr(k.Codespace()))
+		}
 	}
 	if k.Cdc.IsAfterNonCustodialUpgrade(ctx.BlockHeight()) {
 		// ensure output address doesn't change
 		if currentValidator.OutputAddress != nil {
 			if !newValidtor.OutputAddress.Equals(currentValidator.OutputAddress) {
-				fmt.Println(currentValidator.String())
-				return types.ErrUnequalOutputAddr(k.Codespace())
+				errs = append(errs, types.ErrUnequalOutputAddr(k.Codespace()))
 			}
 		}
 		// prevent waiting vals from modifying anything
 		if k.IsWaitingValidator(ctx, currentValidator.Address) {
-			return types.ErrValidatorWaitingToUnstake(types.ModuleName)
+			errs = append(errs, types.ErrValidatorWaitingToUnstake(types.ModuleName))
 		}
 	}
-	return nil
+	return errs
 }
 
 // StakeValidator - Store ops when a validator stakes
@@ -3,6 +3,7 @@ package keeper
 import (
 	"github.com/pokt-network/pocket-core/codec"
 	sdk "github.com/pokt-network/pocket-core/types"
+	"github.com/pokt-network/pocket-core/x/auth"
 	"github.com/pokt-network/pocket-core/x/nodes/types"
 	"github.com/stretchr/testify/assert"
 	abci "github.com/tendermint/tendermint/abci/types"
@@ -724,3 +725,34 @@ func TestKeeper_ValidateUnjailMessage(t *testing.T) {
 		})
 	}
 }
+
+func TestKeeper_ValidateValidatorStakingVesting(t *testing.T) {
+	codec.TestMode = -3
+	codec.UpgradeHeight = -1
+	context, _, keeper := createTestInput(t, true)
+	stakeAmount := sdk.NewInt(100000000000)
+	coins := sdk.NewCoins(sdk.NewCoin(keeper.StakeDenom(context), stakeAmount))
+	val := getUnstakedValidator()
+	val.StakedTokens = sdk.ZeroInt()
+	// the operator is a vesting account with every coin locked
+	baseAcc := auth.NewBaseAccountWithAddress(val.Address)
+	baseAcc.Coins = coins
+	baseAcc.PubKey = val.PublicKey
+	ak := keeper.AccountKeeper.(auth.Keeper)
+	ak.SetAccount(context, auth.NewDelayedVestingAccount(&baseAcc, coins, context.BlockHeader().Time.Add(time.Hour).Unix()))
+	// the locked coins can't be unstaked to another account
+	val.OutputAddress = getRandomValidatorAddress()
+	err := keeper.ValidateValidatorStaking(context, val, stakeAmount, val.Address)
+	assert.Equal(t, types.ErrVestingOutputAddr(types.ModuleName).Error(), err.Error())
+	val.OutputAddress = val.Address
+	assert.Nil(t, keeper.ValidateValidatorStaking(context, val, stakeAmount, val.Address))
+	assert.Nil(t, keeper.StakeValidator(context, val, stakeAmount, val.PublicKey))
+	vacc := ak.GetAccount(context, val.Address).(auth.VestingAccount)
+	assert.True(t, vacc.GetDelegatedVesting().IsEqual(coins))
+	// the unstaked coins are locked again
+	val, _ = keeper.GetValidator(context, val.Address)
+	assert.Nil(t, keeper.coinsFromStakedToUnstaked(context, val))
+	vacc = ak.GetAccount(context, val.Address).(auth.VestingAccount)
+	assert.True(t, vacc.GetDelegatedVesting().IsZero())
+	assert.True(t, vacc.GetCoins().IsEqual(vacc.GetVestingCoins(context.BlockHeader().Time)))
+}
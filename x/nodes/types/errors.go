@@ -37,6 +37,7 @@ const (
 	CodeUnequalOutputAddr        CodeType          = 124
 	CodeUnauthorizedSigner       CodeType          = 125
 	CodeNilSigner                CodeType          = 126
+	CodeVestingOutputAddr        CodeType          = 127
 )
 
 func ErrTooManyChains(codespace sdk.CodespaceType) sdk.Error {
@@ -65,6 +66,9 @@ func ErrNilOutputAddr(codespace sdk.CodespaceType) sdk.Error {
 func ErrUnequalOutputAddr(codespace sdk.CodespaceType) sdk.Error {
 	return sdk.NewError(codespace, CodeUnequalOutputAddr, "output address is already set to a different value")
 }
+func ErrVestingOutputAddr(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeVestingOutputAddr, "a vesting account can only stake with itself as the output address")
+}
 func ErrUnauthorizedSigner(codespace sdk.CodespaceType) sdk.Error {
 	return sdk.NewError(codespace, CodeUnauthorizedSigner, "the signer for this message is not the operator or the output address")
 }
@@ -0,0 +1,36 @@
+package types
+
+import (
+	sdk "github.com/pokt-network/pocket-core/types"
+)
+
+// RewardEstimate - The projected tokens minted for relays and how they are split
+type RewardEstimate struct {
+	Relays   sdk.BigInt `json:"relays"`
+	Minted   sdk.BigInt `json:"minted"`   // the total minted for the relays
+	Servicer sdk.BigInt `json:"servicer"` // minted to the servicer (or its output address)
+	DAO      sdk.BigInt `json:"dao"`      // sent to the dao by the block reward
+	Proposer sdk.BigInt `json:"proposer"` // sent to the block proposer by the block reward
+}
+
+// NewRewardEstimate - Returns an estimate of no relays
+func NewRewardEstimate() RewardEstimate {
+	return RewardEstimate{
+		Relays:   sdk.ZeroInt(),
+		Minted:   sdk.ZeroInt(),
+		Servicer: sdk.ZeroInt(),
+		DAO:      sdk.ZeroInt(),
+		Proposer: sdk.ZeroInt(),
+	}
+}
+
+// Add - Returns the sum of the estimates
+func (r RewardEstimate) Add(other RewardEstimate) RewardEstimate {
+	return RewardEstimate{
+		Relays:   r.Relays.Add(other.Relays),
+		Minted:   r.Minted.Add(other.Minted),
+		Servicer: r.Servicer.Add(other.Servicer),
+		DAO:      r.DAO.Add(other.DAO),
+		Proposer: r.Proposer.Add(other.Proposer),
+	}
+}
@@ -0,0 +1,38 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/pokt-network/pocket-core/types"
+)
+
+// StakeSimulation - The outcome of a stake (or edit stake) message run against the world state without a signature
+type StakeSimulation struct {
+	Valid            bool               `json:"valid"`
+	EditStake        bool               `json:"edit_stake"` // the node is already staked, so the message edits its stake
+	Custodial        bool               `json:"custodial"`  // the output address is the operator address
+	Failures         []StakeRuleFailure `json:"failures"`   // every rule the message breaks
+	Fee              sdk.BigInt         `json:"fee"`
+	CurrentStake     sdk.BigInt         `json:"current_stake"`
+	ResultingStake   sdk.BigInt         `json:"resulting_stake"`
+	Cost             sdk.BigInt         `json:"cost"`               // the tokens moved from the signer to the stake
+	CurrentWeight    sdk.BigDec         `json:"current_weight"`     // the stake weight of the relays with the current stake
+	ResultingWeight  sdk.BigDec         `json:"resulting_weight"`   // the stake weight of the relays with the resulting stake
+	MinimumEditStake sdk.BigInt         `json:"minimum_edit_stake"` // the smallest stake an edit of the node accepts
+}
+
+// StakeRuleFailure - A rule broken by a stake message
+type StakeRuleFailure struct {
+	Codespace sdk.CodespaceType `json:"codespace"`
+	Code      sdk.CodeType      `json:"code"`
+	Message   string            `json:"message"`
+}
+
+// NewStakeRuleFailure - Returns the failure of the error
+func NewStakeRuleFailure(err sdk.Error) StakeRuleFailure {
+	return StakeRuleFailure{
+		Codespace: err.Codespace(),
+		Code:      err.Code(),
+		Message:   fmt.Sprintf("%v", err),
+	}
+}
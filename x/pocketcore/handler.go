@@ -2,6 +2,7 @@ package pocketcore
 
 import (
 	"fmt"
+	"github.com/pokt-network/pocket-core/codec"
 	"github.com/pokt-network/pocket-core/crypto"
 	sdk "github.com/pokt-network/pocket-core/types"
 	"github.com/pokt-network/pocket-core/x/pocketcore/keeper"
@@ -27,6 +28,12 @@ func NewHandler(keeper keeper.Keeper) sdk.Handler {
 		// handle legacy proof message
 		case types.MsgProof:
 			return handleProofMsg(ctx, keeper, msg)
+		// handle batched claim message
+		case types.MsgBatchClaim:
+			return handleBatchClaimMsg(ctx, keeper, msg)
+		// handle batched proof message
+		case types.MsgBatchProof:
+			return handleBatchProofMsg(ctx, keeper, msg)
 		default:
 			errMsg := fmt.Sprintf("Unrecognized pocketcore ProtoMsg type: %v", msg.Type())
 			return sdk.ErrUnknownRequest(errMsg).Result()
@@ -56,34 +63,114 @@ func handleClaimMsg(ctx sdk.Ctx, k keeper.Keeper, msg types.MsgClaim) sdk.Result
 	return sdk.Result{Events: ctx.EventManager().Events()}
 }
 
+// "handleBatchClaimMsg" - General handler for the batched claim message, every claim is validated before any is set
+// and the claims are only written once all of them are set
+func handleBatchClaimMsg(ctx sdk.Ctx, k keeper.Keeper, msg types.MsgBatchClaim) sdk.Result {
+	defer sdk.TimeTrack(time.Now())
+	if !k.Cdc.IsAfterNamedFeatureActivationHeight(ctx.BlockHeight(), codec.BatchClaimKey) {
+		return types.NewBatchNotActiveError(types.ModuleName).Result()
+	}
+	// validate every claim of the batch
+	for _, claim := range msg.Claims {
+		if err := k.ValidateClaim(ctx, claim); err != nil {
+			return err.Result()
+		}
+	}
+	// the claims are set atomically, if one fails none of them is set
+	cacheCtx, writeCache := ctx.CacheContext()
+	for _, claim := range msg.Claims {
+		// set the claim in the world state
+		err := k.SetClaim(cacheCtx, claim)
+		if err != nil {
+			return sdk.ErrInternal(err.Error()).Result()
+		}
+		// create the event
+		cacheCtx.EventManager().EmitEvents(sdk.Events{
+			sdk.NewEvent(
+				types.EventTypeClaim,
+				sdk.NewAttribute(types.AttributeKeyValidator, claim.FromAddress.String()),
+			),
+		})
+	}
+	writeCache()
+	ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+	return sdk.Result{Events: ctx.EventManager().Events()}
+}
+
 // "handleProofMsg" - General handler for the proof message
 func handleProofMsg(ctx sdk.Ctx, k keeper.Keeper, proof types.MsgProof) sdk.Result {
 	defer sdk.TimeTrack(time.Now())
+	if err := processProof(ctx, k, proof); err != nil {
+		return err.Result()
+	}
+	return sdk.Result{Events: ctx.EventManager().Events()}
+}
+
+// "handleBatchProofMsg" - General handler for the batched proof message, every proof is validated before any is
+// executed. An invalid proof is handled as if it was sent on its own (a replay attack is burned), the proofs are only
+// written and the local evidence deleted once the whole batch succeeded
+func handleBatchProofMsg(ctx sdk.Ctx, k keeper.Keeper, msg types.MsgBatchProof) sdk.Result {
+	defer sdk.TimeTrack(time.Now())
+	if !k.Cdc.IsAfterNamedFeatureActivationHeight(ctx.BlockHeight(), codec.BatchClaimKey) {
+		return types.NewBatchNotActiveError(types.ModuleName).Result()
+	}
+	proofs := msg.ProofMsgs()
+	addrs, claims := make([]sdk.Address, len(proofs)), make([]types.MsgClaim, len(proofs))
+	// validate every proof of the batch, so every invalid one is handled
+	var firstErr sdk.Error
+	for i, proof := range proofs {
+		addr, claim, err := k.ValidateProof(ctx, proof)
+		if err != nil {
+			handleInvalidProof(ctx, k, proof, addr, claim, err)
+			if firstErr == nil {
+				firstErr = err
+			}
+			continue
+		}
+		addrs[i], claims[i] = addr, claim
+	}
+	if firstErr != nil {
+		return firstErr.Result()
+	}
+	// the proofs are executed atomically, if one fails none of them is minted
+	cacheCtx, writeCache := ctx.CacheContext()
+	tokens := make([]sdk.BigInt, len(proofs))
+	for i, proof := range proofs {
+		// valid claim message so execute according to type
+		t, err := k.ExecuteProof(cacheCtx, proof, claims[i])
+		if err != nil {
+			return err.Result()
+		}
+		tokens[i] = t
+		// create the event
+		cacheCtx.EventManager().EmitEvents(sdk.Events{
+			sdk.NewEvent(
+				types.EventTypeProof,
+				sdk.NewAttribute(types.AttributeKeyValidator, addrs[i].String()),
+			),
+		})
+	}
+	writeCache()
+	ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+	// delete local evidence
+	for i, proof := range proofs {
+		processSelf(ctx, k, proof.GetSigners()[0], claims[i].SessionHeader, claims[i].EvidenceType, tokens[i])
+	}
+	return sdk.Result{Events: ctx.EventManager().Events()}
+}
 
+// "processProof" - Validates and executes a single proof
+func processProof(ctx sdk.Ctx, k keeper.Keeper, proof types.MsgProof) sdk.Error {
 	// validate the claim claim
 	addr, claim, err := k.ValidateProof(ctx, proof)
 	if err != nil {
-		if err.Code() == types.CodeInvalidMerkleVerifyError && !claim.IsEmpty() {
-			// delete local evidence
-			processSelf(ctx, k, proof.GetSigners()[0], claim.SessionHeader, claim.EvidenceType, sdk.ZeroInt())
-			return err.Result()
-		}
-		if err.Code() == types.CodeReplayAttackError && !claim.IsEmpty() {
-			// delete local evidence
-			processSelf(ctx, k, proof.GetSigners()[0], claim.SessionHeader, claim.EvidenceType, sdk.ZeroInt())
-			// if is a replay attack, handle accordingly
-			k.HandleReplayAttack(ctx, addr, sdk.NewInt(claim.TotalProofs))
-			err := k.DeleteClaim(ctx, addr, claim.SessionHeader, claim.EvidenceType)
-			if err != nil {
-				ctx.Logger().Error("Could not delete claim from world state after replay attack detected", "Address", claim.FromAddress)
-			}
-		}
-		return err.Result()
+		handleInvalidProof(ctx, k, proof, addr, claim, err)
+		return err
 	}
 	// valid claim message so execute according to type
 	tokens, err := k.ExecuteProof(ctx, proof, claim)
 	if err != nil {
-		return err.Result()
+		return err
 	}
 	// delete local evidence
 	processSelf(ctx, k, proof.GetSigners()[0], claim.SessionHeader, claim.EvidenceType, tokens)
@@ -94,18 +181,46 @@ func handleProofMsg(ctx sdk.Ctx, k keeper.Keeper, proof types.MsgProof) sdk.Resu
 			sdk.NewAttribute(types.AttributeKeyValidator, addr.String()),
 		),
 	})
-	return sdk.Result{Events: ctx.EventManager().Events()}
+	return nil
+}
+
+// "handleInvalidProof" - Deletes the local evidence of a proof that failed its validation, a replay attack is burned
+// and its claim deleted
+func handleInvalidProof(ctx sdk.Ctx, k keeper.Keeper, proof types.MsgProof, addr sdk.Address, claim types.MsgClaim, err sdk.Error) {
+	if err.Code() == types.CodeInvalidMerkleVerifyError && !claim.IsEmpty() {
+		// delete local evidence
+		processSelf(ctx, k, proof.GetSigners()[0], claim.SessionHeader, claim.EvidenceType, sdk.ZeroInt())
+		return
+	}
+	if err.Code() == types.CodeReplayAttackError && !claim.IsEmpty() {
+		// delete local evidence
+		processSelf(ctx, k, proof.GetSigners()[0], claim.SessionHeader, claim.EvidenceType, sdk.ZeroInt())
+		// if is a replay attack, handle accordingly
+		k.HandleReplayAttack(ctx, addr, sdk.NewInt(claim.TotalProofs))
+		err := k.DeleteClaim(ctx, addr, claim.SessionHeader, claim.EvidenceType)
+		if err != nil {
+			ctx.Logger().Error("Could not delete claim from world state after replay attack detected", "Address", claim.FromAddress)
+		}
+	}
 }
 
 func processSelf(ctx sdk.Ctx, k keeper.Keeper, signer sdk.Address, header types.SessionHeader, evidenceType types.EvidenceType, tokens sdk.BigInt) {
+	// a simulation runs the handler against the check state, only a delivered tx changes the local evidence, the
+	// metrics and the ledger
+	if ctx.IsCheckTx() {
+		return
+	}
 	// delete local evidence
-	if signer.Equals(k.GetSelfAddress(ctx)) {
-		err := types.DeleteEvidence(header, evidenceType)
+	if k.IsSelfServicer(ctx, signer) {
+		err := types.DeleteEvidence(signer, header, evidenceType)
 		if err != nil {
 			ctx.Logger().Error("Unable to delete evidence: " + err.Error())
 		}
 		if !tokens.IsZero() {
-			types.GlobalServiceMetric().AddUPOKTEarnedFor(header.Chain, float64(tokens.Int64()))
+			types.GlobalServiceMetric().AddUPOKTEarnedFor(header.Chain, header.ApplicationPubKey, float64(tokens.Int64()))
+			if evidenceType == types.RelayEvidence {
+				types.RecordMinted(signer, header, tokens, ctx.BlockHeight())
+			}
 		}
 	}
 }
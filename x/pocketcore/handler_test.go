@@ -0,0 +1,147 @@
+package pocketcore
+
+import (
+	"encoding/hex"
+	"fmt"
+	"testing"
+
+	"github.com/pokt-network/pocket-core/codec"
+	"github.com/pokt-network/pocket-core/crypto"
+	sdk "github.com/pokt-network/pocket-core/types"
+	appsKeeper "github.com/pokt-network/pocket-core/x/apps/keeper"
+	appsTypes "github.com/pokt-network/pocket-core/x/apps/types"
+	keep "github.com/pokt-network/pocket-core/x/pocketcore/keeper"
+	"github.com/pokt-network/pocket-core/x/pocketcore/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/tendermint/tendermint/libs/log"
+	"github.com/tendermint/tendermint/privval"
+)
+
+// creates a hosted servicer with the evidence of its relays loaded in the local cache
+func createTestServicer(t *testing.T) crypto.PrivateKey {
+	sdk.InitCtxCache(10)
+	types.InitConfig(&types.HostedBlockchains{M: make(map[string]types.HostedBlockchain)}, log.NewNopLogger(), sdk.DefaultTestingPocketConfig())
+	types.ClearEvidence()
+	servicer := crypto.GenerateEd25519PrivKey()
+	assert.Nil(t, types.InitServicerKeyFiles([]privval.FilePVKey{{PrivKey: servicer.PrivKey()}}))
+	return servicer
+}
+
+// creates the evidence of the servicer for a session of a new app, sets the claim of the evidence in the world state
+// and returns the proof the claim requires
+func createTestClaimAndProof(t *testing.T, ctx sdk.Ctx, k keep.Keeper, appk appsKeeper.Keeper, servicer crypto.PrivateKey, relays int) (types.MsgClaim, types.MsgProof) {
+	ethereum := hex.EncodeToString([]byte{01})
+	appKey, clientKey := crypto.GenerateEd25519PrivKey(), crypto.GenerateEd25519PrivKey()
+	app := appsTypes.NewApplication(sdk.Address(appKey.PublicKey().Address()), appKey.PublicKey(), []string{ethereum}, sdk.NewInt(10000000))
+	app.MaxRelays = appk.CalculateAppRelays(ctx, app)
+	appk.SetApplication(ctx, app)
+	appk.SetStakedApplication(ctx, app)
+	header := types.SessionHeader{
+		ApplicationPubKey:  appKey.PublicKey().RawString(),
+		Chain:              ethereum,
+		SessionBlockHeight: ctx.BlockHeight(),
+	}
+	servicerAddr := sdk.Address(servicer.PublicKey().Address())
+	aat := types.AAT{
+		Version:              "0.0.1",
+		ApplicationPublicKey: appKey.PublicKey().RawString(),
+		ClientPublicKey:      clientKey.PublicKey().RawString(),
+	}
+	sig, err := appKey.Sign(aat.Hash())
+	assert.Nil(t, err)
+	aat.ApplicationSignature = hex.EncodeToString(sig)
+	for i := 0; i < relays; i++ {
+		proof := types.RelayProof{
+			Entropy:            int64(i + 1),
+			RequestHash:        aat.HashString(),
+			SessionBlockHeight: header.SessionBlockHeight,
+			ServicerPubKey:     servicer.PublicKey().RawString(),
+			Blockchain:         ethereum,
+			Token:              aat,
+		}
+		sig, err := clientKey.Sign(proof.Hash())
+		assert.Nil(t, err)
+		proof.Signature = hex.EncodeToString(sig)
+		types.SetProof(servicerAddr, header, types.RelayEvidence, proof, sdk.NewInt(100000))
+	}
+	evidence, err := types.GetEvidence(servicerAddr, header, types.RelayEvidence, sdk.NewInt(100000))
+	assert.Nil(t, err)
+	claim := types.MsgClaim{
+		SessionHeader: header,
+		MerkleRoot:    evidence.GenerateMerkleRoot(servicerAddr, 0, int64(relays)),
+		TotalProofs:   int64(relays),
+		FromAddress:   servicerAddr,
+		EvidenceType:  types.RelayEvidence,
+	}
+	assert.Nil(t, k.SetClaim(ctx, claim))
+	// the hash of the proof block selects the leaf, the current block stands in for it
+	proofHeight := header.SessionBlockHeight + k.ClaimSubmissionWindow(ctx)*k.BlocksPerSession(ctx)
+	sdk.GlobalCtxCache.Add(fmt.Sprintf("%d", proofHeight), ctx)
+	for i := 0; i < relays; i++ {
+		merkleProof, leaf := evidence.GenerateMerkleProof(0, i, int64(relays))
+		proof := types.MsgProof{MerkleProof: merkleProof, Leaf: leaf, EvidenceType: types.RelayEvidence}
+		if _, _, err := k.ValidateProof(ctx, proof); err == nil {
+			return claim, proof
+		}
+	}
+	t.Fatal("no valid proof for the claim")
+	return claim, types.MsgProof{}
+}
+
+func TestHandleProofMsg_Simulate(t *testing.T) {
+	servicer := createTestServicer(t)
+	defer func() { _ = types.InitServicerKeyFiles(nil) }()
+	ctx, _, appk, k, _ := createTestInput(t, false)
+	claim, proof := createTestClaimAndProof(t, ctx, k, appk, servicer, 5)
+	h := NewHandler(k)
+	// a simulation runs the handler against a cache of the check state
+	simCtx, _ := ctx.CacheContext()
+	res := h(simCtx.WithIsCheckTx(true), proof, nil)
+	assert.True(t, res.IsOK(), res.Log)
+	_, total := types.GetTotalProofs(claim.FromAddress, claim.SessionHeader, types.RelayEvidence, sdk.NewInt(100000))
+	assert.Equal(t, claim.TotalProofs, total)
+	// the delivered proof deletes the local evidence
+	res = h(ctx, proof, nil)
+	assert.True(t, res.IsOK(), res.Log)
+	_, total = types.GetTotalProofs(claim.FromAddress, claim.SessionHeader, types.RelayEvidence, sdk.NewInt(100000))
+	assert.Zero(t, total)
+}
+
+func TestHandleBatchProofMsg(t *testing.T) {
+	servicer := createTestServicer(t)
+	defer func() { _ = types.InitServicerKeyFiles(nil) }()
+	ctx, _, appk, k, _ := createTestInput(t, false)
+	codec.UpgradeFeatureMap[codec.BatchClaimKey] = ctx.BlockHeight()
+	defer delete(codec.UpgradeFeatureMap, codec.BatchClaimKey)
+	claim1, proof1 := createTestClaimAndProof(t, ctx, k, appk, servicer, 5)
+	claim2, proof2 := createTestClaimAndProof(t, ctx, k, appk, servicer, 6)
+	claim3, proof3 := createTestClaimAndProof(t, ctx, k, appk, servicer, 7)
+	// the claim of the last proof is gone, so the batch fails after a valid proof
+	assert.Nil(t, k.DeleteClaim(ctx, claim3.FromAddress, claim3.SessionHeader, claim3.EvidenceType))
+	h := NewHandler(k)
+	res := h(ctx, types.NewMsgBatchProof([]types.MsgProof{proof1, proof3}), nil)
+	assert.EqualValues(t, types.CodeClaimNotFoundError, res.Code)
+	// the evidence of the valid proof is kept to prove the session again
+	_, total := types.GetTotalProofs(claim1.FromAddress, claim1.SessionHeader, types.RelayEvidence, sdk.NewInt(100000))
+	assert.Equal(t, claim1.TotalProofs, total)
+	_, found := k.GetClaim(ctx, claim1.FromAddress, claim1.SessionHeader, claim1.EvidenceType)
+	assert.True(t, found)
+	// an invalid merkle proof is handled as if it was sent on its own, its local evidence is deleted
+	invalid := proof2
+	invalid.MerkleProof.HashRanges = append([]types.HashRange{}, proof2.MerkleProof.HashRanges...)
+	invalid.MerkleProof.HashRanges[0].Hash = types.Hash([]byte("fake"))
+	res = h(ctx, types.NewMsgBatchProof([]types.MsgProof{proof1, invalid}), nil)
+	assert.EqualValues(t, types.CodeInvalidMerkleVerifyError, res.Code)
+	_, total = types.GetTotalProofs(claim2.FromAddress, claim2.SessionHeader, types.RelayEvidence, sdk.NewInt(100000))
+	assert.Zero(t, total)
+	_, total = types.GetTotalProofs(claim1.FromAddress, claim1.SessionHeader, types.RelayEvidence, sdk.NewInt(100000))
+	assert.Equal(t, claim1.TotalProofs, total)
+	res = h(ctx, types.NewMsgBatchProof([]types.MsgProof{proof1, proof2}), nil)
+	assert.True(t, res.IsOK(), res.Log)
+	for _, claim := range []types.MsgClaim{claim1, claim2} {
+		_, total = types.GetTotalProofs(claim.FromAddress, claim.SessionHeader, types.RelayEvidence, sdk.NewInt(100000))
+		assert.Zero(t, total)
+		_, found = k.GetClaim(ctx, claim.FromAddress, claim.SessionHeader, claim.EvidenceType)
+		assert.False(t, found)
+	}
+}
@@ -9,9 +9,14 @@ func (k Keeper) GetHostedBlockchains() *pc.HostedBlockchains {
 	return k.hostedBlockchains
 }
 
-func (k Keeper) SetHostedBlockchains(m map[string]pc.HostedBlockchain) *pc.HostedBlockchains {
+// "SetHostedBlockchains" replaces the non native chains hosted locally on this node, an invalid config is rejected and
+// the current chains are kept
+func (k Keeper) SetHostedBlockchains(m map[string]pc.HostedBlockchain) (*pc.HostedBlockchains, error) {
+	if err := (&pc.HostedBlockchains{M: m}).Validate(); err != nil {
+		return nil, err
+	}
 	k.hostedBlockchains.L.Lock()
 	k.hostedBlockchains.M = m
 	k.hostedBlockchains.L.Unlock()
-	return k.hostedBlockchains
+	return k.hostedBlockchains, nil
 }
@@ -25,3 +25,31 @@ func TestKeeper_GetHostedBlockchains(t *testing.T) {
 	assert.True(t, hb.Contains(eth.ID))
 	assert.False(t, hb.Contains(btc.ID))
 }
+
+func TestKeeper_SetHostedBlockchains(t *testing.T) {
+	ethereum := hex.EncodeToString([]byte{01})
+	bitcoin := hex.EncodeToString([]byte{02})
+	_, _, _, _, keeper, _, _ := createTestInput(t, false)
+	tests := []struct {
+		name  string
+		chain types.HostedBlockchain
+	}{
+		{"cache without entries", types.HostedBlockchain{ID: bitcoin, URL: "https://www.google.com:443", Cache: &types.RelayCache{}}},
+		{"unknown response validator", types.HostedBlockchain{ID: bitcoin, URL: "https://www.google.com:443", Validators: []string{"unknown"}}},
+		{"grpc transport with an http url", types.HostedBlockchain{ID: bitcoin, URL: "https://www.google.com:443", Transport: types.GRPCTransport}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := keeper.SetHostedBlockchains(map[string]types.HostedBlockchain{bitcoin: tt.chain})
+			assert.NotNil(t, err)
+			// the invalid config is rejected and the current chains are kept
+			hb := keeper.GetHostedBlockchains()
+			assert.True(t, hb.Contains(ethereum))
+			assert.False(t, hb.Contains(bitcoin))
+		})
+	}
+	hb, err := keeper.SetHostedBlockchains(map[string]types.HostedBlockchain{bitcoin: {ID: bitcoin, URL: "https://www.google.com:443"}})
+	assert.Nil(t, err)
+	assert.True(t, hb.Contains(bitcoin))
+	assert.False(t, hb.Contains(ethereum))
+}
@@ -13,16 +13,29 @@ import (
 	"github.com/tendermint/tendermint/rpc/client"
 )
 
-// "SendClaimTx" - Automatically sends a claim of work/challenge based on relays or challenges stored.
-func (k Keeper) SendClaimTx(ctx sdk.Ctx, keeper Keeper, n client.Client, claimTx func(pk crypto.PrivateKey, cliCtx util.CLIContext, txBuilder auth.TxBuilder, header pc.SessionHeader, totalProofs int64, root pc.HashRange, evidenceType pc.EvidenceType) (*sdk.TxResponse, error)) {
-	// get the private val key (main) account from the keybase
-	kp, err := k.GetPKFromFile(ctx)
-	if err != nil {
-		ctx.Logger().Error(fmt.Sprintf("an error occured retrieving the private key from file for the claim transaction:\n%s", err.Error()))
-		return
+// "ClaimTxFn" - Sends the claim transaction of a servicer
+type ClaimTxFn func(pk crypto.PrivateKey, cliCtx util.CLIContext, txBuilder auth.TxBuilder, header pc.SessionHeader, totalProofs int64, root pc.HashRange, evidenceType pc.EvidenceType) (*sdk.TxResponse, error)
+
+// "BatchClaimTxFn" - Sends several claims of a servicer in a single batched claim transaction
+type BatchClaimTxFn func(cliCtx util.CLIContext, txBuilder auth.TxBuilder, claims []pc.MsgClaim) (*sdk.TxResponse, error)
+
+// "SendClaimTx" - Automatically sends a claim of work/challenge based on relays or challenges stored, for every servicer hosted by this node.
+func (k Keeper) SendClaimTx(ctx sdk.Ctx, keeper Keeper, n client.Client, claimTx ClaimTxFn, batchClaimTx BatchClaimTxFn) {
+	for _, kp := range k.GetServicerKeys(ctx) {
+		k.sendClaimTx(ctx, keeper, n, kp, claimTx, batchClaimTx)
 	}
+}
+
+// "sendClaimTx" - Sends a claim of work/challenge for every piece of evidence of the servicer
+func (k Keeper) sendClaimTx(ctx sdk.Ctx, keeper Keeper, n client.Client, kp crypto.PrivateKey, claimTx ClaimTxFn, batchClaimTx BatchClaimTxFn) {
+	addr := sdk.Address(kp.PublicKey().Address())
+	// once batched claims are active, the claims sent for the first time are batched, the retries are sent on their own
+	// so a claim that keeps failing doesn't fail the whole batch
+	batchActive := k.Cdc.IsAfterNamedFeatureActivationHeight(ctx.BlockHeight(), codec.BatchClaimKey)
+	var batch []pc.MsgClaim
+	var batchSubmissions []pc.Submission
 	// retrieve the iterator to go through each piece of evidence in storage
-	iter := pc.EvidenceIterator()
+	iter := pc.EvidenceIterator(addr)
 	defer iter.Close()
 	// loop through each evidence
 	for ; iter.Valid(); iter.Next() {
@@ -42,7 +55,7 @@ func (k Keeper) SendClaimTx(ctx sdk.Ctx, keeper Keeper, n client.Client, claimTx
 		}
 		// if the evidence length is less than minimum, it would not satisfy our merkle tree needs
 		if evidence.NumOfProofs < keeper.MinimumNumberOfProofs(sessionCtx) {
-			if err := pc.DeleteEvidence(evidence.SessionHeader, evidenceType); err != nil {
+			if err := pc.DeleteEvidence(addr, evidence.SessionHeader, evidenceType); err != nil {
 				ctx.Logger().Debug(err.Error())
 			}
 			continue
@@ -54,37 +67,99 @@ func (k Keeper) SendClaimTx(ctx sdk.Ctx, keeper Keeper, n client.Client, claimTx
 		// if the blockchain in the evidence is not supported then delete it because nodes don't get paid/challenged for unsupported blockchains
 		if !k.IsPocketSupportedBlockchain(sessionCtx.WithBlockHeight(evidence.SessionHeader.SessionBlockHeight), evidence.SessionHeader.Chain) {
 			ctx.Logger().Info(fmt.Sprintf("claim for %s blockchain isn't pocket supported, so will not send. Deleting evidence\n", evidence.SessionHeader.Chain))
-			if err := pc.DeleteEvidence(evidence.SessionHeader, evidenceType); err != nil {
+			if err := pc.DeleteEvidence(addr, evidence.SessionHeader, evidenceType); err != nil {
 				ctx.Logger().Debug(err.Error())
 			}
 			continue
 		}
+		submission := pc.NewSubmission(addr, evidence.SessionHeader, evidenceType, pc.ClaimSubmission)
 		// check the current state to see if the unverified evidence has already been sent and processed (if so, then skip this evidence)
-		if _, found := k.GetClaim(ctx, sdk.Address(kp.PublicKey().Address()), evidence.SessionHeader, evidenceType); found {
+		if _, found := k.GetClaim(ctx, addr, evidence.SessionHeader, evidenceType); found {
+			pc.MarkSubmissionIncluded(submission, ctx.BlockHeight())
 			continue
 		}
 		// if the claim is mature, delete it because we cannot submit a mature claim
 		if k.ClaimIsMature(ctx, evidence.SessionBlockHeight) {
-			if err := pc.DeleteEvidence(evidence.SessionHeader, evidenceType); err != nil {
+			if err := pc.DeleteEvidence(addr, evidence.SessionHeader, evidenceType); err != nil {
 				ctx.Logger().Debug(err.Error())
 			}
 			continue
 		}
+		// schedule the claim within the submission window and wait for its turn (or its retry)
+		deadline := evidence.SessionBlockHeight + k.ClaimSubmissionWindow(ctx)*k.BlocksPerSession(ctx) - 1
+		tracked := pc.ScheduleSubmission(submission, ctx.BlockHeight(), deadline)
+		batched := batchActive && tracked.Attempts == 0
+		if !pc.IsSubmissionDue(submission, ctx.BlockHeight()) || (!batched && !pc.ReserveSubmission(ctx.BlockHeight())) {
+			continue
+		}
 		app, found := k.GetAppFromPublicKey(sessionCtx, evidence.ApplicationPubKey)
 		if !found {
 			ctx.Logger().Error(fmt.Sprintf("an error occurred creating the claim transaction with app %s not found with evidence %v", evidence.ApplicationPubKey, evidence))
+			continue
 		}
 		// generate the merkle root for this evidence
-		root := evidence.GenerateMerkleRoot(evidence.SessionHeader.SessionBlockHeight, pc.MaxPossibleRelays(app, k.SessionNodeCount(sessionCtx)).Int64())
+		root := evidence.GenerateMerkleRoot(addr, evidence.SessionHeader.SessionBlockHeight, pc.MaxPossibleRelays(app, k.SessionNodeCount(sessionCtx)).Int64())
+		if batched {
+			batch = append(batch, pc.MsgClaim{
+				SessionHeader: evidence.SessionHeader,
+				TotalProofs:   evidence.NumOfProofs,
+				MerkleRoot:    root,
+				FromAddress:   addr,
+				EvidenceType:  evidenceType,
+			})
+			batchSubmissions = append(batchSubmissions, submission)
+			continue
+		}
 		// generate the auto txbuilder and clictx
 		txBuilder, cliCtx, err := newTxBuilderAndCliCtx(ctx, &pc.MsgClaim{}, n, kp, k)
 		if err != nil {
+			pc.MarkSubmissionSent(submission, ctx.BlockHeight(), nil, err)
 			ctx.Logger().Error(fmt.Sprintf("an error occured creating the tx builder for the claim tx:\n%s", err.Error()))
 			return
 		}
 		// send in the evidence header, the total relays completed, and the merkle root (ensures data integrity)
-		if _, err := claimTx(kp, cliCtx, txBuilder, evidence.SessionHeader, evidence.NumOfProofs, root, evidenceType); err != nil {
+		res, err := claimTx(kp, cliCtx, txBuilder, evidence.SessionHeader, evidence.NumOfProofs, root, evidenceType)
+		pc.MarkSubmissionSent(submission, ctx.BlockHeight(), res, err)
+		if err != nil {
 			ctx.Logger().Error(fmt.Sprintf("an error occured executing the claim transaciton: \n%s", err.Error()))
+			continue
+		}
+		if evidenceType == pc.RelayEvidence && res != nil {
+			pc.RecordClaim(addr, evidence.SessionHeader, evidence.NumOfProofs, res.TxHash, ctx.BlockHeight())
+		}
+	}
+	k.sendBatchClaimTx(ctx, n, kp, batch, batchSubmissions, batchClaimTx)
+}
+
+// "sendBatchClaimTx" - Sends the claims of the servicer in batched claim transactions of up to MaxBatchEntries claims
+func (k Keeper) sendBatchClaimTx(ctx sdk.Ctx, n client.Client, kp crypto.PrivateKey, claims []pc.MsgClaim, submissions []pc.Submission, batchClaimTx BatchClaimTxFn) {
+	addr := sdk.Address(kp.PublicKey().Address())
+	for start := 0; start < len(claims); start += pc.MaxBatchEntries {
+		end := start + pc.MaxBatchEntries
+		if end > len(claims) {
+			end = len(claims)
+		}
+		if !pc.ReserveSubmission(ctx.BlockHeight()) {
+			return
+		}
+		// generate the auto txbuilder and clictx
+		txBuilder, cliCtx, err := newTxBuilderAndCliCtx(ctx, &pc.MsgBatchClaim{}, n, kp, k)
+		if err != nil {
+			for _, submission := range submissions[start:end] {
+				pc.MarkSubmissionSent(submission, ctx.BlockHeight(), nil, err)
+			}
+			ctx.Logger().Error(fmt.Sprintf("an error occured creating the tx builder for the batch claim tx:\n%s", err.Error()))
+			return
+		}
+		res, err := batchClaimTx(cliCtx, txBuilder, claims[start:end])
+		for i := start; i < end; i++ {
+			pc.MarkSubmissionSent(submissions[i], ctx.BlockHeight(), res, err)
+			if err == nil && res != nil && claims[i].EvidenceType == pc.RelayEvidence {
+				pc.RecordClaim(addr, claims[i].SessionHeader, claims[i].TotalProofs, res.TxHash, ctx.BlockHeight())
+			}
+		}
+		if err != nil {
+			ctx.Logger().Error(fmt.Sprintf("an error occured executing the batch claim transaciton: \n%s", err.Error()))
 		}
 	}
 }
@@ -0,0 +1,74 @@
+package keeper
+
+import (
+	sdk "github.com/pokt-network/pocket-core/types"
+	pc "github.com/pokt-network/pocket-core/x/pocketcore/types"
+)
+
+// "EstimateEarnings" - Projects the rewards of a servicer with the params of the context. The rewards of the pending
+// claims and of the evidence not claimed yet are estimated for a node address, the stake is the stake of the node unless
+// a (hypothetical) stake is passed. The relays are projected with the stake whether or not an address is passed
+func (k Keeper) EstimateEarnings(ctx sdk.Ctx, address sdk.Address, stake sdk.BigInt, relays sdk.BigInt) (pc.EarningsEstimate, sdk.Error) {
+	claimed, unclaimed := sdk.ZeroInt(), sdk.ZeroInt()
+	if address != nil {
+		node, found := k.GetNode(ctx, address)
+		if !found {
+			return pc.EarningsEstimate{}, pc.NewNodeNotFoundErr(pc.ModuleName)
+		}
+		if !stake.IsPositive() {
+			stake = node.GetTokens()
+		}
+		claimed, unclaimed = k.pendingRelays(ctx, address)
+	}
+	if !stake.IsPositive() {
+		return pc.EarningsEstimate{}, pc.NewEstimateStakeError(pc.ModuleName)
+	}
+	if relays.IsNegative() {
+		relays = sdk.ZeroInt()
+	}
+	estimate := pc.EarningsEstimate{
+		Height:     ctx.BlockHeight(),
+		Stake:      stake,
+		Weight:     k.posKeeper.ServicerStakeWeight(ctx, stake),
+		Claims:     k.posKeeper.EstimateReward(ctx, claimed, stake),
+		Evidence:   k.posKeeper.EstimateReward(ctx, unclaimed, stake),
+		Projection: k.posKeeper.EstimateReward(ctx, relays, stake),
+	}
+	if address != nil {
+		estimate.Address = address.String()
+	}
+	estimate.Total = estimate.Claims.Add(estimate.Evidence).Add(estimate.Projection)
+	return estimate, nil
+}
+
+// "pendingRelays" - Returns the relays of the pending claims of the node and the relays of its local evidence that
+// will be claimed (hosted servicers only)
+func (k Keeper) pendingRelays(ctx sdk.Ctx, address sdk.Address) (claimed, unclaimed sdk.BigInt) {
+	claimed, unclaimed = sdk.ZeroInt(), sdk.ZeroInt()
+	claims, err := k.GetClaims(ctx, address)
+	if err == nil {
+		for _, claim := range claims {
+			// challenges are burned, not minted
+			if claim.EvidenceType == pc.RelayEvidence {
+				claimed = claimed.Add(sdk.NewInt(claim.TotalProofs))
+			}
+		}
+	}
+	if !k.IsSelfServicer(ctx, address) {
+		return
+	}
+	iter := pc.EvidenceIterator(address)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		evidence := iter.Value()
+		if evidence.EvidenceType != pc.RelayEvidence || evidence.NumOfProofs < k.MinimumNumberOfProofs(ctx) {
+			continue
+		}
+		// the claimed evidence is already counted, the evidence can't be claimed once the claim would be mature
+		if _, found := k.GetClaim(ctx, address, evidence.SessionHeader, evidence.EvidenceType); found || k.ClaimIsMature(ctx, evidence.SessionBlockHeight) {
+			continue
+		}
+		unclaimed = unclaimed.Add(sdk.NewInt(evidence.NumOfProofs))
+	}
+	return
+}
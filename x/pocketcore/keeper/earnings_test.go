@@ -0,0 +1,61 @@
+package keeper
+
+import (
+	"encoding/hex"
+	"testing"
+
+	sdk "github.com/pokt-network/pocket-core/types"
+	"github.com/pokt-network/pocket-core/x/pocketcore/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestKeeper_EstimateEarnings(t *testing.T) {
+	ctx, _, _, _, keeper, _, _ := createTestInput(t, false)
+	// the evidence of the first session can still be claimed
+	ctx = ctx.WithBlockHeight(1)
+	types.ClearEvidence()
+	defer types.ClearEvidence()
+	pk, err := keeper.GetPKFromFile(ctx)
+	assert.Nil(t, err)
+	servicer := sdk.Address(pk.PublicKey().Address())
+	header := types.SessionHeader{
+		ApplicationPubKey:  getTestApplication().PublicKey.RawString(),
+		Chain:              hex.EncodeToString([]byte{01}),
+		SessionBlockHeight: 1,
+	}
+	clientKey := getRandomPrivateKey()
+	for j := 0; j < 5; j++ {
+		proof := createProof(getTestApplicationPrivateKey(), clientKey, pk.PublicKey(), header.Chain, j)
+		types.SetProof(servicer, header, types.RelayEvidence, proof, sdk.NewInt(100000))
+	}
+	claimed := header
+	claimed.ApplicationPubKey = getRandomPubKey().RawString()
+	err = keeper.SetClaim(ctx, types.MsgClaim{
+		SessionHeader:    claimed,
+		MerkleRoot:       types.HashRange{Hash: []byte("root")},
+		TotalProofs:      10,
+		FromAddress:      servicer,
+		EvidenceType:     types.RelayEvidence,
+		ExpirationHeight: 100,
+	})
+	assert.Nil(t, err)
+	// the test nodes are staked without tokens, so a stake is needed
+	_, er := keeper.EstimateEarnings(ctx, servicer, sdk.ZeroInt(), sdk.NewInt(100))
+	assert.Equal(t, types.NewEstimateStakeError(types.ModuleName).Code(), er.Code())
+	stake := sdk.NewInt(15000000000)
+	estimate, er := keeper.EstimateEarnings(ctx, servicer, stake, sdk.NewInt(100))
+	assert.Nil(t, er)
+	assert.True(t, estimate.Stake.Equal(stake))
+	assert.True(t, estimate.Claims.Relays.Equal(sdk.NewInt(10)))
+	assert.True(t, estimate.Evidence.Relays.Equal(sdk.NewInt(5)))
+	assert.True(t, estimate.Projection.Relays.Equal(sdk.NewInt(100)))
+	assert.True(t, estimate.Total.Relays.Equal(sdk.NewInt(115)))
+	assert.Equal(t, keeper.posKeeper.EstimateReward(ctx, sdk.NewInt(115), stake), estimate.Total)
+	// a hypothetical stake without a node only projects the relays
+	estimate, er = keeper.EstimateEarnings(ctx, nil, stake, sdk.NewInt(100))
+	assert.Nil(t, er)
+	assert.True(t, estimate.Claims.Relays.IsZero())
+	assert.True(t, estimate.Total.Minted.IsPositive())
+	_, er = keeper.EstimateEarnings(ctx, sdk.Address(getRandomPubKey().Address()), sdk.ZeroInt(), sdk.NewInt(100))
+	assert.NotNil(t, er)
+}
@@ -34,6 +34,30 @@ func (k Keeper) GetSelfPrivKey(ctx sdk.Ctx) (crypto.PrivateKey, sdk.Error) {
 	return pk, nil
 }
 
+// "GetServicerKeys" - Returns the private keys of every servicer hosted by this node, the validator key first. The keys
+// are kept in memory since they're loaded, the slice must not be modified
+func (k Keeper) GetServicerKeys(ctx sdk.Ctx) []crypto.PrivateKey {
+	keys, err := pc.GetHostedServicerKeys()
+	if err != nil {
+		ctx.Logger().Error("Unable to retrieve the private validator key: " + err.Error())
+	}
+	return keys
+}
+
+// "GetServicerPrivKey" - Returns the private key of the servicer hosted by this node with the public key (hex),
+// relays for any other servicer are handled with the validator key
+func (k Keeper) GetServicerPrivKey(ctx sdk.Ctx, servicerPubKey string) (crypto.PrivateKey, sdk.Error) {
+	if pk, found := pc.GetServicerKey(servicerPubKey); found {
+		return pk, nil
+	}
+	return k.GetSelfPrivKey(ctx)
+}
+
+// "IsSelfServicer" - Returns true if the address belongs to a servicer hosted by this node
+func (k Keeper) IsSelfServicer(ctx sdk.Ctx, address sdk.Address) bool {
+	return pc.IsHostedServicer(address)
+}
+
 // "GetSelfNode" - Gets self node (private val key) from the world state
 func (k Keeper) GetSelfNode(ctx sdk.Ctx) (node exported.ValidatorI, er sdk.Error) {
 	// get the node from the world state
@@ -15,15 +15,28 @@ import (
 	"reflect"
 )
 
-// auto sends a proof transaction for the claim
-func (k Keeper) SendProofTx(ctx sdk.Ctx, n client.Client, proofTx func(cliCtx util.CLIContext, txBuilder auth.TxBuilder, merkleProof pc.MerkleProof, leafNode pc.Proof, evidenceType pc.EvidenceType) (*sdk.TxResponse, error)) {
-	kp, err := k.GetPKFromFile(ctx)
-	if err != nil {
-		ctx.Logger().Error(fmt.Sprintf("an error occured retrieving the pk from the file for the Proof Transaction:\n%v", err))
-		return
+// "ProofTxFn" - Sends the proof transaction of a servicer, the private key is in the cli context
+type ProofTxFn func(cliCtx util.CLIContext, txBuilder auth.TxBuilder, merkleProof pc.MerkleProof, leafNode pc.Proof, evidenceType pc.EvidenceType) (*sdk.TxResponse, error)
+
+// "BatchProofTxFn" - Sends several proofs of a servicer in a single batched proof transaction
+type BatchProofTxFn func(cliCtx util.CLIContext, txBuilder auth.TxBuilder, proofs []pc.MsgProof) (*sdk.TxResponse, error)
+
+// auto sends a proof transaction for the claims of every servicer hosted by this node
+func (k Keeper) SendProofTx(ctx sdk.Ctx, n client.Client, proofTx ProofTxFn, batchProofTx BatchProofTxFn) {
+	for _, kp := range k.GetServicerKeys(ctx) {
+		k.sendProofTx(ctx, n, kp, proofTx, batchProofTx)
 	}
-	// get the self address
+}
+
+// auto sends a proof transaction for the mature claims of the servicer
+func (k Keeper) sendProofTx(ctx sdk.Ctx, n client.Client, kp crypto.PrivateKey, proofTx ProofTxFn, batchProofTx BatchProofTxFn) {
+	// get the servicer address
 	addr := sdk.Address(kp.PublicKey().Address())
+	// once batched proofs are active, the proofs sent for the first time are batched, the retries are sent on their own
+	// so a proof that keeps failing doesn't fail the whole batch
+	batchActive := k.Cdc.IsAfterNamedFeatureActivationHeight(ctx.BlockHeight(), codec.BatchClaimKey)
+	var batch []pc.MsgProof
+	var batchSubmissions []pc.Submission
 	// get all mature (waiting period has passed) claims for your address
 	claims, err := k.GetMatureClaims(ctx, addr)
 	if err != nil {
@@ -33,32 +46,45 @@ func (k Keeper) SendProofTx(ctx sdk.Ctx, n client.Client, proofTx func(cliCtx ut
 	// for every claim of the mature set
 	for _, claim := range claims {
 		// check to see if evidence is stored in cache
-		evidence, err := pc.GetEvidence(claim.SessionHeader, claim.EvidenceType, sdk.ZeroInt())
+		evidence, err := pc.GetEvidence(addr, claim.SessionHeader, claim.EvidenceType, sdk.ZeroInt())
 		if err != nil || evidence.Proofs == nil || len(evidence.Proofs) == 0 {
 			ctx.Logger().Info(fmt.Sprintf("the evidence object for evidence is not found, ignoring pending claim for app: %s, at sessionHeight: %d", claim.SessionHeader.ApplicationPubKey, claim.SessionHeader.SessionBlockHeight))
 			continue
 		}
 		if ctx.BlockHeight()-claim.SessionHeader.SessionBlockHeight > int64(pc.GlobalPocketConfig.MaxClaimAgeForProofRetry) {
-			err := pc.DeleteEvidence(claim.SessionHeader, claim.EvidenceType)
+			err := pc.DeleteEvidence(addr, claim.SessionHeader, claim.EvidenceType)
 			ctx.Logger().Error(fmt.Sprintf("deleting evidence older than MaxClaimAgeForProofRetry"))
 			if err != nil {
 				ctx.Logger().Error(fmt.Sprintf("unable to delete evidence that is older than 32 blocks: %s", err.Error()))
 			}
 			continue
 		}
-		if !evidence.IsSealed() {
-			err := pc.DeleteEvidence(claim.SessionHeader, claim.EvidenceType)
+		if !pc.IsEvidenceSealed(addr, evidence) {
+			err := pc.DeleteEvidence(addr, claim.SessionHeader, claim.EvidenceType)
 			ctx.Logger().Error(fmt.Sprintf("evidence is not sealed, could cause a relay leak:"))
 			if err != nil {
 				ctx.Logger().Error(fmt.Sprintf("could not delete evidence is not sealed, could cause a relay leak: %s", err.Error()))
 			}
+			continue
 		}
 		if evidence.NumOfProofs != claim.TotalProofs {
-			err := pc.DeleteEvidence(claim.SessionHeader, claim.EvidenceType)
+			err := pc.DeleteEvidence(addr, claim.SessionHeader, claim.EvidenceType)
 			ctx.Logger().Error(fmt.Sprintf("evidence num of proofs does not equal claim total proofs... possible relay leak"))
 			if err != nil {
 				ctx.Logger().Error(fmt.Sprintf("evidence num of proofs does not equal claim total proofs... possible relay leak: %s", err.Error()))
 			}
+			continue
+		}
+		// schedule the proof before the claim expires and wait for its turn (or its retry)
+		submission := pc.NewSubmission(addr, claim.SessionHeader, claim.EvidenceType, pc.ProofSubmission)
+		deadline := claim.SessionHeader.SessionBlockHeight + int64(pc.GlobalPocketConfig.MaxClaimAgeForProofRetry)
+		if claim.ExpirationHeight > 0 && claim.ExpirationHeight-1 < deadline {
+			deadline = claim.ExpirationHeight - 1
+		}
+		tracked := pc.ScheduleSubmission(submission, ctx.BlockHeight(), deadline)
+		batched := batchActive && tracked.Attempts == 0
+		if !pc.IsSubmissionDue(submission, ctx.BlockHeight()) || (!batched && !pc.ReserveSubmission(ctx.BlockHeight())) {
+			continue
 		}
 		// get the session context
 		sessionCtx, err := ctx.PrevCtx(claim.SessionHeader.SessionBlockHeight)
@@ -69,12 +95,14 @@ func (k Keeper) SendProofTx(ctx sdk.Ctx, n client.Client, proofTx func(cliCtx ut
 		// generate the needed pseudorandom index using the information found in the first transaction
 		index, err := k.getPseudorandomIndex(ctx, claim.TotalProofs, claim.SessionHeader, sessionCtx)
 		if err != nil {
+			pc.MarkSubmissionSent(submission, ctx.BlockHeight(), nil, err)
 			ctx.Logger().Error(err.Error())
 			continue
 		}
 		app, found := k.GetAppFromPublicKey(sessionCtx, claim.SessionHeader.ApplicationPubKey)
 		if !found {
 			ctx.Logger().Error(fmt.Sprintf("an error occurred creating the proof transaction with app %s not found with evidence %v", evidence.ApplicationPubKey, evidence))
+			continue
 		}
 		// get the merkle proof object for the pseudorandom index
 		mProof, leaf := evidence.GenerateMerkleProof(claim.SessionHeader.SessionBlockHeight, int(index), pc.MaxPossibleRelays(app, k.SessionNodeCount(sessionCtx)).Int64())
@@ -83,22 +111,69 @@ func (k Keeper) SendProofTx(ctx sdk.Ctx, n client.Client, proofTx func(cliCtx ut
 			// validate level count on claim by total relays
 			levelCount := len(mProof.HashRanges)
 			if levelCount != int(math.Ceil(math.Log2(float64(claim.TotalProofs)))) {
+				pc.MarkSubmissionSent(submission, ctx.BlockHeight(), nil, fmt.Errorf("produced invalid proof, level count"))
 				ctx.Logger().Error(fmt.Sprintf("produced invalid proof for pending claim for app: %s, at sessionHeight: %d, level count", claim.SessionHeader.ApplicationPubKey, claim.SessionHeader.SessionBlockHeight))
 				continue
 			}
 			if isValid, _ := mProof.Validate(claim.SessionHeader.SessionBlockHeight, claim.MerkleRoot, leaf, levelCount); !isValid {
+				pc.MarkSubmissionSent(submission, ctx.BlockHeight(), nil, fmt.Errorf("produced invalid proof"))
 				ctx.Logger().Error(fmt.Sprintf("produced invalid proof for pending claim for app: %s, at sessionHeight: %d", claim.SessionHeader.ApplicationPubKey, claim.SessionHeader.SessionBlockHeight))
 				continue
 			}
 		}
+		if batched {
+			batch = append(batch, pc.MsgProof{MerkleProof: mProof, Leaf: leaf, EvidenceType: evidence.EvidenceType})
+			batchSubmissions = append(batchSubmissions, submission)
+			continue
+		}
 		// generate the auto txbuilder and clictx
 		txBuilder, cliCtx, err := newTxBuilderAndCliCtx(ctx, &pc.MsgProof{}, n, kp, k)
 		if err != nil {
+			pc.MarkSubmissionSent(submission, ctx.BlockHeight(), nil, err)
 			ctx.Logger().Error(fmt.Sprintf("an error occured in the transaction process of the Proof Transaction:\n%v", err))
 			return
 		}
 		// send the proof TX
-		_, err = proofTx(cliCtx, txBuilder, mProof, leaf, evidence.EvidenceType)
+		res, err := proofTx(cliCtx, txBuilder, mProof, leaf, evidence.EvidenceType)
+		pc.MarkSubmissionSent(submission, ctx.BlockHeight(), res, err)
+		if err != nil {
+			ctx.Logger().Error(err.Error())
+			continue
+		}
+		if evidence.EvidenceType == pc.RelayEvidence && res != nil {
+			pc.RecordProof(addr, claim.SessionHeader, res.TxHash, ctx.BlockHeight())
+		}
+	}
+	k.sendBatchProofTx(ctx, n, kp, batch, batchSubmissions, batchProofTx)
+}
+
+// "sendBatchProofTx" - Sends the proofs of the servicer in batched proof transactions of up to MaxBatchEntries proofs
+func (k Keeper) sendBatchProofTx(ctx sdk.Ctx, n client.Client, kp crypto.PrivateKey, proofs []pc.MsgProof, submissions []pc.Submission, batchProofTx BatchProofTxFn) {
+	addr := sdk.Address(kp.PublicKey().Address())
+	for start := 0; start < len(proofs); start += pc.MaxBatchEntries {
+		end := start + pc.MaxBatchEntries
+		if end > len(proofs) {
+			end = len(proofs)
+		}
+		if !pc.ReserveSubmission(ctx.BlockHeight()) {
+			return
+		}
+		// generate the auto txbuilder and clictx
+		txBuilder, cliCtx, err := newTxBuilderAndCliCtx(ctx, &pc.MsgBatchProof{}, n, kp, k)
+		if err != nil {
+			for _, submission := range submissions[start:end] {
+				pc.MarkSubmissionSent(submission, ctx.BlockHeight(), nil, err)
+			}
+			ctx.Logger().Error(fmt.Sprintf("an error occured in the transaction process of the Batch Proof Transaction:\n%v", err))
+			return
+		}
+		res, err := batchProofTx(cliCtx, txBuilder, proofs[start:end])
+		for i := start; i < end; i++ {
+			pc.MarkSubmissionSent(submissions[i], ctx.BlockHeight(), res, err)
+			if err == nil && res != nil && proofs[i].EvidenceType == pc.RelayEvidence {
+				pc.RecordProof(addr, proofs[i].Leaf.SessionHeader(), res.TxHash, ctx.BlockHeight())
+			}
+		}
 		if err != nil {
 			ctx.Logger().Error(err.Error())
 		}
@@ -237,21 +312,24 @@ func newTxBuilderAndCliCtx(ctx sdk.Ctx, msg sdk.ProtoMsg, n client.Client, key c
 	fromAddr := sdk.Address(key.PublicKey().Address())
 	// create a client context for sending
 	cliCtx = util.NewCLIContext(n, fromAddr, "").WithCodec(k.Cdc).WithHeight(ctx.BlockHeight())
-	pk, err := k.GetPKFromFile(ctx)
-	if err != nil {
-		return txBuilder, cliCtx, err
-	}
-	cliCtx.PrivateKey = pk
+	cliCtx.PrivateKey = key
 	// broadcast synchronously
 	cliCtx.BroadcastMode = util.BroadcastSync
+	// check the fee amount
+	fee := k.authKeeper.GetFee(ctx, msg)
+	fees := sdk.NewCoins(sdk.NewCoin(k.posKeeper.StakeDenom(ctx), fee))
+	// the fees are paid by the fee payer of the node if any
+	feePayer := k.submissionFeePayer(ctx, fromAddr, fees)
+	payer := fromAddr
+	if feePayer != nil {
+		payer = feePayer
+	}
 	// get the account to ensure balance
 	// retrieve the account for a balance check (and ensure it exists)
-	account := k.authKeeper.GetAccount(ctx, fromAddr)
+	account := k.authKeeper.GetAccount(ctx, payer)
 	if account == nil {
-		return txBuilder, cliCtx, fmt.Errorf("unable to locate an account at address: %s", fromAddr)
+		return txBuilder, cliCtx, fmt.Errorf("unable to locate an account at address: %s", payer)
 	}
-	// check the fee amount
-	fee := k.authKeeper.GetFee(ctx, msg)
 	if account.GetCoins().AmountOf(k.posKeeper.StakeDenom(ctx)).LT(fee) {
 		return txBuilder, cliCtx, fmt.Errorf("insufficient funds for the auto %s transaction: the fee needed is %v ", msg.Type(), fee)
 	}
@@ -261,7 +339,30 @@ func newTxBuilderAndCliCtx(ctx sdk.Ctx, msg sdk.ProtoMsg, n client.Client, key c
 		auth.DefaultTxDecoder(k.Cdc),
 		ctx.ChainID(),
 		"",
-		sdk.NewCoins(sdk.NewCoin(k.posKeeper.StakeDenom(ctx), fee)),
-	)
+		fees,
+	).WithFeePayer(feePayer)
 	return
 }
+
+// "submissionFeePayer" - Returns the fee payer of the node config if its fee allowance to the servicer covers the fees,
+// nil if the servicer pays its own fees
+func (k Keeper) submissionFeePayer(ctx sdk.Ctx, servicer sdk.Address, fees sdk.Coins) sdk.Address {
+	if pc.GlobalPocketConfig.SubmissionFeePayer == "" || !k.Cdc.IsAfterNamedFeatureActivationHeight(ctx.BlockHeight(), codec.FeeGrantKey) {
+		return nil
+	}
+	feePayer, err := sdk.AddressFromHex(pc.GlobalPocketConfig.SubmissionFeePayer)
+	if err != nil {
+		ctx.Logger().Error(fmt.Sprintf("invalid submission fee payer %s: %s", pc.GlobalPocketConfig.SubmissionFeePayer, err.Error()))
+		return nil
+	}
+	allowance, found := k.authKeeper.GetFeeAllowance(ctx, feePayer, servicer)
+	if !found || allowance.IsExpired(ctx.BlockHeight()) {
+		ctx.Logger().Info(fmt.Sprintf("the submission fee payer %s has no active fee allowance to %s, the servicer pays its fees", feePayer, servicer))
+		return nil
+	}
+	if !allowance.SpendLimit.Empty() && !allowance.SpendLimit.IsAllGTE(fees) {
+		ctx.Logger().Info(fmt.Sprintf("the fee allowance of the submission fee payer %s to %s doesn't cover the fees %s, the servicer pays its fees", feePayer, servicer, fees))
+		return nil
+	}
+	return feePayer
+}
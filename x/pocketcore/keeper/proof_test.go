@@ -19,12 +19,12 @@ func TestKeeper_ValidateProof(t *testing.T) { // happy path only todo
 	ctx, _, _, _, keeper, keys, _ := createTestInput(t, false)
 	types.ClearEvidence()
 	npk, header, _ := simulateRelays(t, keeper, &ctx, relaysDone)
-	evidence, err := types.GetEvidence(header, types.RelayEvidence, sdk.NewInt(1000))
+	evidence, err := types.GetEvidence(sdk.Address(npk.Address()), header, types.RelayEvidence, sdk.NewInt(1000))
 	if err != nil {
 		t.Fatalf("Set evidence not found")
 	}
-	root := evidence.GenerateMerkleRoot(0, maxRelays)
-	_, totalRelays := types.GetTotalProofs(header, types.RelayEvidence, sdk.NewInt(1000))
+	root := evidence.GenerateMerkleRoot(sdk.Address(npk.Address()), 0, maxRelays)
+	_, totalRelays := types.GetTotalProofs(sdk.Address(npk.Address()), header, types.RelayEvidence, sdk.NewInt(1000))
 	assert.Equal(t, totalRelays, int64(relaysDone))
 	// generate a claim message
 	claimMsg := types.MsgClaim{
@@ -49,7 +49,7 @@ func TestKeeper_ValidateProof(t *testing.T) { // happy path only todo
 	assert.Nil(t, er)
 	merkleProofs, _ := evidence.GenerateMerkleProof(0, int(neededLeafIndex), maxRelays)
 	// get leaf and cousin node
-	leafNode := types.GetProof(header, types.RelayEvidence, neededLeafIndex)
+	leafNode := types.GetProof(sdk.Address(npk.Address()), header, types.RelayEvidence, neededLeafIndex)
 	// create proof message
 	proofMsg := types.MsgProof{
 		MerkleProof:  merkleProofs,
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/pokt-network/pocket-core/crypto"
 	sdk "github.com/pokt-network/pocket-core/types"
 	pc "github.com/pokt-network/pocket-core/x/pocketcore/types"
 )
@@ -12,10 +13,83 @@ import (
 // "HandleRelay" - Handles an api (read/write) request to a non-native (external) blockchain
 func (k Keeper) HandleRelay(ctx sdk.Ctx, relay pc.Relay) (*pc.RelayResponse, sdk.Error) {
 	relayTimeStart := time.Now()
+	// validate the relay and store the proof
+	pk, err := k.validateAndStoreRelay(ctx, &relay)
+	if err != nil {
+		return nil, err
+	}
+	selfAddr := sdk.Address(pk.PublicKey().Address())
+	// serve idempotent requests from the response cache if the chain enables it, otherwise attempt to execute
+	hostedBlockchains := k.GetHostedBlockchains()
+	respPayload, cached := pc.GetCachedResponse(hostedBlockchains, relay)
+	if !cached {
+		respPayload, err = relay.Execute(hostedBlockchains)
+		if err != nil {
+			ctx.Logger().Error(fmt.Sprintf("could not send relay with error: %s", err.Error()))
+			return nil, err
+		}
+		pc.CacheResponse(hostedBlockchains, relay, respPayload)
+	}
+	// generate response object
+	resp := &pc.RelayResponse{
+		Response: respPayload,
+		Proof:    relay.Proof,
+	}
+	// sign the response
+	sig, er := pk.Sign(resp.Hash())
+	if er != nil {
+		ctx.Logger().Error(
+			fmt.Sprintf("could not sign response for address: %s with hash: %v, with error: %s",
+				selfAddr.String(), resp.HashString(), er.Error()),
+		)
+		return nil, pc.NewKeybaseError(pc.ModuleName, er)
+	}
+	// attach the signature in hex to the response
+	resp.Signature = hex.EncodeToString(sig)
+	// track the relay time
+	relayTime := time.Since(relayTimeStart)
+	// add to metrics
+	pc.GlobalServiceMetric().AddRelayTimingFor(relay.Proof.Blockchain, float64(relayTime.Milliseconds()))
+	pc.GlobalServiceMetric().AddRelayFor(relay.Proof.Blockchain, relay.Proof.Token.ApplicationPublicKey)
+	return resp, nil
+}
+
+// "HandleWebsocketRelay" - Handles a relay received over a websocket connection; the proof is stored but execution is left to the caller
+func (k Keeper) HandleWebsocketRelay(ctx sdk.Ctx, relay pc.Relay) sdk.Error {
+	_, err := k.validateAndStoreRelay(ctx, &relay)
+	if err != nil {
+		return err
+	}
+	// add to metrics
+	pc.GlobalServiceMetric().AddRelayFor(relay.Proof.Blockchain, relay.Proof.Token.ApplicationPublicKey)
+	return nil
+}
+
+// "SignRelayResponse" - Signs a relay response with the private key of the servicer of the proof
+func (k Keeper) SignRelayResponse(ctx sdk.Ctx, resp *pc.RelayResponse) sdk.Error {
+	pk, err := k.GetServicerPrivKey(ctx, resp.Proof.ServicerPubKey)
+	if err != nil {
+		return err
+	}
+	sig, er := pk.Sign(resp.Hash())
+	if er != nil {
+		ctx.Logger().Error(
+			fmt.Sprintf("could not sign response for address: %s with hash: %v, with error: %s",
+				sdk.Address(pk.PublicKey().Address()).String(), resp.HashString(), er.Error()),
+		)
+		return pc.NewKeybaseError(pc.ModuleName, er)
+	}
+	// attach the signature in hex to the response
+	resp.Signature = hex.EncodeToString(sig)
+	return nil
+}
+
+// "validateAndStoreRelay" - Ensures the validity of the relay and stores the proof before execution
+func (k Keeper) validateAndStoreRelay(ctx sdk.Ctx, relay *pc.Relay) (crypto.PrivateKey, sdk.Error) {
 	// get the latest session block height because this relay will correspond with the latest session
 	sessionBlockHeight := k.GetLatestSessionBlockHeight(ctx)
-	// get self node (your validator) from the current state
-	pk, err := k.GetSelfPrivKey(ctx)
+	// get the servicer hosted by this node the relay is sent to
+	pk, err := k.GetServicerPrivKey(ctx, relay.Proof.ServicerPubKey)
 	if err != nil {
 		return nil, err
 	}
@@ -25,6 +99,7 @@ func (k Keeper) HandleRelay(ctx sdk.Ctx, relay pc.Relay) (*pc.RelayResponse, sdk
 	// ensure the validity of the relay
 	maxPossibleRelays, err := relay.Validate(ctx, k.posKeeper, k.appKeeper, k, selfAddr, hostedBlockchains, sessionBlockHeight)
 	if err != nil {
+		pc.GlobalServiceMetric().AddRejectedRelayFor(relay.Proof.Blockchain, err)
 		if pc.GlobalPocketConfig.RelayErrors {
 			ctx.Logger().Error(
 				fmt.Sprintf("could not validate relay for app: %s for chainID: %v with error: %s",
@@ -46,36 +121,13 @@ func (k Keeper) HandleRelay(ctx sdk.Ctx, relay pc.Relay) (*pc.RelayResponse, sdk
 		}
 		return nil, err
 	}
-	// store the proof before execution, because the proof corresponds to the previous relay
-	relay.Proof.Store(maxPossibleRelays)
-	// attempt to execute
-	respPayload, err := relay.Execute(hostedBlockchains)
-	if err != nil {
-		ctx.Logger().Error(fmt.Sprintf("could not send relay with error: %s", err.Error()))
+	// the app and the client of the relay are authenticated, so their rate limits apply
+	if err := pc.CheckRelayRateLimits(relay.Proof.Blockchain, relay.Proof.Token.ApplicationPublicKey, relay.Proof.Token.ClientPublicKey); err != nil {
 		return nil, err
 	}
-	// generate response object
-	resp := &pc.RelayResponse{
-		Response: respPayload,
-		Proof:    relay.Proof,
-	}
-	// sign the response
-	sig, er := pk.Sign(resp.Hash())
-	if er != nil {
-		ctx.Logger().Error(
-			fmt.Sprintf("could not sign response for address: %s with hash: %v, with error: %s",
-				selfAddr.String(), resp.HashString(), er.Error()),
-		)
-		return nil, pc.NewKeybaseError(pc.ModuleName, er)
-	}
-	// attach the signature in hex to the response
-	resp.Signature = hex.EncodeToString(sig)
-	// track the relay time
-	relayTime := time.Since(relayTimeStart)
-	// add to metrics
-	pc.GlobalServiceMetric().AddRelayTimingFor(relay.Proof.Blockchain, float64(relayTime.Milliseconds()))
-	pc.GlobalServiceMetric().AddRelayFor(relay.Proof.Blockchain)
-	return resp, nil
+	// store the proof before execution, because the proof corresponds to the previous relay
+	relay.Proof.Store(selfAddr, maxPossibleRelays)
+	return pk, nil
 }
 
 // "HandleChallenge" - Handles a client relay response challenge request
@@ -115,13 +167,20 @@ func (k Keeper) HandleChallenge(ctx sdk.Ctx, challenge pc.ChallengeProofInvalidD
 		// add to cache
 		pc.SetSession(session)
 	}
+	// the challenge is handled by the first servicer of this node in the session
+	for _, pk := range k.GetServicerKeys(ctx) {
+		if addr := sdk.Address(pk.PublicKey().Address()); session.SessionNodes.Contains(addr) {
+			selfNode = addr
+			break
+		}
+	}
 	// validate the challenge
 	err := challenge.ValidateLocal(header, app.GetMaxRelays(), app.GetChains(), int(k.SessionNodeCount(sessionCtx)), session.SessionNodes, selfNode)
 	if err != nil {
 		return nil, err
 	}
 	// store the challenge in memory
-	challenge.Store(app.GetMaxRelays())
+	challenge.Store(selfNode, app.GetMaxRelays())
 	// update metric
 	pc.GlobalServiceMetric().AddChallengeFor(header.Chain)
 	return &pc.ChallengeResponse{Response: fmt.Sprintf("successfully stored challenge proof for %s", challenge.MinorityResponse.Proof.ServicerPubKey)}, nil
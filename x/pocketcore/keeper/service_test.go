@@ -67,6 +67,7 @@ func TestKeeper_HandleRelay(t *testing.T) {
 	}
 	validRelay.Proof.Signature = hex.EncodeToString(clientSig)
 	defer gock.Off() // Flush pending mocks after test execution
+	gock.InterceptClient(types.GetRelayClient(ethereum))
 
 	gock.New("https://www.google.com:443").
 		Post("/").
@@ -88,3 +89,80 @@ func TestKeeper_HandleRelay(t *testing.T) {
 	assert.NotEmpty(t, resp)
 	assert.Equal(t, resp.Response, "bar")
 }
+
+func TestKeeper_HandleWebsocketRelay(t *testing.T) {
+	ethereum := hex.EncodeToString([]byte{01})
+	ctx, _, _, _, keeper, keys, kb := createTestInput(t, false)
+	mockCtx := new(Ctx)
+	ak := keeper.appKeeper.(appsKeeper.Keeper)
+	clientPrivateKey := getRandomPrivateKey()
+	appPrivateKey := getRandomPrivateKey()
+	apk := appPrivateKey.PublicKey()
+	app := appsTypes.NewApplication(sdk.Address(apk.Address()), apk, []string{ethereum}, sdk.NewInt(10000000))
+	app.MaxRelays = ak.CalculateAppRelays(ctx, app)
+	ak.SetApplication(ctx, app)
+	ak.SetStakedApplication(ctx, app)
+	kp, _ := kb.GetCoinbase()
+	relay := types.Relay{
+		Payload: types.Payload{Data: "{\"jsonrpc\":\"2.0\",\"method\":\"eth_subscribe\",\"params\":[\"newHeads\"],\"id\":1}"},
+		Meta:    types.RelayMeta{BlockHeight: 976},
+		Proof: types.RelayProof{
+			Entropy:            2,
+			SessionBlockHeight: 976,
+			ServicerPubKey:     kp.PublicKey.RawString(),
+			Blockchain:         ethereum,
+			Token: types.AAT{
+				Version:              "0.0.1",
+				ApplicationPublicKey: apk.RawString(),
+				ClientPublicKey:      clientPrivateKey.PublicKey().RawString(),
+			},
+		},
+	}
+	relay.Proof.RequestHash = relay.RequestHashString()
+	appSig, er := appPrivateKey.Sign(relay.Proof.Token.Hash())
+	assert.Nil(t, er)
+	relay.Proof.Token.ApplicationSignature = hex.EncodeToString(appSig)
+	mockCtx.On("KVStore", keeper.storeKey).Return(ctx.KVStore(keeper.storeKey))
+	mockCtx.On("KVStore", keys["pos"]).Return(ctx.KVStore(keys["pos"]))
+	mockCtx.On("KVStore", keys["params"]).Return(ctx.KVStore(keys["params"]))
+	mockCtx.On("KVStore", keys["application"]).Return(ctx.KVStore(keys["application"]))
+	mockCtx.On("BlockHeight").Return(ctx.BlockHeight())
+	mockCtx.On("PrevCtx", int64(976)).Return(ctx, nil)
+	mockCtx.On("PrevCtx", keeper.GetLatestSessionBlockHeight(mockCtx)).Return(ctx, nil)
+	mockCtx.On("Logger").Return(ctx.Logger())
+	servicer := sdk.Address(kp.PublicKey.Address())
+	// a relay per second for the app
+	config := types.GlobalPocketConfig
+	defer func() { types.GlobalPocketConfig = config; types.ResetRateLimits() }()
+	types.GlobalPocketConfig.RelayRateLimitApp, types.GlobalPocketConfig.RelayRateLimitBurst = 1, 1
+	types.ResetRateLimits()
+	// a relay signed by someone else than the client is rejected and no proof is stored
+	wrongSig, er := appPrivateKey.Sign(relay.Proof.Hash())
+	assert.Nil(t, er)
+	relay.Proof.Signature = hex.EncodeToString(wrongSig)
+	assert.NotNil(t, keeper.HandleWebsocketRelay(mockCtx, relay))
+	_, total := types.GetTotalProofs(servicer, relay.Proof.SessionHeader(), types.RelayEvidence, sdk.NewInt(app.MaxRelays.Int64()))
+	assert.Zero(t, total)
+	// the valid relay stores its proof
+	clientSig, er := clientPrivateKey.Sign(relay.Proof.Hash())
+	assert.Nil(t, er)
+	relay.Proof.Signature = hex.EncodeToString(clientSig)
+	assert.Nil(t, keeper.HandleWebsocketRelay(mockCtx, relay))
+	_, total = types.GetTotalProofs(servicer, relay.Proof.SessionHeader(), types.RelayEvidence, sdk.NewInt(app.MaxRelays.Int64()))
+	assert.Equal(t, int64(1), total)
+	// the invalid relay didn't take the token of the app, the valid one did
+	relay.Proof.Entropy = 3
+	relay.Proof.RequestHash = relay.RequestHashString()
+	clientSig, er = clientPrivateKey.Sign(relay.Proof.Hash())
+	assert.Nil(t, er)
+	relay.Proof.Signature = hex.EncodeToString(clientSig)
+	err := keeper.HandleWebsocketRelay(mockCtx, relay)
+	assert.NotNil(t, err)
+	assert.Equal(t, types.CodeRateLimitError, int(err.Code()))
+	// the response is signed by the servicer of the proof
+	resp := types.RelayResponse{Response: "{\"result\":\"0x1\"}", Proof: relay.Proof}
+	assert.Nil(t, keeper.SignRelayResponse(mockCtx, &resp))
+	sig, er := hex.DecodeString(resp.Signature)
+	assert.Nil(t, er)
+	assert.True(t, kp.PublicKey.VerifyBytes(resp.Hash(), sig))
+}
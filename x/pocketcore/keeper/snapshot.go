@@ -0,0 +1,206 @@
+package keeper
+
+import (
+	"bytes"
+	"encoding/hex"
+	"fmt"
+	"reflect"
+
+	sdk "github.com/pokt-network/pocket-core/types"
+	pc "github.com/pokt-network/pocket-core/x/pocketcore/types"
+)
+
+// "ExportEvidenceSnapshot" - Returns a snapshot of the evidence of the servicers hosted by this node (or of a single
+// servicer) and of the session cache
+func (k Keeper) ExportEvidenceSnapshot(ctx sdk.Ctx, servicer sdk.Address) (pc.EvidenceSnapshot, error) {
+	servicers := make([]sdk.Address, 0)
+	for _, pk := range k.GetServicerKeys(ctx) {
+		addr := sdk.Address(pk.PublicKey().Address())
+		if servicer == nil || servicer.Equals(addr) {
+			servicers = append(servicers, addr)
+		}
+	}
+	if servicer != nil && len(servicers) == 0 {
+		return pc.EvidenceSnapshot{}, pc.NewForeignServicerError(pc.ModuleName)
+	}
+	return pc.NewEvidenceSnapshot(ctx.ChainID(), ctx.BlockHeight(), servicers)
+}
+
+// "ImportEvidenceSnapshot" - Validates every piece of evidence of the snapshot against the world state and adds the
+// valid ones to the evidence of the servicers hosted by this node, the sessions are only added if they match the
+// sessions generated from the world state
+func (k Keeper) ImportEvidenceSnapshot(ctx sdk.Ctx, snapshot pc.EvidenceSnapshot) (result pc.EvidenceImportResult, err error) {
+	if err := snapshot.ValidateBasic(); err != nil {
+		return result, err
+	}
+	if snapshot.ChainID != ctx.ChainID() {
+		return result, pc.NewSnapshotChainIDError(pc.ModuleName, snapshot.ChainID)
+	}
+	result.Height = ctx.BlockHeight()
+	result.Skipped = make([]pc.EvidenceImportSkip, 0)
+	for _, se := range snapshot.Evidence {
+		o, er := pc.Evidence{}.UnmarshalObject(se.Evidence)
+		if er != nil {
+			return pc.EvidenceImportResult{}, er
+		}
+		evidence, ok := o.(pc.Evidence)
+		if !ok {
+			return pc.EvidenceImportResult{}, fmt.Errorf("could not unmarshal the evidence of servicer %s", se.Servicer)
+		}
+		skip := func(reason string) {
+			result.Skipped = append(result.Skipped, pc.EvidenceImportSkip{
+				Servicer:      se.Servicer,
+				SessionHeader: evidence.SessionHeader,
+				EvidenceType:  evidence.EvidenceType,
+				Reason:        reason,
+			})
+		}
+		servicer, er := sdk.AddressFromHex(se.Servicer)
+		if er != nil {
+			skip(er.Error())
+			continue
+		}
+		claim, claimed, err := k.ValidateImportedEvidence(ctx, servicer, evidence)
+		if err != nil {
+			skip(err.Error())
+			continue
+		}
+		if claimed {
+			// the proof will be generated from this evidence, so the evidence on this node must be the claimed one
+			local, er := pc.GetEvidence(servicer, evidence.SessionHeader, evidence.EvidenceType, sdk.ZeroInt())
+			if er == nil && local.NumOfProofs == claim.TotalProofs {
+				skip("the claimed evidence is already on this node")
+				continue
+			}
+		}
+		proofs, merged, er := pc.ImportEvidence(servicer, evidence, se.Sealed || claimed, claimed)
+		if er != nil {
+			skip(er.Error())
+			continue
+		}
+		if merged {
+			result.Merged++
+		} else {
+			result.Imported++
+		}
+		result.Proofs += proofs
+	}
+	for _, bz := range snapshot.Sessions {
+		o, er := pc.Session{}.UnmarshalObject(bz)
+		if er != nil {
+			return pc.EvidenceImportResult{}, er
+		}
+		if k.importSession(ctx, o.(pc.Session)) {
+			result.Sessions++
+		}
+	}
+	return result, nil
+}
+
+// "ValidateImportedEvidence" - Validates a piece of evidence imported from another node against the world state.
+// Returns the claim of the servicer for the evidence if it was already claimed
+func (k Keeper) ValidateImportedEvidence(ctx sdk.Ctx, servicer sdk.Address, evidence pc.Evidence) (claim pc.MsgClaim, claimed bool, err sdk.Error) {
+	// only the servicers hosted by this node can use the evidence
+	if !k.IsSelfServicer(ctx, servicer) {
+		return claim, false, pc.NewForeignServicerError(pc.ModuleName)
+	}
+	if evidence.EvidenceType != pc.RelayEvidence && evidence.EvidenceType != pc.ChallengeEvidence {
+		return claim, false, pc.NewNoEvidenceTypeErr(pc.ModuleName)
+	}
+	if err := evidence.SessionHeader.ValidateHeader(); err != nil {
+		return claim, false, err
+	}
+	if evidence.NumOfProofs == 0 || evidence.NumOfProofs != int64(len(evidence.Proofs)) {
+		return claim, false, pc.NewInvalidProofsError(pc.ModuleName)
+	}
+	// every proof must belong to the session and the servicer
+	for _, p := range evidence.Proofs {
+		if p == nil || p.SessionHeader() != evidence.SessionHeader || !servicer.Equals(p.GetSigner()) {
+			return claim, false, pc.NewInvalidProofsError(pc.ModuleName)
+		}
+	}
+	// get the session context (state info at the beginning of the session)
+	sessionCtx, er := ctx.PrevCtx(evidence.SessionBlockHeight)
+	if er != nil {
+		return claim, false, sdk.ErrInternal(er.Error())
+	}
+	if !k.IsPocketSupportedBlockchain(sessionCtx, evidence.Chain) {
+		return claim, false, pc.NewChainNotSupportedErr(pc.ModuleName)
+	}
+	if _, found := k.GetNode(sessionCtx, servicer); !found {
+		return claim, false, pc.NewNodeNotFoundErr(pc.ModuleName)
+	}
+	app, found := k.GetAppFromPublicKey(sessionCtx, evidence.ApplicationPubKey)
+	if !found {
+		return claim, false, pc.NewAppNotFoundError(pc.ModuleName)
+	}
+	// every proof is verified as if the relay or the challenge was served by this node: signatures, token and proof
+	sessionNodeCount := int(k.SessionNodeCount(sessionCtx))
+	for _, p := range evidence.Proofs {
+		// convert to value for switch consistency
+		if reflect.ValueOf(p).Kind() == reflect.Ptr {
+			p = reflect.Indirect(reflect.ValueOf(p)).Interface().(pc.Proof)
+		}
+		var err sdk.Error
+		switch proof := p.(type) {
+		case pc.RelayProof:
+			err = proof.ValidateLocal(app.GetChains(), sessionNodeCount, evidence.SessionBlockHeight, servicer)
+		case pc.ChallengeProofInvalidData:
+			if err = proof.ValidateBasic(); err == nil {
+				err = proof.Validate(app.GetChains(), sessionNodeCount, evidence.SessionBlockHeight)
+			}
+		default:
+			err = pc.NewInvalidProofsError(pc.ModuleName)
+		}
+		if err != nil {
+			return claim, false, err
+		}
+	}
+	claim, claimed = k.GetClaim(ctx, servicer, evidence.SessionHeader, evidence.EvidenceType)
+	if !claimed {
+		// the evidence can't be claimed once the claim is mature
+		if k.ClaimIsMature(ctx, evidence.SessionBlockHeight) {
+			return claim, false, pc.NewExpiredProofsSubmissionError(pc.ModuleName)
+		}
+		return claim, false, nil
+	}
+	// the proof is generated from the evidence, so it must be the evidence the claim was made of
+	proofs := evidence.Proofs
+	if maxRelays := pc.MaxPossibleRelays(app, k.SessionNodeCount(sessionCtx)).Int64(); int64(len(proofs)) > maxRelays {
+		proofs = proofs[:maxRelays]
+	}
+	root, _ := pc.GenerateRoot(evidence.SessionBlockHeight, proofs)
+	if claim.TotalProofs != evidence.NumOfProofs || !root.Equal(claim.MerkleRoot) {
+		return claim, true, pc.NewClaimMismatchError(pc.ModuleName)
+	}
+	return claim, true, nil
+}
+
+// "importSession" - Adds the session to the session cache if it matches the session generated from the world state
+func (k Keeper) importSession(ctx sdk.Ctx, session pc.Session) bool {
+	if session.SessionHeader.ValidateHeader() != nil {
+		return false
+	}
+	if _, found := pc.GetSession(session.SessionHeader); found {
+		return false
+	}
+	sessionCtx, err := ctx.PrevCtx(session.SessionHeader.SessionBlockHeight)
+	if err != nil {
+		return false
+	}
+	blockHashBz, err := sessionCtx.BlockHash(k.Cdc, sessionCtx.BlockHeight())
+	if err != nil {
+		return false
+	}
+	generated, er := pc.NewSession(sessionCtx, ctx, k.posKeeper, session.SessionHeader, hex.EncodeToString(blockHashBz), int(k.SessionNodeCount(sessionCtx)))
+	if er != nil || !bytes.Equal(generated.SessionKey, session.SessionKey) || len(generated.SessionNodes) != len(session.SessionNodes) {
+		return false
+	}
+	for i, node := range generated.SessionNodes {
+		if !node.Equals(session.SessionNodes[i]) {
+			return false
+		}
+	}
+	pc.SetSession(generated)
+	return true
+}
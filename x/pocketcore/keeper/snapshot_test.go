@@ -0,0 +1,114 @@
+package keeper
+
+import (
+	"encoding/hex"
+	"testing"
+
+	sdk "github.com/pokt-network/pocket-core/types"
+	appsTypes "github.com/pokt-network/pocket-core/x/apps/types"
+	nodesTypes "github.com/pokt-network/pocket-core/x/nodes/types"
+	"github.com/pokt-network/pocket-core/x/pocketcore/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestKeeper_ExportImportEvidenceSnapshot(t *testing.T) {
+	ctx, _, _, _, keeper, keys, _ := createTestInput(t, false)
+	types.ClearEvidence()
+	types.ClearSessionCache()
+	defer types.ClearEvidence()
+	pk, err := keeper.GetPKFromFile(ctx)
+	assert.Nil(t, err)
+	servicer := sdk.Address(pk.PublicKey().Address())
+	header := types.SessionHeader{
+		ApplicationPubKey:  getTestApplication().PublicKey.RawString(),
+		Chain:              hex.EncodeToString([]byte{01}),
+		SessionBlockHeight: 1,
+	}
+	// the evidence of a servicer that isn't hosted by this node, it's in the same cache (and session) as the evidence of
+	// the node so it's removed once marshalled
+	npk, foreignHeader, _ := simulateRelays(t, keeper, &ctx, 5)
+	foreign, err := types.GetEvidence(sdk.Address(npk.Address()), foreignHeader, types.RelayEvidence, sdk.ZeroInt())
+	assert.Nil(t, err)
+	foreignBz, err := foreign.MarshalObject()
+	assert.Nil(t, err)
+	assert.Nil(t, types.DeleteEvidence(sdk.Address(npk.Address()), foreignHeader, types.RelayEvidence))
+	clientKey := getRandomPrivateKey()
+	for j := 0; j < 5; j++ {
+		proof := createProof(getTestApplicationPrivateKey(), clientKey, pk.PublicKey(), header.Chain, j)
+		types.SetProof(servicer, header, types.RelayEvidence, proof, sdk.NewInt(100000))
+	}
+	mockCtx := &Ctx{}
+	mockCtx.On("KVStore", keeper.storeKey).Return(ctx.KVStore(keeper.storeKey))
+	mockCtx.On("KVStore", keys[sdk.ParamsKey.Name()]).Return(ctx.KVStore(keys[sdk.ParamsKey.Name()]))
+	mockCtx.On("KVStore", keys[appsTypes.StoreKey]).Return(ctx.KVStore(keys[appsTypes.StoreKey]))
+	mockCtx.On("KVStore", keys[nodesTypes.StoreKey]).Return(ctx.KVStore(keys[nodesTypes.StoreKey]))
+	mockCtx.On("Logger").Return(ctx.Logger())
+	mockCtx.On("ChainID").Return(ctx.ChainID())
+	mockCtx.On("BlockHeight").Return(int64(1))
+	mockCtx.On("PrevCtx", header.SessionBlockHeight).Return(ctx, nil)
+	// only the hosted servicers are exported
+	_, err = keeper.ExportEvidenceSnapshot(mockCtx, sdk.Address(npk.Address()))
+	assert.NotNil(t, err)
+	snapshot, err := keeper.ExportEvidenceSnapshot(mockCtx, nil)
+	assert.Nil(t, err)
+	assert.Nil(t, snapshot.ValidateBasic())
+	assert.Len(t, snapshot.Evidence, 1)
+	// a tampered snapshot is refused
+	tampered := snapshot
+	tampered.Height++
+	_, err = keeper.ImportEvidenceSnapshot(mockCtx, tampered)
+	assert.NotNil(t, err)
+	// the evidence of the foreign servicer is skipped
+	snapshot.Evidence = append(snapshot.Evidence, types.SnapshotEvidence{Servicer: sdk.Address(npk.Address()).String(), Evidence: foreignBz})
+	snapshot.Checksum, err = snapshot.ComputeChecksum()
+	assert.Nil(t, err)
+	// the evidence is restored on a node without it
+	types.ClearEvidence()
+	result, err := keeper.ImportEvidenceSnapshot(mockCtx, snapshot)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(1), result.Imported)
+	assert.Equal(t, int64(5), result.Proofs)
+	assert.Len(t, result.Skipped, 1)
+	assert.Equal(t, sdk.Address(npk.Address()).String(), result.Skipped[0].Servicer)
+	_, total := types.GetTotalProofs(servicer, header, types.RelayEvidence, sdk.NewInt(100000))
+	assert.Equal(t, int64(5), total)
+	// importing twice doesn't duplicate the proofs
+	result, err = keeper.ImportEvidenceSnapshot(mockCtx, snapshot)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(1), result.Merged)
+	assert.Zero(t, result.Proofs)
+	_, total = types.GetTotalProofs(servicer, header, types.RelayEvidence, sdk.NewInt(100000))
+	assert.Equal(t, int64(5), total)
+	// evidence that doesn't match the claim of the servicer is skipped
+	err = keeper.SetClaim(mockCtx, types.MsgClaim{
+		SessionHeader:    header,
+		MerkleRoot:       types.HashRange{Hash: types.Hash([]byte("fakeRoot")), Range: types.Range{Upper: 5}},
+		TotalProofs:      5,
+		FromAddress:      servicer,
+		EvidenceType:     types.RelayEvidence,
+		ExpirationHeight: 100,
+	})
+	assert.Nil(t, err)
+	result, err = keeper.ImportEvidenceSnapshot(mockCtx, snapshot)
+	assert.Nil(t, err)
+	assert.Len(t, result.Skipped, 2)
+	assert.Contains(t, result.Skipped[0].Reason, types.ClaimMismatchError.Error())
+	// evidence with a proof the client didn't sign is skipped
+	o, err := types.Evidence{}.UnmarshalObject(snapshot.Evidence[0].Evidence)
+	assert.Nil(t, err)
+	forged := o.(types.Evidence)
+	proof := *forged.Proofs[0].(*types.RelayProof)
+	sig, err := getRandomPrivateKey().Sign(proof.Hash())
+	assert.Nil(t, err)
+	proof.Signature = hex.EncodeToString(sig)
+	forged.Proofs[0] = proof
+	bz, err := forged.MarshalObject()
+	assert.Nil(t, err)
+	snapshot.Evidence = []types.SnapshotEvidence{{Servicer: servicer.String(), Evidence: bz}}
+	snapshot.Checksum, err = snapshot.ComputeChecksum()
+	assert.Nil(t, err)
+	result, err = keeper.ImportEvidenceSnapshot(mockCtx, snapshot)
+	assert.Nil(t, err)
+	assert.Len(t, result.Skipped, 1)
+	assert.Contains(t, result.Skipped[0].Reason, types.InvalidSignatureError.Error())
+}
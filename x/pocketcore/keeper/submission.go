@@ -0,0 +1,25 @@
+package keeper
+
+import (
+	"encoding/hex"
+
+	sdk "github.com/pokt-network/pocket-core/types"
+	pc "github.com/pokt-network/pocket-core/x/pocketcore/types"
+	"github.com/tendermint/tendermint/rpc/client"
+)
+
+// "UpdateSubmissions" - Tracks the claim and proof transactions of the submission queue through inclusion using the
+// tx indexer of the node
+func (k Keeper) UpdateSubmissions(ctx sdk.Ctx, n client.Client) {
+	pc.UpdateSubmissions(ctx.BlockHeight(), func(txHash string) (int64, uint32, string, bool) {
+		hash, err := hex.DecodeString(txHash)
+		if err != nil {
+			return 0, 0, "", false
+		}
+		res, err := n.Tx(hash, false)
+		if err != nil || res == nil {
+			return 0, 0, "", false
+		}
+		return res.Height, res.TxResult.Code, res.TxResult.Log, true
+	})
+}
@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"math/rand"
+	"sync/atomic"
 	"time"
 
 	"github.com/pokt-network/pocket-core/codec"
@@ -20,6 +21,13 @@ var (
 	_ module.AppModuleBasic = AppModuleBasic{}
 )
 
+var (
+	// set while the claims and proofs are being submitted
+	submitting int32
+	// set on the session trigger of the node until a submission run picks it up, so a trigger hit during a run isn't lost
+	sessionTriggerPending int32
+)
+
 // AppModuleBasic "AppModuleBasic" - The fundamental building block of a sdk module
 type AppModuleBasic struct{}
 
@@ -121,8 +129,13 @@ func (am AppModule) EndBlock(ctx sdk.Ctx, _ abci.RequestEndBlock) []abci.Validat
 	if addr != nil {
 		// use the offset as a trigger to see if it's time to attempt to submit proofs
 		if (ctx.BlockHeight()+int64(addr[0]))%blocksPerSession == 1 && ctx.BlockHeight() != 1 {
+			atomic.StoreInt32(&sessionTriggerPending, 1)
+		}
+		// the submissions are tracked every block, one run at a time
+		if atomic.CompareAndSwapInt32(&submitting, 0, 1) {
 			// run go routine because cannot access TmNode during end-block period
 			go func() {
+				defer atomic.StoreInt32(&submitting, 0)
 				// use this sleep timer to bypass the beginBlock lock over transactions
 				time.Sleep(time.Duration(rand.Intn(5000)) * time.Millisecond)
 				s, err := am.keeper.TmNode.Status()
@@ -130,12 +143,20 @@ func (am AppModule) EndBlock(ctx sdk.Ctx, _ abci.RequestEndBlock) []abci.Validat
 					ctx.Logger().Error(fmt.Sprintf("could not get status for tendermint node (cannot submit claims/proofs in this state): %s", err.Error()))
 				} else {
 					if !s.SyncInfo.CatchingUp {
-						// auto send the proofs
-						am.keeper.SendClaimTx(ctx, am.keeper, am.keeper.TmNode, ClaimTx)
-						// auto claim the proofs
-						am.keeper.SendProofTx(ctx, am.keeper.TmNode, ProofTx)
-						// clear session cache and db
-						types.ClearSessionCache()
+						// track the claims and proofs already sent
+						am.keeper.UpdateSubmissions(ctx, am.keeper.TmNode)
+						sessionTrigger := atomic.CompareAndSwapInt32(&sessionTriggerPending, 1, 0)
+						// the evidence is only gone through on the session trigger or when a submission is due (or retried)
+						if sessionTrigger || types.HasDueSubmissions(ctx.BlockHeight()) {
+							// auto send the proofs
+							am.keeper.SendClaimTx(ctx, am.keeper, am.keeper.TmNode, ClaimTx, BatchClaimTx)
+							// auto claim the proofs
+							am.keeper.SendProofTx(ctx, am.keeper.TmNode, ProofTx, BatchProofTx)
+						}
+						if sessionTrigger {
+							// clear session cache and db
+							types.ClearSessionCache()
+						}
 					}
 				}
 			}()
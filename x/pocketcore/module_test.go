@@ -5,6 +5,7 @@ import (
 	"reflect"
 	"testing"
 
+	"github.com/pokt-network/pocket-core/codec"
 	"github.com/pokt-network/pocket-core/x/pocketcore/keeper"
 	"github.com/pokt-network/pocket-core/x/pocketcore/types"
 	"github.com/stretchr/testify/assert"
@@ -58,6 +59,17 @@ func TestAppModule_Route(t *testing.T) {
 	assert.Equal(t, am.Route(), types.RouterKey)
 }
 
+func TestAppModule_BatchNotActive(t *testing.T) {
+	ctx, _, _, k, _ := createTestInput(t, false)
+	codec.UpgradeFeatureMap[codec.BatchClaimKey] = ctx.BlockHeight() + 1
+	defer delete(codec.UpgradeFeatureMap, codec.BatchClaimKey)
+	h := NewHandler(k)
+	res := h(ctx, types.MsgBatchClaim{Claims: []types.MsgClaim{{}}}, nil)
+	assert.EqualValues(t, types.CodeBatchNotActiveError, res.Code)
+	res = h(ctx, types.MsgBatchProof{}, nil)
+	assert.EqualValues(t, types.CodeBatchNotActiveError, res.Code)
+}
+
 func TestAppModule_QuerierRoute(t *testing.T) {
 	_, _, _, k, _ := createTestInput(t, false)
 	am := NewAppModule(k)
@@ -47,3 +47,31 @@ func ProofTx(cliCtx util.CLIContext, txBuilder auth.TxBuilder, merkleProof types
 	}
 	return util.CompleteAndBroadcastTxCLI(txBuilder, cliCtx, &msg, legacyCodec)
 }
+
+// "BatchClaimTx" - A transaction that claims several sessions of the servicer at once
+func BatchClaimTx(cliCtx util.CLIContext, txBuilder auth.TxBuilder, claims []types.MsgClaim) (*sdk.TxResponse, error) {
+	msg := types.MsgBatchClaim{Claims: claims}
+	err := msg.ValidateBasic()
+	if err != nil {
+		return nil, err
+	}
+	var legacyCodec bool
+	if cliCtx.Height < codec.GetCodecUpgradeHeight() {
+		legacyCodec = true
+	}
+	return util.CompleteAndBroadcastTxCLI(txBuilder, cliCtx, &msg, legacyCodec)
+}
+
+// "BatchProofTx" - A transaction that proves several claims of the servicer at once
+func BatchProofTx(cliCtx util.CLIContext, txBuilder auth.TxBuilder, proofs []types.MsgProof) (*sdk.TxResponse, error) {
+	msg := types.NewMsgBatchProof(proofs)
+	err := msg.ValidateBasic()
+	if err != nil {
+		return nil, err
+	}
+	var legacyCodec bool
+	if cliCtx.Height < codec.GetCodecUpgradeHeight() {
+		legacyCodec = true
+	}
+	return util.CompleteAndBroadcastTxCLI(txBuilder, cliCtx, &msg, legacyCodec)
+}
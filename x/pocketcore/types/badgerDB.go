@@ -0,0 +1,245 @@
+package types
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/dgraph-io/badger/v2"
+	db "github.com/tendermint/tm-db"
+)
+
+// "badgerDB" - A BadgerDB database behind the tm-db interface, so it can persist the objects of a cache storage
+type badgerDB struct {
+	db *badger.DB
+}
+
+var _ db.DB = &badgerDB{}
+
+// "newBadgerDB" - Opens (or creates) the BadgerDB database named name in the directory dir
+func newBadgerDB(name, dir string) (*badgerDB, error) {
+	path := filepath.Join(dir, name+".badger")
+	if err := os.MkdirAll(path, 0755); err != nil {
+		return nil, err
+	}
+	opts := badger.DefaultOptions(path).WithLogger(nil)
+	bdb, err := badger.Open(opts)
+	if err != nil {
+		return nil, err
+	}
+	return &badgerDB{db: bdb}, nil
+}
+
+// "Get" - Returns the value of the key, nil if it isn't found
+func (b *badgerDB) Get(key []byte) (value []byte, err error) {
+	err = b.db.View(func(txn *badger.Txn) error {
+		item, err := txn.Get(key)
+		if errors.Is(err, badger.ErrKeyNotFound) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		value, err = item.ValueCopy(nil)
+		return err
+	})
+	return
+}
+
+// "Has" - Returns true if the key is found
+func (b *badgerDB) Has(key []byte) (bool, error) {
+	value, err := b.Get(key)
+	return value != nil, err
+}
+
+// "Set" - Sets the value of the key
+func (b *badgerDB) Set(key, value []byte) error {
+	return b.db.Update(func(txn *badger.Txn) error {
+		return txn.Set(key, value)
+	})
+}
+
+// "SetSync" - Sets the value of the key and syncs the database to disk
+func (b *badgerDB) SetSync(key, value []byte) error {
+	if err := b.Set(key, value); err != nil {
+		return err
+	}
+	return b.db.Sync()
+}
+
+// "Delete" - Deletes the key
+func (b *badgerDB) Delete(key []byte) error {
+	return b.db.Update(func(txn *badger.Txn) error {
+		return txn.Delete(key)
+	})
+}
+
+// "DeleteSync" - Deletes the key and syncs the database to disk
+func (b *badgerDB) DeleteSync(key []byte) error {
+	if err := b.Delete(key); err != nil {
+		return err
+	}
+	return b.db.Sync()
+}
+
+// "Iterator" - Returns an iterator over the keys in [start, end), in ascending order
+func (b *badgerDB) Iterator(start, end []byte) (db.Iterator, error) {
+	return newBadgerIterator(b.db, start, end, false), nil
+}
+
+// "ReverseIterator" - Returns an iterator over the keys in [start, end), in descending order
+func (b *badgerDB) ReverseIterator(start, end []byte) (db.Iterator, error) {
+	return newBadgerIterator(b.db, start, end, true), nil
+}
+
+// "Close" - Closes the database, after the value log is garbage collected
+func (b *badgerDB) Close() error {
+	// the evidence is deleted once it's proven, so the value log is mostly garbage
+	for b.db.RunValueLogGC(0.5) == nil {
+	}
+	return b.db.Close()
+}
+
+// "NewBatch" - Returns a batch of writes, applied on Write
+func (b *badgerDB) NewBatch() db.Batch {
+	return &badgerBatch{wb: b.db.NewWriteBatch(), db: b.db}
+}
+
+// "Print" - Prints every key/value pair of the database
+func (b *badgerDB) Print() error {
+	iter, _ := b.Iterator(nil, nil)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		fmt.Printf("[%X]:\t[%X]\n", iter.Key(), iter.Value())
+	}
+	return iter.Error()
+}
+
+// "Stats" - Returns the size of the database
+func (b *badgerDB) Stats() map[string]string {
+	lsm, vlog := b.db.Size()
+	return map[string]string{
+		"database.type": "badgerDB",
+		"database.lsm":  fmt.Sprintf("%d", lsm),
+		"database.vlog": fmt.Sprintf("%d", vlog),
+	}
+}
+
+// "badgerBatch" - A batch of writes of a BadgerDB database, the first error is returned on Write
+type badgerBatch struct {
+	wb  *badger.WriteBatch
+	db  *badger.DB
+	err error
+}
+
+func (bb *badgerBatch) Set(key, value []byte) {
+	if bb.err == nil {
+		bb.err = bb.wb.Set(key, value)
+	}
+}
+
+func (bb *badgerBatch) Delete(key []byte) {
+	if bb.err == nil {
+		bb.err = bb.wb.Delete(key)
+	}
+}
+
+func (bb *badgerBatch) Write() error {
+	if bb.err != nil {
+		bb.wb.Cancel()
+		return bb.err
+	}
+	return bb.wb.Flush()
+}
+
+func (bb *badgerBatch) WriteSync() error {
+	if err := bb.Write(); err != nil {
+		return err
+	}
+	return bb.db.Sync()
+}
+
+func (bb *badgerBatch) Close() {
+	bb.wb.Cancel()
+}
+
+// "badgerIterator" - Iterates over the keys in [start, end) of a read only transaction
+type badgerIterator struct {
+	txn        *badger.Txn
+	iter       *badger.Iterator
+	start, end []byte
+	reverse    bool
+}
+
+var _ db.Iterator = &badgerIterator{}
+
+func newBadgerIterator(bdb *badger.DB, start, end []byte, reverse bool) *badgerIterator {
+	txn := bdb.NewTransaction(false)
+	opts := badger.DefaultIteratorOptions
+	opts.Reverse = reverse
+	iter := txn.NewIterator(opts)
+	switch {
+	case !reverse && start != nil:
+		iter.Seek(start)
+	case reverse && end != nil:
+		// seeks the last key <= end, end is exclusive
+		iter.Seek(end)
+		if iter.Valid() && bytes.Equal(iter.Item().Key(), end) {
+			iter.Next()
+		}
+	default:
+		iter.Rewind()
+	}
+	return &badgerIterator{txn: txn, iter: iter, start: start, end: end, reverse: reverse}
+}
+
+func (bi *badgerIterator) Domain() (start []byte, end []byte) {
+	return bi.start, bi.end
+}
+
+func (bi *badgerIterator) Valid() bool {
+	if !bi.iter.Valid() {
+		return false
+	}
+	key := bi.iter.Item().Key()
+	if bi.reverse {
+		return bi.start == nil || bytes.Compare(key, bi.start) >= 0
+	}
+	return bi.end == nil || bytes.Compare(key, bi.end) < 0
+}
+
+func (bi *badgerIterator) Next() {
+	if !bi.Valid() {
+		panic("iterator is invalid")
+	}
+	bi.iter.Next()
+}
+
+func (bi *badgerIterator) Key() []byte {
+	if !bi.Valid() {
+		panic("iterator is invalid")
+	}
+	return bi.iter.Item().KeyCopy(nil)
+}
+
+func (bi *badgerIterator) Value() []byte {
+	if !bi.Valid() {
+		panic("iterator is invalid")
+	}
+	value, err := bi.iter.Item().ValueCopy(nil)
+	if err != nil {
+		return nil
+	}
+	return value
+}
+
+func (bi *badgerIterator) Error() error {
+	return nil
+}
+
+func (bi *badgerIterator) Close() {
+	bi.iter.Close()
+	bi.txn.Discard()
+}
@@ -5,11 +5,14 @@ import (
 	"errors"
 	"fmt"
 	"github.com/tendermint/tendermint/config"
+	"hash/fnv"
 	"log"
 	"path/filepath"
 	"sync"
+	"sync/atomic"
 	"syscall"
 
+	lru "github.com/hashicorp/golang-lru"
 	sdk "github.com/pokt-network/pocket-core/types"
 	db "github.com/tendermint/tm-db"
 	"github.com/willf/bloom"
@@ -17,58 +20,202 @@ import (
 
 var (
 	// cache for session objects
-	globalSessionCache *CacheStorage
-	// cache for GOBEvidence objects
-	globalEvidenceCache *CacheStorage
+	globalSessionCache CacheStorage
+	// cache for GOBEvidence objects of the validator key, additional servicers have their own cache
+	globalEvidenceCache CacheStorage
 	// sync.once to perform initialization
 	cacheOnce sync.Once
-
-	globalEvidenceSealedMap sync.Map
 )
 
-// "CacheStorage" - Contains an LRU cache and a database instance w/ mutex
-type CacheStorage struct {
-	Cache *sdk.Cache // lru cache
-	DB    db.DB      // persisted
-	l     sync.Mutex // lock
-}
+// "CacheStorage" - A local store for the cache objects of the node (sessions and evidence). The engine is chosen in
+// the config, the objects of different keys can be written concurrently
+type CacheStorage interface {
+	// "Get" - Returns the value from a key
+	Get(key []byte, object CacheObject) (interface{}, bool)
+	// "Set" - Sets the KV pair, unless the object is sealed
+	Set(key []byte, val CacheObject)
+	// "Update" - Reads, modifies and writes the object of the key while holding the lock of the key
+	Update(key []byte, object CacheObject, fn CacheUpdateFn) error
+	// "Delete" - Deletes the item from the store
+	Delete(key []byte)
+	// "Seal" - Seals the cache object so it is no longer writable in the cache store
+	Seal(object CacheObject) (cacheObject CacheObject, isOK bool)
+	// "IsSealed" - Returns true if the cache object is no longer writable in the cache store
+	IsSealed(object CacheObject) bool
+	// "Iterator" - Returns an iterator for all of the items in the store, ordered by key
+	Iterator() (db.Iterator, error)
+	// "FlushToDB" - Persists the items held in memory, if the engine persists them
+	FlushToDB() error
+	// "Clear" - Deletes all items from the store
+	Clear()
+	// "Close" - Releases the resources of the store
+	Close() error
+}
+
+// "CacheUpdateFn" - Returns the new object of the key (nil keeps the stored one) and whether it's sealed.
+// Found and sealed describe the stored object
+type CacheUpdateFn func(val CacheObject, found, sealed bool) (newVal CacheObject, seal bool, err error)
 
 type CacheObject interface {
 	MarshalObject() ([]byte, error)
 	UnmarshalObject(b []byte) (CacheObject, error)
 	Key() ([]byte, error)
-	Seal() CacheObject
-	IsSealed() bool
 }
 
-// "Init" - Initializes a cache storage object
-func (cs *CacheStorage) Init(dir, name string, options config.LevelDBOptions, maxEntries int, inMemoryDB bool) {
-	// init the lru cache with a max entries
-	cs.Cache = sdk.NewCache(maxEntries)
+const (
+	LevelDBCacheEngine  = "leveldb"  // lru cache in front of a goleveldb database (default)
+	BadgerDBCacheEngine = "badgerdb" // lru cache in front of a BadgerDB database
+	MemDBCacheEngine    = "memdb"    // lru cache in front of an in memory goleveldb database, nothing is persisted
+	ShardedCacheEngine  = "sharded"  // sharded in memory maps of decoded objects, nothing is persisted
+)
+
+// "CacheEngineFn" - Opens a cache storage named name in the directory dir
+type CacheEngineFn func(dir, name string, options config.LevelDBOptions, maxEntries int) (CacheStorage, error)
+
+var cacheEngines = map[string]CacheEngineFn{
+	LevelDBCacheEngine: func(dir, name string, options config.LevelDBOptions, maxEntries int) (CacheStorage, error) {
+		return NewLevelDBCache(dir, name, options, maxEntries, false)
+	},
+	BadgerDBCacheEngine: func(dir, name string, _ config.LevelDBOptions, maxEntries int) (CacheStorage, error) {
+		return NewBadgerDBCache(dir, name, maxEntries)
+	},
+	MemDBCacheEngine: func(dir, name string, options config.LevelDBOptions, maxEntries int) (CacheStorage, error) {
+		return NewLevelDBCache(dir, name, options, maxEntries, true)
+	},
+	ShardedCacheEngine: func(dir, name string, options config.LevelDBOptions, maxEntries int) (CacheStorage, error) {
+		return NewShardedCache(), nil
+	},
+}
+
+// "RegisterCacheEngine" - Makes a cache engine available to the config, engines built with additional dependencies
+// (Pebble, RocksDB...) register themselves with it
+func RegisterCacheEngine(engine string, fn CacheEngineFn) {
+	cacheEngines[engine] = fn
+}
+
+// "NewCacheStorage" - Opens a cache storage with the engine
+func NewCacheStorage(engine, dir, name string, options config.LevelDBOptions, maxEntries int) (CacheStorage, error) {
+	if engine == "" {
+		engine = LevelDBCacheEngine
+	}
+	fn, found := cacheEngines[engine]
+	if !found {
+		return nil, fmt.Errorf("unknown cache engine: %s", engine)
+	}
+	return fn(dir, name, options, maxEntries)
+}
+
+// "mustNewCacheStorage" - Opens a cache storage with the engine, panics if it can't be opened
+func mustNewCacheStorage(engine, dir, name string, options config.LevelDBOptions, maxEntries int) CacheStorage {
+	cs, err := NewCacheStorage(engine, dir, name, options, maxEntries)
+	if err != nil {
+		panic(err)
+	}
+	return cs
+}
+
+// the number of locks the keys are spread over, so concurrent writes of different sessions don't contend
+const cacheKeyLocks = 256
+
+// "keyLocks" - Locks a key of a cache storage, the keys are spread over a fixed number of locks
+type keyLocks [cacheKeyLocks]sync.Mutex
+
+func (kl *keyLocks) lock(key []byte) *sync.Mutex {
+	h := fnv.New32a()
+	_, _ = h.Write(key)
+	l := &kl[h.Sum32()%cacheKeyLocks]
+	l.Lock()
+	return l
+}
+
+// "LevelDBCache" - Contains an LRU cache in front of a database (goleveldb or BadgerDB), the objects evicted from the
+// cache are written to the database. The keys are locked on their own so there's no global lock, an object is written
+// to the database before it leaves the cache so it's always found in one or the other
+type LevelDBCache struct {
+	Cache    *lru.Cache // lru cache, its lock is held while an evicted object is written to the db
+	DB       db.DB      // persisted
+	keys     keyLocks   // guards the reads and updates of a key
+	sealed   sync.Map   // hex keys of the READONLY objects
+	clearing int32      // set while the cache is purged, the evicted objects are dropped instead of written
+}
+
+var _ CacheStorage = &LevelDBCache{}
+
+// "NewLevelDBCache" - Opens a goleveldb backed cache storage
+func NewLevelDBCache(dir, name string, options config.LevelDBOptions, maxEntries int, inMemoryDB bool) (*LevelDBCache, error) {
 	// intialize the db
-	var err error
 	if inMemoryDB {
-		cs.DB = db.NewGoLevelMemDBWithCapacity(maxEntries)
-		return
+		return NewDBCache(db.NewGoLevelMemDBWithCapacity(maxEntries), maxEntries)
 	}
-	cs.DB, err = sdk.NewLevelDB(name, dir, options.ToGoLevelDBOpts())
+	database, err := sdk.NewLevelDB(name, dir, options.ToGoLevelDBOpts())
 	if err != nil {
 		if err == syscall.EWOULDBLOCK {
 			message := fmt.Sprintf("can't open files needed for execution. Another instance may be running. path: %s\n", filepath.Join(dir, name+".db"))
-			panic(errors.New(message))
+			return nil, errors.New(message)
 		}
-		panic(err)
+		return nil, err
+	}
+	return NewDBCache(database, maxEntries)
+}
+
+// "NewBadgerDBCache" - Opens a BadgerDB backed cache storage
+func NewBadgerDBCache(dir, name string, maxEntries int) (*LevelDBCache, error) {
+	database, err := newBadgerDB(name, dir)
+	if err != nil {
+		return nil, err
 	}
+	return NewDBCache(database, maxEntries)
+}
+
+// "NewDBCache" - Returns a cache storage with an lru cache of max entries in front of the database
+func NewDBCache(database db.DB, maxEntries int) (*LevelDBCache, error) {
+	cs := &LevelDBCache{DB: database}
+	// init the lru cache with a max entries
+	var err error
+	cs.Cache, err = lru.NewWithEvict(maxEntries, cs.onEvict)
+	if err != nil {
+		return nil, err
+	}
+	return cs, nil
+}
+
+// "onEvict" - Writes an object leaving the lru cache to the db. CONTRACT: called with the lock of the lru cache
+func (cs *LevelDBCache) onEvict(key, val interface{}) {
+	if atomic.LoadInt32(&cs.clearing) == 1 {
+		return
+	}
+	if err := cs.writeToDB(key, val); err != nil {
+		fmt.Printf("ERROR: cache storage cannot be flushed to database: %s\n", err.Error())
+	}
+}
+
+func (cs *LevelDBCache) writeToDB(key, val interface{}) error {
+	// value should be cache object
+	co, ok := val.(CacheObject)
+	if !ok {
+		return fmt.Errorf("object in cache does not impement the cache object interface")
+	}
+	// marshal object to bytes
+	bz, err := co.MarshalObject()
+	if err != nil {
+		return fmt.Errorf("error flushing database, marshalling value for DB: %s", err.Error())
+	}
+	kBz, err := hex.DecodeString(key.(string))
+	if err != nil {
+		return fmt.Errorf("error flushing database, couldn't hex decode key: %s", err.Error())
+	}
+	// set to DB
+	return cs.DB.Set(kBz, bz)
 }
 
 // "Get" - Returns the value from a key
-func (cs *CacheStorage) Get(key []byte, object CacheObject) (interface{}, bool) {
-	cs.l.Lock()
-	defer cs.l.Unlock()
-	return cs.GetWithoutLock(key, object)
+func (cs *LevelDBCache) Get(key []byte, object CacheObject) (interface{}, bool) {
+	defer cs.keys.lock(key).Unlock()
+	return cs.getWithoutLock(key, object)
 }
 
-func (cs *CacheStorage) GetWithoutLock(key []byte, object CacheObject) (interface{}, bool) {
+// "getWithoutLock" - CONTRACT: used in a function with the lock of the key
+func (cs *LevelDBCache) getWithoutLock(key []byte, object CacheObject) (interface{}, bool) {
 	// get the object using hex string of key
 	if res, ok := cs.Cache.Get(hex.EncodeToString(key)); ok {
 		return res, true
@@ -88,112 +235,74 @@ func (cs *CacheStorage) GetWithoutLock(key []byte, object CacheObject) (interfac
 	return res, true
 }
 
-// "Seal" - Seals the cache object so it is no longer writable in the cache store
-func (cs *CacheStorage) Seal(object CacheObject) (cacheObject CacheObject, isOK bool) {
-	if object.IsSealed() {
-		return object, true
+// "Update" - Reads, modifies and writes the object of the key while holding the lock of the key, the other keys
+// can be read and written in the meantime
+func (cs *LevelDBCache) Update(key []byte, object CacheObject, fn CacheUpdateFn) error {
+	defer cs.keys.lock(key).Unlock()
+	keyString := hex.EncodeToString(key)
+	val, found := cs.getWithoutLock(key, object)
+	co, _ := val.(CacheObject)
+	_, sealed := cs.sealed.Load(keyString)
+	newVal, seal, err := fn(co, found && co != nil, sealed)
+	if err != nil || newVal == nil {
+		return err
 	}
-	cs.l.Lock()
-	defer cs.l.Unlock()
-	// get the key from the object
-	k, err := object.Key()
-	if err != nil {
-		return object, false
+	if seal {
+		cs.sealed.Store(keyString, struct{}{})
+	} else {
+		cs.sealed.Delete(keyString)
 	}
-	// make READONLY
-	sealed := object.Seal()
-	// set in db and cache
-	cs.SetWithoutLockAndSealCheck(hex.EncodeToString(k), sealed)
-	return sealed, true
+	cs.Cache.Add(keyString, newVal)
+	return nil
 }
 
-// "Set" - Sets the KV pair in cache and db
-func (cs *CacheStorage) Set(key []byte, val CacheObject) {
-	keyString := hex.EncodeToString(key)
-	cs.l.Lock()
-	defer cs.l.Unlock()
-	// get object to check if sealed
-	res, found := cs.GetWithoutLock(key, val)
-	if found {
-		co, ok := res.(CacheObject)
-		if !ok {
-			fmt.Printf("ERROR: cannot convert object into cache object (in set)")
-			return
-		}
-		// if evidence, check sealed map
-		if ev, ok := co.(Evidence); ok {
-			if _, ok := globalEvidenceSealedMap.Load(ev.HashString()); ok {
-				return
-			}
-		}
-	}
-	cs.SetWithoutLockAndSealCheck(keyString, val)
+// "Seal" - Seals the cache object so it is no longer writable in the cache store
+func (cs *LevelDBCache) Seal(object CacheObject) (cacheObject CacheObject, isOK bool) {
+	return sealObject(cs, object)
 }
 
-// "SetWithoutLockAndSealCheck" - CONTRACT: used in a function with lock
-//                                          cache must be flushed to db before any DB iterator
-func (cs *CacheStorage) SetWithoutLockAndSealCheck(key string, val CacheObject) {
-	// flush to db
-	if cs.Cache.Len() == cs.Cache.Cap() && !cs.Cache.Contains(key) {
-		err := cs.FlushToDBWithoutLock()
-		if err != nil {
-			fmt.Printf("ERROR: cache storage cannot be flushed to database (in set): %s", err.Error())
-			return
-		}
+// "IsSealed" - Returns true if the cache object is no longer writable in the cache store
+func (cs *LevelDBCache) IsSealed(object CacheObject) bool {
+	k, err := object.Key()
+	if err != nil {
+		return false
 	}
-	// add to cache
-	cs.Cache.Add(key, val)
+	_, ok := cs.sealed.Load(hex.EncodeToString(k))
+	return ok
+}
+
+// "Set" - Sets the KV pair in cache and db
+func (cs *LevelDBCache) Set(key []byte, val CacheObject) {
+	_ = cs.Update(key, val, setObject(val))
 }
 
 // "Remove" - Deletes the item from stores
-func (cs *CacheStorage) Delete(key []byte) {
-	cs.l.Lock()
-	defer cs.l.Unlock()
-	// remove from cache
+func (cs *LevelDBCache) Delete(key []byte) {
+	defer cs.keys.lock(key).Unlock()
+	// remove from cache, the object is evicted to the db first
 	cs.Cache.Remove(hex.EncodeToString(key))
+	cs.sealed.Delete(hex.EncodeToString(key))
 	// remove from db
 	_ = cs.DB.Delete(key)
 }
 
-func (cs *CacheStorage) FlushToDB() error {
-	cs.l.Lock()
-	defer cs.l.Unlock()
-	return cs.FlushToDBWithoutLock()
-}
-
-func (cs *CacheStorage) FlushToDBWithoutLock() error {
-	// flush all to database
+// "FlushToDB" - Writes every object of the lru cache to the db, emptying the cache
+func (cs *LevelDBCache) FlushToDB() error {
+	// flush all to database, the objects are written as they are evicted
 	for {
-		key, val, ok := cs.Cache.RemoveOldest()
-		if !ok {
-			break
+		if _, _, ok := cs.Cache.RemoveOldest(); !ok {
+			return nil
 		}
-		// value should be cache object
-		co, ok := val.(CacheObject)
-		if !ok {
-			return fmt.Errorf("object in cache does not impement the cache object interface")
-		}
-		// marshal object to bytes
-		bz, err := co.MarshalObject()
-		if err != nil {
-			return fmt.Errorf("error flushing database, marshalling value for DB: %s", err.Error())
-		}
-		kBz, err := hex.DecodeString(key)
-		if err != nil {
-			return fmt.Errorf("error flushing database, couldn't hex decode key: %s", err.Error())
-		}
-		// set to DB
-		_ = cs.DB.Set(kBz, bz)
 	}
-	return nil
 }
 
 // "Clear" - Deletes all items from stores
-func (cs *CacheStorage) Clear() {
-	cs.l.Lock()
-	defer cs.l.Unlock()
-	// clear cache
+func (cs *LevelDBCache) Clear() {
+	// clear cache, without writing the objects to the db
+	atomic.StoreInt32(&cs.clearing, 1)
 	cs.Cache.Purge()
+	atomic.StoreInt32(&cs.clearing, 0)
+	cs.sealed = sync.Map{}
 	// clear db
 	iter, _ := cs.DB.Iterator(nil, nil)
 	defer iter.Close()
@@ -203,7 +312,7 @@ func (cs *CacheStorage) Clear() {
 }
 
 // "Iterator" - Returns an iterator for all of the items in the stores
-func (cs *CacheStorage) Iterator() (db.Iterator, error) {
+func (cs *LevelDBCache) Iterator() (db.Iterator, error) {
 	err := cs.FlushToDB()
 	if err != nil {
 		fmt.Printf("unable to flush to db before iterator created in cacheStorage Iterator(): %s", err.Error())
@@ -211,6 +320,39 @@ func (cs *CacheStorage) Iterator() (db.Iterator, error) {
 	return cs.DB.Iterator(nil, nil)
 }
 
+// "Close" - Closes the database
+func (cs *LevelDBCache) Close() error {
+	return cs.DB.Close()
+}
+
+// "setObject" - The update that sets the object, unless the stored one is sealed
+func setObject(val CacheObject) CacheUpdateFn {
+	return func(_ CacheObject, _, sealed bool) (CacheObject, bool, error) {
+		// sealed objects are READONLY
+		if sealed {
+			return nil, true, nil
+		}
+		return val, false, nil
+	}
+}
+
+// "sealObject" - Seals the object in the cache storage
+func sealObject(cs CacheStorage, object CacheObject) (CacheObject, bool) {
+	// get the key from the object
+	k, err := object.Key()
+	if err != nil {
+		return object, false
+	}
+	// make READONLY, set in db and cache
+	err = cs.Update(k, object, func(_ CacheObject, _, sealed bool) (CacheObject, bool, error) {
+		if sealed {
+			return nil, true, nil
+		}
+		return object, true, nil
+	})
+	return object, err == nil
+}
+
 // "GetSession" - Returns a session (value) from the stores using a header (key)
 func GetSession(header SessionHeader) (session Session, found bool) {
 	// generate the key from the header
@@ -273,41 +415,33 @@ func SessionIterator() SessionIt {
 	}
 }
 
-// "GetEvidence" - Retrieves the GOBEvidence object from the storage
-func GetEvidence(header SessionHeader, evidenceType EvidenceType, max sdk.BigInt) (evidence Evidence, err error) {
+// "GetEvidence" - Retrieves the GOBEvidence object of the servicer from the storage
+func GetEvidence(servicer sdk.Address, header SessionHeader, evidenceType EvidenceType, max sdk.BigInt) (evidence Evidence, err error) {
 	// generate the key for the GOBEvidence
 	key, err := KeyForEvidence(header, evidenceType)
 	if err != nil {
 		return
 	}
+	cache := getEvidenceCache(servicer)
 	// get the bytes from the storage
-	val, found := globalEvidenceCache.Get(key, evidence)
+	val, found := cache.Get(key, evidence)
 	if !found && max.Equal(sdk.ZeroInt()) {
 		return Evidence{}, fmt.Errorf("GOBEvidence not found")
 	}
 	if !found {
-		bloomFilter := bloom.NewWithEstimates(uint(sdk.NewUintFromBigInt(max.BigInt()).Uint64()), .01)
-		// add to metric
-		GlobalServiceMetric().AddSessionFor(header.Chain)
-		return Evidence{
-			Bloom:         *bloomFilter,
-			SessionHeader: header,
-			NumOfProofs:   0,
-			Proofs:        make([]Proof, 0),
-			EvidenceType:  evidenceType,
-		}, nil
+		return newEvidence(header, evidenceType, max), nil
 	}
 	evidence, ok := val.(Evidence)
 	if !ok {
 		err = fmt.Errorf("could not unmarshal into evidence from cache with header %v", header)
 		return
 	}
-	if evidence.IsSealed() {
+	if cache.IsSealed(evidence) {
 		return evidence, nil
 	}
 	// if hit relay limit... Seal the evidence
 	if found && !max.Equal(sdk.ZeroInt()) && evidence.NumOfProofs >= max.Int64() {
-		evidence, ok = SealEvidence(evidence)
+		evidence, ok = SealEvidence(servicer, evidence)
 		if !ok {
 			err = fmt.Errorf("max relays is hit and could not seal evidence! GetEvidence() with header %v", header)
 			return
@@ -316,33 +450,46 @@ func GetEvidence(header SessionHeader, evidenceType EvidenceType, max sdk.BigInt
 	return
 }
 
-// "SetEvidence" - Sets an GOBEvidence object in the storage
-func SetEvidence(evidence Evidence) {
+// "newEvidence" - Returns empty evidence for a session the servicer hasn't served before
+func newEvidence(header SessionHeader, evidenceType EvidenceType, max sdk.BigInt) Evidence {
+	bloomFilter := bloom.NewWithEstimates(uint(sdk.NewUintFromBigInt(max.BigInt()).Uint64()), .01)
+	// add to metric
+	GlobalServiceMetric().AddSessionFor(header.Chain, header.ApplicationPubKey)
+	return Evidence{
+		Bloom:         *bloomFilter,
+		SessionHeader: header,
+		NumOfProofs:   0,
+		Proofs:        make([]Proof, 0),
+		EvidenceType:  evidenceType,
+	}
+}
+
+// "SetEvidence" - Sets an GOBEvidence object of the servicer in the storage
+func SetEvidence(servicer sdk.Address, evidence Evidence) {
 	// generate the key for the evidence
 	key, err := evidence.Key()
 	if err != nil {
 		return
 	}
-	globalEvidenceCache.Set(key, evidence)
+	getEvidenceCache(servicer).Set(key, evidence)
 }
 
-// "DeleteEvidence" - Remove the GOBEvidence from the stores
-func DeleteEvidence(header SessionHeader, evidenceType EvidenceType) error {
+// "DeleteEvidence" - Remove the GOBEvidence of the servicer from the stores
+func DeleteEvidence(servicer sdk.Address, header SessionHeader, evidenceType EvidenceType) error {
 	// generate key for GOBEvidence
 	key, err := KeyForEvidence(header, evidenceType)
 	if err != nil {
 		return err
 	}
 	// delete from cache
-	globalEvidenceCache.Delete(key)
-	globalEvidenceSealedMap.Delete(header.HashString())
+	getEvidenceCache(servicer).Delete(key)
 	return nil
 }
 
-// "SealEvidence" - Locks/sets the evidence from the stores
-func SealEvidence(evidence Evidence) (Evidence, bool) {
+// "SealEvidence" - Locks/sets the evidence of the servicer from the stores
+func SealEvidence(servicer sdk.Address, evidence Evidence) (Evidence, bool) {
 	// delete from cache
-	co, ok := globalEvidenceCache.Seal(evidence)
+	co, ok := getEvidenceCache(servicer).Seal(evidence)
 	if !ok {
 		return Evidence{}, ok
 	}
@@ -350,15 +497,22 @@ func SealEvidence(evidence Evidence) (Evidence, bool) {
 	return e, ok
 }
 
+// "IsEvidenceSealed" - Returns true if the evidence of the servicer is READONLY
+func IsEvidenceSealed(servicer sdk.Address, evidence Evidence) bool {
+	return getEvidenceCache(servicer).IsSealed(evidence)
+}
+
 // "ClearEvidence" - Clear stores of all evidence
 func ClearEvidence() {
 	if globalEvidenceCache != nil {
 		globalEvidenceCache.Clear()
-		globalEvidenceSealedMap = sync.Map{}
+	}
+	for _, cache := range servicerEvidenceCaches() {
+		cache.Clear()
 	}
 }
 
-// "EvidenceIt" - An GOBEvidence iterator instance of the globalEvidenceCache
+// "EvidenceIt" - An GOBEvidence iterator instance of an evidence cache
 type EvidenceIt struct {
 	db.Iterator
 }
@@ -377,9 +531,9 @@ func (ei *EvidenceIt) Value() (evidence Evidence) {
 	return
 }
 
-// "EvidenceIterator" - Returns a globalEvidenceCache iterator instance
-func EvidenceIterator() EvidenceIt {
-	it, _ := globalEvidenceCache.Iterator()
+// "EvidenceIterator" - Returns an iterator over the evidence cache of the servicer
+func EvidenceIterator(servicer sdk.Address) EvidenceIt {
+	it, _ := getEvidenceCache(servicer).Iterator()
 
 	return EvidenceIt{
 		Iterator: it,
@@ -387,9 +541,9 @@ func EvidenceIterator() EvidenceIt {
 }
 
 // "GetProof" - Returns the Proof object from a specific piece of GOBEvidence at a certain index
-func GetProof(header SessionHeader, evidenceType EvidenceType, index int64) Proof {
+func GetProof(servicer sdk.Address, header SessionHeader, evidenceType EvidenceType, index int64) Proof {
 	// retrieve the GOBEvidence
-	evidence, err := GetEvidence(header, evidenceType, sdk.ZeroInt())
+	evidence, err := GetEvidence(servicer, header, evidenceType, sdk.ZeroInt())
 	if err != nil {
 		return nil
 	}
@@ -401,28 +555,52 @@ func GetProof(header SessionHeader, evidenceType EvidenceType, index int64) Proo
 	return evidence.Proofs[index]
 }
 
-// "SetProof" - Sets a proof object in the GOBEvidence, using the header and GOBEvidence type
-func SetProof(header SessionHeader, evidenceType EvidenceType, p Proof, max sdk.BigInt) {
-	// retireve the GOBEvidence
-	evidence, err := GetEvidence(header, evidenceType, max)
-	// if not found generate the GOBEvidence object
+// "SetProof" - Sets a proof object in the GOBEvidence of the servicer, using the header and GOBEvidence type.
+// Only the evidence of the session is locked, so relays of different sessions are stored concurrently
+func SetProof(servicer sdk.Address, header SessionHeader, evidenceType EvidenceType, p Proof, max sdk.BigInt) {
+	// generate the key for the GOBEvidence
+	key, err := KeyForEvidence(header, evidenceType)
+	if err != nil {
+		log.Fatalf("could not set proof object: %s", err.Error())
+	}
+	err = getEvidenceCache(servicer).Update(key, Evidence{}, func(val CacheObject, found, sealed bool) (CacheObject, bool, error) {
+		// sealed evidence is READONLY
+		if sealed {
+			return nil, true, nil
+		}
+		// if not found generate the GOBEvidence object
+		if !found {
+			val = newEvidence(header, evidenceType, max)
+		}
+		evidence, ok := val.(Evidence)
+		if !ok {
+			return nil, false, fmt.Errorf("could not unmarshal into evidence from cache with header %v", header)
+		}
+		// if hit relay limit... Seal the evidence
+		if !max.Equal(sdk.ZeroInt()) && evidence.NumOfProofs >= max.Int64() {
+			return evidence, true, nil
+		}
+		// the uniqueness checked by the relay is not locked, so a concurrent duplicate is dropped here
+		if !IsUniqueProof(p, evidence) {
+			return nil, false, nil
+		}
+		// add proof
+		evidence.AddProof(p)
+		return evidence, false, nil
+	})
 	if err != nil {
 		log.Fatalf("could not set proof object: %s", err.Error())
 	}
-	// add proof
-	evidence.AddProof(p)
-	// set GOBEvidence back
-	SetEvidence(evidence)
 }
 
 func IsUniqueProof(p Proof, evidence Evidence) bool {
 	return !evidence.Bloom.Test(p.Hash())
 }
 
-// "GetTotalProofs" - Returns the total number of proofs for a piece of GOBEvidence
-func GetTotalProofs(h SessionHeader, et EvidenceType, maxPossibleRelays sdk.BigInt) (Evidence, int64) {
+// "GetTotalProofs" - Returns the total number of proofs for a piece of GOBEvidence of the servicer
+func GetTotalProofs(servicer sdk.Address, h SessionHeader, et EvidenceType, maxPossibleRelays sdk.BigInt) (Evidence, int64) {
 	// retrieve the GOBEvidence
-	evidence, err := GetEvidence(h, et, maxPossibleRelays)
+	evidence, err := GetEvidence(servicer, h, et, maxPossibleRelays)
 	if err != nil {
 		log.Fatalf("could not get total proofs for GOBEvidence: %s", err.Error())
 	}
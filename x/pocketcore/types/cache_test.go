@@ -2,9 +2,12 @@ package types
 
 import (
 	"encoding/hex"
+	"fmt"
 	"github.com/tendermint/tendermint/libs/log"
 	"os"
 	"reflect"
+	"sync"
+	"sync/atomic"
 	"testing"
 
 	sdk "github.com/pokt-network/pocket-core/types"
@@ -30,12 +33,13 @@ func TestMain(m *testing.M) {
 }
 
 func TestIsUniqueProof(t *testing.T) {
+	servicer := sdk.Address(getRandomPubKey().Address())
 	h := SessionHeader{
 		ApplicationPubKey:  "0",
 		Chain:              "0001",
 		SessionBlockHeight: 0,
 	}
-	e, _ := GetEvidence(h, RelayEvidence, sdk.NewInt(100000))
+	e, _ := GetEvidence(servicer, h, RelayEvidence, sdk.NewInt(100000))
 	p := RelayProof{
 		Entropy: 1,
 	}
@@ -44,8 +48,8 @@ func TestIsUniqueProof(t *testing.T) {
 	}
 	assert.True(t, IsUniqueProof(p, e), "p is unique")
 	e.AddProof(p)
-	SetEvidence(e)
-	e, err := GetEvidence(h, RelayEvidence, sdk.ZeroInt())
+	SetEvidence(servicer, e)
+	e, err := GetEvidence(servicer, h, RelayEvidence, sdk.ZeroInt())
 	assert.Nil(t, err)
 	assert.False(t, IsUniqueProof(p, e), "p is no longer unique")
 	assert.True(t, IsUniqueProof(p1, e), "p is unique")
@@ -53,6 +57,7 @@ func TestIsUniqueProof(t *testing.T) {
 }
 
 func TestAllEvidence_AddGetEvidence(t *testing.T) {
+	servicer := sdk.Address(getRandomPubKey().Address())
 	appPubKey := getRandomPubKey().RawString()
 	servicerPubKey := getRandomPubKey().RawString()
 	clientPubKey := getRandomPubKey().RawString()
@@ -76,11 +81,12 @@ func TestAllEvidence_AddGetEvidence(t *testing.T) {
 		},
 		Signature: "",
 	}
-	SetProof(header, RelayEvidence, proof, sdk.NewInt(100000))
-	assert.True(t, reflect.DeepEqual(GetProof(header, RelayEvidence, 0), proof))
+	SetProof(servicer, header, RelayEvidence, proof, sdk.NewInt(100000))
+	assert.True(t, reflect.DeepEqual(GetProof(servicer, header, RelayEvidence, 0), proof))
 }
 
 func TestAllEvidence_DeleteEvidence(t *testing.T) {
+	servicer := sdk.Address(getRandomPubKey().Address())
 	appPubKey := getRandomPubKey().RawString()
 	servicerPubKey := getRandomPubKey().RawString()
 	clientPubKey := getRandomPubKey().RawString()
@@ -104,14 +110,15 @@ func TestAllEvidence_DeleteEvidence(t *testing.T) {
 		},
 		Signature: "",
 	}
-	SetProof(header, RelayEvidence, proof, sdk.NewInt(100000))
-	assert.True(t, reflect.DeepEqual(GetProof(header, RelayEvidence, 0), proof))
-	GetProof(header, RelayEvidence, 0)
-	_ = DeleteEvidence(header, RelayEvidence)
-	assert.Empty(t, GetProof(header, RelayEvidence, 0))
+	SetProof(servicer, header, RelayEvidence, proof, sdk.NewInt(100000))
+	assert.True(t, reflect.DeepEqual(GetProof(servicer, header, RelayEvidence, 0), proof))
+	GetProof(servicer, header, RelayEvidence, 0)
+	_ = DeleteEvidence(servicer, header, RelayEvidence)
+	assert.Empty(t, GetProof(servicer, header, RelayEvidence, 0))
 }
 
 func TestAllEvidence_GetTotalProofs(t *testing.T) {
+	servicer := sdk.Address(getRandomPubKey().Address())
 	appPubKey := getRandomPubKey().RawString()
 	servicerPubKey := getRandomPubKey().RawString()
 	clientPubKey := getRandomPubKey().RawString()
@@ -141,7 +148,7 @@ func TestAllEvidence_GetTotalProofs(t *testing.T) {
 		Signature: "",
 	}
 	proof2 := RelayProof{
-		Entropy:            0,
+		Entropy:            1,
 		SessionBlockHeight: 1,
 		ServicerPubKey:     servicerPubKey,
 		RequestHash:        header.HashString(), // fake
@@ -154,10 +161,10 @@ func TestAllEvidence_GetTotalProofs(t *testing.T) {
 		},
 		Signature: "",
 	}
-	SetProof(header, RelayEvidence, proof, sdk.NewInt(100000))
-	SetProof(header, RelayEvidence, proof2, sdk.NewInt(100000))
-	SetProof(header2, RelayEvidence, proof2, sdk.NewInt(100000)) // different header so shouldn't be counted
-	_, totalRelays := GetTotalProofs(header, RelayEvidence, sdk.NewInt(100000))
+	SetProof(servicer, header, RelayEvidence, proof, sdk.NewInt(100000))
+	SetProof(servicer, header, RelayEvidence, proof2, sdk.NewInt(100000))
+	SetProof(servicer, header2, RelayEvidence, proof2, sdk.NewInt(100000)) // different header so shouldn't be counted
+	_, totalRelays := GetTotalProofs(servicer, header, RelayEvidence, sdk.NewInt(100000))
 	assert.Equal(t, totalRelays, int64(2))
 }
 
@@ -197,6 +204,132 @@ func TestClearCache(t *testing.T) {
 	assert.Zero(t, count)
 }
 
+// "withEvidenceCache" - Runs fn with the main evidence cache opened with the engine, in a temporary directory
+func withEvidenceCache(tb testing.TB, engine string, fn func()) {
+	c := sdk.DefaultTestingPocketConfig()
+	cache, err := NewCacheStorage(engine, tb.TempDir(), "evidence", c.TendermintConfig.LevelDBOptions, c.PocketConfig.MaxEvidenceCacheEntires)
+	if err != nil {
+		tb.Fatal(err)
+	}
+	prev := globalEvidenceCache
+	globalEvidenceCache = cache
+	defer func() {
+		globalEvidenceCache = prev
+		_ = cache.Close()
+	}()
+	fn()
+}
+
+var cacheEngineNames = []string{LevelDBCacheEngine, BadgerDBCacheEngine, MemDBCacheEngine, ShardedCacheEngine}
+
+func TestCacheStorage_ParallelSetProof(t *testing.T) {
+	_, err := NewCacheStorage("unknown", "", "", sdk.DefaultTestingPocketConfig().TendermintConfig.LevelDBOptions, 1)
+	assert.NotNil(t, err)
+	for _, engine := range cacheEngineNames {
+		t.Run(engine, func(t *testing.T) {
+			withEvidenceCache(t, engine, func() {
+				servicer := sdk.Address(getRandomPubKey().Address())
+				headers := make([]SessionHeader, 10)
+				for i := range headers {
+					headers[i] = SessionHeader{ApplicationPubKey: getRandomPubKey().RawString(), Chain: "0001", SessionBlockHeight: 1}
+				}
+				var wg sync.WaitGroup
+				// every proof is set twice concurrently
+				for i := 0; i < 2000; i++ {
+					wg.Add(1)
+					go func(i int) {
+						defer wg.Done()
+						SetProof(servicer, headers[i/2%len(headers)], RelayEvidence, RelayProof{Entropy: int64(i / 2)}, sdk.NewInt(90))
+					}(i)
+				}
+				wg.Wait()
+				// every session hit the relay limit and was sealed, no proof was lost, duplicated or added past the limit
+				for _, h := range headers {
+					e, total := GetTotalProofs(servicer, h, RelayEvidence, sdk.NewInt(90))
+					assert.Equal(t, int64(90), total)
+					assert.True(t, IsEvidenceSealed(servicer, e))
+				}
+				iter := EvidenceIterator(servicer)
+				defer iter.Close()
+				count := 0
+				for ; iter.Valid(); iter.Next() {
+					assert.Len(t, iter.Value().Proofs, 90)
+					unique := make(map[string]struct{})
+					for _, p := range iter.Value().Proofs {
+						unique[p.HashString()] = struct{}{}
+					}
+					assert.Len(t, unique, 90)
+					count++
+				}
+				assert.Equal(t, len(headers), count)
+			})
+		})
+	}
+}
+
+func TestCacheStorage_Persisted(t *testing.T) {
+	for _, engine := range []string{LevelDBCacheEngine, BadgerDBCacheEngine} {
+		t.Run(engine, func(t *testing.T) {
+			dir := t.TempDir()
+			options := sdk.DefaultTestingPocketConfig().TendermintConfig.LevelDBOptions
+			// a single entry lru cache, so every other object is evicted to the db
+			cache, err := NewCacheStorage(engine, dir, "evidence", options, 1)
+			assert.Nil(t, err)
+			headers := make([]SessionHeader, 5)
+			for i := range headers {
+				headers[i] = SessionHeader{ApplicationPubKey: getRandomPubKey().RawString(), Chain: "0001", SessionBlockHeight: 1}
+				e := newEvidence(headers[i], RelayEvidence, sdk.NewInt(10))
+				e.AddProof(RelayProof{Entropy: int64(i)})
+				k, err := e.Key()
+				assert.Nil(t, err)
+				cache.Set(k, e)
+			}
+			assert.Nil(t, cache.FlushToDB())
+			assert.Nil(t, cache.Close())
+			cache, err = NewCacheStorage(engine, dir, "evidence", options, 1)
+			assert.Nil(t, err)
+			defer cache.Close()
+			for _, h := range headers {
+				k, err := KeyForEvidence(h, RelayEvidence)
+				assert.Nil(t, err)
+				val, found := cache.Get(k, Evidence{})
+				assert.True(t, found)
+				assert.Equal(t, h, val.(Evidence).SessionHeader)
+				assert.Equal(t, int64(1), val.(Evidence).NumOfProofs)
+			}
+			k, _ := KeyForEvidence(headers[0], RelayEvidence)
+			cache.Delete(k)
+			_, found := cache.Get(k, Evidence{})
+			assert.False(t, found)
+		})
+	}
+}
+
+// "BenchmarkCacheStorage_SetProof" - Compares the cache engines under parallel relays, spread over sessions
+func BenchmarkCacheStorage_SetProof(b *testing.B) {
+	for _, engine := range cacheEngineNames {
+		for _, sessions := range []int{1, 100} {
+			b.Run(fmt.Sprintf("%s/sessions=%d", engine, sessions), func(b *testing.B) {
+				withEvidenceCache(b, engine, func() {
+					servicer := sdk.Address(getRandomPubKey().Address())
+					headers := make([]SessionHeader, sessions)
+					for i := range headers {
+						headers[i] = SessionHeader{ApplicationPubKey: getRandomPubKey().RawString(), Chain: "0001", SessionBlockHeight: 1}
+					}
+					var entropy int64
+					b.ResetTimer()
+					b.RunParallel(func(pb *testing.PB) {
+						for pb.Next() {
+							i := atomic.AddInt64(&entropy, 1)
+							SetProof(servicer, headers[i%int64(sessions)], RelayEvidence, RelayProof{Entropy: i}, sdk.NewInt(100000))
+						}
+					})
+				})
+			})
+		}
+	}
+}
+
 func NewTestSession(t *testing.T, chain string) Session {
 	appPubKey := getRandomPubKey()
 	var vals []sdk.Address
@@ -22,6 +22,8 @@ func RegisterCodec(cdc *codec.Codec) {
 	cdc.RegisterStructure(MsgClaim{}, "pocketcore/claim")
 	cdc.RegisterStructure(MsgProtoProof{}, "pocketcore/protoProof")
 	cdc.RegisterStructure(MsgProof{}, "pocketcore/proof")
+	cdc.RegisterStructure(MsgBatchClaim{}, "pocketcore/batch_claim")
+	cdc.RegisterStructure(MsgBatchProof{}, "pocketcore/batch_proof")
 	cdc.RegisterStructure(Relay{}, "pocketcore/relay")
 	cdc.RegisterStructure(Session{}, "pocketcore/session")
 	cdc.RegisterStructure(RelayResponse{}, "pocketcore/relay_response")
@@ -34,7 +36,7 @@ func RegisterCodec(cdc *codec.Codec) {
 	cdc.RegisterStructure(nodesTypes.LegacyValidator{}, "pos/Validator") // todo does this really need to depend on nodes/types
 	cdc.RegisterInterface("x.pocketcore.Proof", (*Proof)(nil), &RelayProof{}, &ChallengeProofInvalidData{})
 	cdc.RegisterInterface("types.isProofI_Proof", (*isProofI_Proof)(nil))
-	cdc.RegisterImplementation((*sdk.ProtoMsg)(nil), &MsgClaim{}, &MsgProof{})
-	cdc.RegisterImplementation((*sdk.Msg)(nil), &MsgClaim{}, &MsgProof{})
+	cdc.RegisterImplementation((*sdk.ProtoMsg)(nil), &MsgClaim{}, &MsgProof{}, &MsgBatchClaim{}, &MsgBatchProof{})
+	cdc.RegisterImplementation((*sdk.Msg)(nil), &MsgClaim{}, &MsgProof{}, &MsgBatchClaim{}, &MsgBatchProof{})
 	ModuleCdc = cdc
 }
@@ -1,9 +1,7 @@
 package types
 
 import (
-	"encoding/hex"
 	"fmt"
-	"sync"
 	"time"
 
 	"github.com/pokt-network/pocket-core/types"
@@ -24,15 +22,20 @@ var (
 // "InitConfig" - Initializes the cache for sessions and evidence
 func InitConfig(chains *HostedBlockchains, logger log.Logger, c types.Config) {
 	cacheOnce.Do(func() {
-		globalEvidenceCache = new(CacheStorage)
-		globalSessionCache = new(CacheStorage)
-		globalEvidenceSealedMap = sync.Map{}
-		globalEvidenceCache.Init(c.PocketConfig.DataDir, c.PocketConfig.EvidenceDBName, c.TendermintConfig.LevelDBOptions, c.PocketConfig.MaxEvidenceCacheEntires, false)
-		globalSessionCache.Init(c.PocketConfig.DataDir, "", c.TendermintConfig.LevelDBOptions, c.PocketConfig.MaxSessionCacheEntries, true)
-		InitGlobalServiceMetric(chains, logger, c.PocketConfig.PrometheusAddr, c.PocketConfig.PrometheusMaxOpenfiles)
+		globalEvidenceCache = mustNewCacheStorage(c.PocketConfig.EvidenceCacheEngine, c.PocketConfig.DataDir, c.PocketConfig.EvidenceDBName, c.TendermintConfig.LevelDBOptions, c.PocketConfig.MaxEvidenceCacheEntires)
+		initServicerEvidenceCaches(c.PocketConfig.EvidenceCacheEngine, c.PocketConfig.DataDir, c.PocketConfig.EvidenceDBName, c.TendermintConfig.LevelDBOptions, c.PocketConfig.MaxEvidenceCacheEntires)
+		globalSessionCache = mustNewCacheStorage(MemDBCacheEngine, c.PocketConfig.DataDir, "", c.TendermintConfig.LevelDBOptions, c.PocketConfig.MaxSessionCacheEntries)
+		if c.PocketConfig.RelayLedger {
+			InitRelayLedger(c.PocketConfig.DataDir, c.TendermintConfig.LevelDBOptions)
+		}
+		InitGlobalServiceMetric(chains, logger, c.PocketConfig.PrometheusAddr, c.PocketConfig.PrometheusMaxOpenfiles, c.PocketConfig.PrometheusLegacyMetrics)
 	})
 	GlobalPocketConfig = c.PocketConfig
 	SetRPCTimeout(c.PocketConfig.RPCTimeout)
+	// the relay clients and rate limits are rebuilt with the new settings
+	ResetRelayClients()
+	ResetGRPCConns()
+	ResetRateLimits()
 }
 
 func ConvertEvidenceToProto(config types.Config) error {
@@ -52,9 +55,9 @@ func ConvertEvidenceToProto(config types.Config) error {
 		if err != nil {
 			return fmt.Errorf("error creating key from evidence object: %s", err.Error())
 		}
-		gec.SetWithoutLockAndSealCheck(hex.EncodeToString(k), ev)
+		gec.Set(k, ev)
 	}
-	err = gec.FlushToDBWithoutLock()
+	err = gec.FlushToDB()
 	if err != nil {
 		return fmt.Errorf("error flushing evidence objects to the database: %s", err.Error())
 	}
@@ -67,9 +70,11 @@ func FlushSessionCache() {
 	if err != nil {
 		fmt.Printf("unable to flush sessions to the database before shutdown!! %s\n", err.Error())
 	}
-	err = globalEvidenceCache.FlushToDB()
-	if err != nil {
-		fmt.Printf("unable to flush GOBEvidence to the database before shutdown!! %s\n", err.Error())
+	for _, cache := range append(servicerEvidenceCaches(), globalEvidenceCache) {
+		err = cache.FlushToDB()
+		if err != nil {
+			fmt.Printf("unable to flush GOBEvidence to the database before shutdown!! %s\n", err.Error())
+		}
 	}
 }
 
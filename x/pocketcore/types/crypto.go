@@ -69,6 +69,7 @@ func SignatureVerification(publicKey, msgHex, sigHex string) sdk.Error {
 // "InitPVKeyFile" - Initializes the global private validator key variable
 func InitPVKeyFile(filePVKey privval.FilePVKey) {
 	globalPVKeyFile = filePVKey
+	setPVKey(filePVKey)
 }
 
 // "GetPVKeyFile" - Returns the globalPVKeyFile instance
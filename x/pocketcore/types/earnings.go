@@ -0,0 +1,18 @@
+package types
+
+import (
+	sdk "github.com/pokt-network/pocket-core/types"
+	nodesTypes "github.com/pokt-network/pocket-core/x/nodes/types"
+)
+
+// "EarningsEstimate" - The projected rewards of a servicer with the params at a height
+type EarningsEstimate struct {
+	Height     int64                     `json:"height"`
+	Address    string                    `json:"address,omitempty"`
+	Stake      sdk.BigInt                `json:"stake"`
+	Weight     sdk.BigDec                `json:"weight"`     // the stake weight of the relays
+	Claims     nodesTypes.RewardEstimate `json:"claims"`     // the relays claimed, minted once their proofs are accepted
+	Evidence   nodesTypes.RewardEstimate `json:"evidence"`   // the relays of the local evidence that are not claimed yet
+	Projection nodesTypes.RewardEstimate `json:"projection"` // the relays requested
+	Total      nodesTypes.RewardEstimate `json:"total"`
+}
@@ -0,0 +1,253 @@
+package types
+
+import (
+	"bytes"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	RoundRobinSelection   = "round_robin"
+	LeastLatencySelection = "least_latency"
+	// the number of consecutive relay failures after which an endpoint is considered unhealthy
+	MaxEndpointFailures = 3
+	// the time after which an unhealthy endpoint is given a trial relay
+	EndpointRetryCooldown = 30 * time.Second
+)
+
+var globalEndpointStates = &endpointStates{
+	states:   make(map[string]*EndpointState),
+	counters: make(map[string]uint64),
+}
+
+// "EndpointState" - The health of a single backend url of a hosted blockchain
+type EndpointState struct {
+	URL                 string    `json:"url"`
+	Healthy             bool      `json:"healthy"`
+	LatencyMs           int64     `json:"latency_ms"` // moving average of the backend response time
+	ConsecutiveFailures int       `json:"consecutive_failures"`
+	LastCheck           time.Time `json:"last_check"`
+}
+
+// "HostedBlockchainStatus" - A hosted blockchain along with the state of each of its endpoints and its sync state
+type HostedBlockchainStatus struct {
+	HostedBlockchain
+	EndpointStates []EndpointState `json:"endpoint_states"`
+	SyncState      *ChainSyncState `json:"sync_state,omitempty"`
+}
+
+// "endpointStates" - The health of all the endpoints, keyed by chain and url
+type endpointStates struct {
+	l        sync.Mutex
+	states   map[string]*EndpointState
+	counters map[string]uint64 // round robin counter per chain
+}
+
+func endpointKey(chainID, url string) string {
+	return chainID + "|" + url
+}
+
+// "get" - Returns the state of the endpoint, endpoints never checked are considered healthy
+func (e *endpointStates) get(chainID, url string) EndpointState {
+	if s, found := e.states[endpointKey(chainID, url)]; found {
+		return *s
+	}
+	return EndpointState{URL: url, Healthy: true}
+}
+
+// "update" - Applies f to the state of the endpoint and publishes the result to the metrics
+func (e *endpointStates) update(chainID, url string, f func(s *EndpointState)) {
+	e.l.Lock()
+	s, found := e.states[endpointKey(chainID, url)]
+	if !found {
+		s = &EndpointState{URL: url, Healthy: true}
+		e.states[endpointKey(chainID, url)] = s
+	}
+	f(s)
+	s.LastCheck = time.Now()
+	state := *s
+	e.l.Unlock()
+	GlobalServiceMetric().SetEndpointStateFor(chainID, state)
+}
+
+// "prune" - Removes the state of the endpoints that are no longer hosted
+func (e *endpointStates) prune(chains map[string]HostedBlockchain) {
+	e.l.Lock()
+	defer e.l.Unlock()
+	hosted := make(map[string]struct{})
+	for _, chain := range chains {
+		for _, endpoint := range chain.GetEndpoints() {
+			hosted[endpointKey(chain.ID, endpoint.URL)] = struct{}{}
+		}
+	}
+	for k := range e.states {
+		if _, found := hosted[k]; !found {
+			delete(e.states, k)
+		}
+	}
+}
+
+// "GetEndpointStates" - Returns the state of every endpoint of the hosted blockchain
+func GetEndpointStates(chain HostedBlockchain) []EndpointState {
+	e := globalEndpointStates
+	e.l.Lock()
+	defer e.l.Unlock()
+	var states []EndpointState
+	for _, endpoint := range chain.GetEndpoints() {
+		states = append(states, e.get(chain.ID, endpoint.URL))
+	}
+	return states
+}
+
+// "OrderEndpoints" - Returns the endpoints of the hosted blockchain in the order they should be tried:
+// the endpoint picked by the selection strategy, the other healthy endpoints by latency, then the unhealthy ones.
+// An unhealthy endpoint not tried for the cooldown is put first for a trial relay, its outcome restores it or restarts
+// the cooldown, so it recovers without the health checks
+func OrderEndpoints(chain HostedBlockchain) []HostedEndpoint {
+	endpoints := chain.GetEndpoints()
+	if len(endpoints) == 1 {
+		return endpoints
+	}
+	e := globalEndpointStates
+	e.l.Lock()
+	defer e.l.Unlock()
+	var healthy, unhealthy, trial []HostedEndpoint
+	for _, endpoint := range endpoints {
+		s := e.get(chain.ID, endpoint.URL)
+		switch {
+		case s.Healthy:
+			healthy = append(healthy, endpoint)
+		case trial == nil && time.Since(s.LastCheck) >= EndpointRetryCooldown:
+			// only this relay takes the trial, the next ones wait for another cooldown
+			e.states[endpointKey(chain.ID, endpoint.URL)].LastCheck = time.Now()
+			trial = append(trial, endpoint)
+		default:
+			unhealthy = append(unhealthy, endpoint)
+		}
+	}
+	sort.SliceStable(healthy, func(i, j int) bool {
+		return e.get(chain.ID, healthy[i].URL).LatencyMs < e.get(chain.ID, healthy[j].URL).LatencyMs
+	})
+	if chain.Selection != LeastLatencySelection && len(healthy) > 1 {
+		// weighted round robin over the healthy endpoints
+		total := 0
+		for _, endpoint := range healthy {
+			total += endpoint.weight()
+		}
+		n := int(e.counters[chain.ID] % uint64(total))
+		e.counters[chain.ID]++
+		for i, endpoint := range healthy {
+			if n -= endpoint.weight(); n < 0 {
+				ordered := append([]HostedEndpoint{endpoint}, healthy[:i]...)
+				healthy = append(ordered, healthy[i+1:]...)
+				break
+			}
+		}
+	}
+	return append(append(trial, healthy...), unhealthy...)
+}
+
+func (e HostedEndpoint) weight() int {
+	if e.Weight <= 0 {
+		return 1
+	}
+	return e.Weight
+}
+
+// "ReportEndpointSuccess" - Records a successful request to the endpoint of the hosted blockchain
+func ReportEndpointSuccess(chainID, url string, latency time.Duration) {
+	globalEndpointStates.update(chainID, url, func(s *EndpointState) {
+		s.Healthy = true
+		s.ConsecutiveFailures = 0
+		if s.LatencyMs == 0 {
+			s.LatencyMs = latency.Milliseconds()
+		} else {
+			s.LatencyMs = (3*s.LatencyMs + latency.Milliseconds()) / 4
+		}
+	})
+}
+
+// "ReportEndpointFailure" - Records a failed request to the endpoint of the hosted blockchain
+func ReportEndpointFailure(chainID, url string) {
+	globalEndpointStates.update(chainID, url, func(s *EndpointState) {
+		s.ConsecutiveFailures++
+		if s.ConsecutiveFailures >= MaxEndpointFailures {
+			s.Healthy = false
+		}
+	})
+}
+
+// "StartEndpointHealthChecks" - Probes every endpoint of the hosted blockchains at the interval (ms)
+func StartEndpointHealthChecks(hostedBlockchains *HostedBlockchains, interval int64) {
+	go func() {
+		for {
+			hostedBlockchains.L.Lock()
+			chains := make(map[string]HostedBlockchain, len(hostedBlockchains.M))
+			for id, chain := range hostedBlockchains.M {
+				chains[id] = chain
+			}
+			hostedBlockchains.L.Unlock()
+			globalEndpointStates.prune(chains)
+			for _, chain := range chains {
+				for _, endpoint := range chain.GetEndpoints() {
+					checkEndpoint(chain, endpoint)
+				}
+			}
+			time.Sleep(time.Duration(interval) * time.Millisecond)
+		}
+	}()
+}
+
+// "checkEndpoint" - An endpoint is healthy if it answers the probe without a server error
+func checkEndpoint(chain HostedBlockchain, endpoint HostedEndpoint) {
+	if chain.Transport == GRPCTransport {
+		start := time.Now()
+		if err := checkGRPCEndpoint(endpoint); err == nil {
+			ReportEndpointSuccess(chain.ID, endpoint.URL, time.Since(start))
+			return
+		}
+		markEndpointUnhealthy(chain.ID, endpoint.URL)
+		return
+	}
+	// without a probe request of the chain the endpoints are judged by the relays only
+	if chain.HealthCheck == nil {
+		return
+	}
+	probe := *chain.HealthCheck
+	if probe.Method == "" {
+		probe.Method = DEFAULTHTTPMETHOD
+	}
+	url := strings.Trim(endpoint.URL, `/`)
+	if len(probe.Path) > 0 {
+		url = url + "/" + strings.Trim(probe.Path, `/`)
+	}
+	start := time.Now()
+	req, err := http.NewRequest(probe.Method, url, bytes.NewBufferString(probe.Data))
+	if err == nil {
+		req.Header.Set("Content-Type", "application/json")
+		if endpoint.BasicAuth.Username != "" {
+			req.SetBasicAuth(endpoint.BasicAuth.Username, endpoint.BasicAuth.Password)
+		}
+		var resp *http.Response
+		resp, err = (&http.Client{Timeout: globalRPCTimeout * time.Millisecond}).Do(req)
+		if err == nil {
+			_ = resp.Body.Close()
+			if resp.StatusCode < http.StatusInternalServerError {
+				ReportEndpointSuccess(chain.ID, endpoint.URL, time.Since(start))
+				return
+			}
+		}
+	}
+	markEndpointUnhealthy(chain.ID, endpoint.URL)
+}
+
+// "markEndpointUnhealthy" - A failed probe marks the endpoint unhealthy right away
+func markEndpointUnhealthy(chainID, url string) {
+	globalEndpointStates.update(chainID, url, func(s *EndpointState) {
+		s.ConsecutiveFailures = MaxEndpointFailures
+		s.Healthy = false
+	})
+}
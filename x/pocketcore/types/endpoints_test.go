@@ -0,0 +1,171 @@
+package types
+
+import (
+	"encoding/hex"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/h2non/gock.v1"
+)
+
+// resets the state of the endpoints shared by the tests
+func resetEndpointStates() {
+	globalEndpointStates.l.Lock()
+	defer globalEndpointStates.l.Unlock()
+	globalEndpointStates.states = make(map[string]*EndpointState)
+	globalEndpointStates.counters = make(map[string]uint64)
+}
+
+func TestOrderEndpoints(t *testing.T) {
+	resetEndpointStates()
+	defer resetEndpointStates()
+	ethereum := hex.EncodeToString([]byte{01})
+	chain := HostedBlockchain{
+		ID: ethereum,
+		Endpoints: []HostedEndpoint{
+			{URL: "https://a.com", Weight: 2},
+			{URL: "https://b.com"},
+			{URL: "https://c.com"},
+		},
+	}
+	// weighted round robin over the healthy endpoints
+	picked := make(map[string]int)
+	for i := 0; i < 8; i++ {
+		endpoints := OrderEndpoints(chain)
+		assert.Len(t, endpoints, 3)
+		picked[endpoints[0].URL]++
+	}
+	assert.Equal(t, 4, picked["https://a.com"])
+	assert.Equal(t, 2, picked["https://b.com"])
+	assert.Equal(t, 2, picked["https://c.com"])
+	// an unhealthy endpoint is tried last
+	for i := 0; i < MaxEndpointFailures; i++ {
+		ReportEndpointFailure(ethereum, "https://a.com")
+	}
+	for i := 0; i < 4; i++ {
+		endpoints := OrderEndpoints(chain)
+		assert.NotEqual(t, "https://a.com", endpoints[0].URL)
+		assert.Equal(t, "https://a.com", endpoints[2].URL)
+	}
+	// after the cooldown the unhealthy endpoint takes a single trial relay
+	globalEndpointStates.l.Lock()
+	globalEndpointStates.states[endpointKey(ethereum, "https://a.com")].LastCheck = time.Now().Add(-EndpointRetryCooldown)
+	globalEndpointStates.l.Unlock()
+	assert.Equal(t, "https://a.com", OrderEndpoints(chain)[0].URL)
+	assert.Equal(t, "https://a.com", OrderEndpoints(chain)[2].URL)
+	// a failed trial restarts the cooldown
+	ReportEndpointFailure(ethereum, "https://a.com")
+	assert.False(t, GetEndpointStates(chain)[0].Healthy)
+	assert.Equal(t, "https://a.com", OrderEndpoints(chain)[2].URL)
+	// least latency picks the fastest healthy endpoint
+	ReportEndpointSuccess(ethereum, "https://b.com", 200*time.Millisecond)
+	ReportEndpointSuccess(ethereum, "https://c.com", 100*time.Millisecond)
+	chain.Selection = LeastLatencySelection
+	assert.Equal(t, "https://c.com", OrderEndpoints(chain)[0].URL)
+	// a success restores the endpoint
+	ReportEndpointSuccess(ethereum, "https://a.com", 50*time.Millisecond)
+	assert.Equal(t, "https://a.com", OrderEndpoints(chain)[0].URL)
+	states := GetEndpointStates(chain)
+	assert.Len(t, states, 3)
+	assert.True(t, states[0].Healthy)
+	assert.Equal(t, int64(50), states[0].LatencyMs)
+}
+
+func TestRelay_ExecuteFailover(t *testing.T) {
+	resetEndpointStates()
+	defer resetEndpointStates()
+	ethereum := hex.EncodeToString([]byte{02})
+	validRelay := Relay{
+		Payload: Payload{Data: "foo", Method: "POST"},
+		Proof:   RelayProof{Blockchain: ethereum},
+	}
+	defer gock.Off()
+	gock.InterceptClient(GetRelayClient(ethereum))
+	gock.New("https://down.com").
+		Post("/relay").
+		Times(2).
+		ReplyError(assert.AnError)
+	gock.New("https://up.com").
+		Post("/relay").
+		Reply(200).
+		BodyString("bar")
+	hb := HostedBlockchains{
+		M: map[string]HostedBlockchain{ethereum: {
+			ID:        ethereum,
+			Selection: LeastLatencySelection,
+			Endpoints: []HostedEndpoint{
+				{URL: "https://down.com/relay/"},
+				{URL: "https://up.com/relay/"},
+			},
+		}},
+		L: sync.Mutex{},
+	}
+	// the relay may have reached the endpoint, so it's not resent without failover
+	_, err := validRelay.Execute(&hb)
+	assert.NotNil(t, err)
+	// the mock of the second endpoint is not consumed
+	assert.Len(t, gock.Pending(), 2)
+	chain := hb.M[ethereum]
+	chain.Failover = true
+	hb.M[ethereum] = chain
+	response, err := validRelay.Execute(&hb)
+	assert.Nil(t, err)
+	assert.Equal(t, "bar", response)
+	states := GetEndpointStates(hb.M[ethereum])
+	assert.Equal(t, 2, states[0].ConsecutiveFailures)
+	assert.Equal(t, 0, states[1].ConsecutiveFailures)
+}
+
+func TestRelay_ExecuteFailoverDialError(t *testing.T) {
+	resetEndpointStates()
+	defer resetEndpointStates()
+	ethereum := hex.EncodeToString([]byte{0x0c})
+	up := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte("bar"))
+	}))
+	defer up.Close()
+	down := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	down.Close()
+	validRelay := Relay{
+		Payload: Payload{Data: "foo", Method: "POST"},
+		Proof:   RelayProof{Blockchain: ethereum},
+	}
+	hb := HostedBlockchains{
+		M: map[string]HostedBlockchain{ethereum: {
+			ID:        ethereum,
+			Selection: LeastLatencySelection,
+			Endpoints: []HostedEndpoint{{URL: down.URL}, {URL: up.URL}},
+		}},
+		L: sync.Mutex{},
+	}
+	// a relay that never reached the endpoint is sent to the next one without failover
+	response, err := validRelay.Execute(&hb)
+	assert.Nil(t, err)
+	assert.Equal(t, "bar", response)
+	assert.Equal(t, 1, GetEndpointStates(hb.M[ethereum])[0].ConsecutiveFailures)
+}
+
+func TestCheckEndpoint(t *testing.T) {
+	resetEndpointStates()
+	defer resetEndpointStates()
+	ethereum := hex.EncodeToString([]byte{0x0d})
+	probes := 0
+	backend := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		probes++
+		w.WriteHeader(http.StatusBadGateway)
+	}))
+	defer backend.Close()
+	chain := HostedBlockchain{ID: ethereum, Endpoints: []HostedEndpoint{{URL: backend.URL}}}
+	// no probe is sent without the health check of the chain
+	checkEndpoint(chain, chain.Endpoints[0])
+	assert.Zero(t, probes)
+	assert.True(t, GetEndpointStates(chain)[0].Healthy)
+	chain.HealthCheck = &HealthCheck{Method: "GET", Path: "/health"}
+	checkEndpoint(chain, chain.Endpoints[0])
+	assert.Equal(t, 1, probes)
+	assert.False(t, GetEndpointStates(chain)[0].Healthy)
+}
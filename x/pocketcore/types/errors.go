@@ -94,6 +94,28 @@ const (
 	CodeInvalidExpirationHeightErr       = 88
 	CodeInvalidMerkleRangeError          = 89
 	CodeEvidenceSealed                   = 90
+	CodeWebsocketNotSupportedError       = 91
+	CodeWebsocketExecutionError          = 92
+	CodeUnackedStreamLimitError          = 93
+	CodeRateLimitError                   = 94
+	CodeRequestTooLargeError             = 95
+	CodeResponseTooLargeError            = 96
+	CodeInvalidGRPCPayloadError          = 97
+	CodeInvalidBackendResponseError      = 98
+	CodeChainDegradedError               = 99
+	CodeRelayLedgerDisabledError         = 100
+	CodeEmptyBatchError                  = 101
+	CodeBatchTooLargeError               = 102
+	CodeBatchSignerError                 = 103
+	CodeDuplicateBatchEntryError         = 104
+	CodeBatchNotActiveError              = 105
+	CodeSnapshotVersionError             = 106
+	CodeSnapshotChecksumError            = 107
+	CodeSnapshotChainIDError             = 108
+	CodeForeignServicerError             = 109
+	CodeClaimMismatchError               = 110
+	CodeEstimateStakeError               = 111
+	CodeGRPCStatusError                  = 112
 )
 
 var (
@@ -186,8 +208,118 @@ var (
 	InvalidExpirationHeightErr       = errors.New("the expiration height included in the claim message is invalid (should not be set)")
 	InvalidMerkleRangeError          = errors.New("the merkle hash range is invalid")
 	SealedEvidenceError              = errors.New("the evidence is sealed, either max relays reached or claim already submitted")
+	WebsocketNotSupportedError       = errors.New("the blockchain requested does not have a websocket url configured on this node")
+	WebsocketExecutionError          = errors.New("error executing the websocket request: ")
+	UnackedStreamLimitError          = errors.New("the max number of unacknowledged streamed messages is exceeded")
+	RateLimitError                   = errors.New("the rate limit of this node is exceeded for the ")
+	RequestTooLargeError             = errors.New("the relay request exceeds the max request size of the blockchain on this node")
+	ResponseTooLargeError            = errors.New("the relay response exceeds the max response size of the blockchain on this node")
+	InvalidBackendResponseError      = errors.New("the response of the blockchain on this node failed validation: ")
+	RelayLedgerDisabledError         = errors.New("the relay ledger is not enabled in the config of this node")
+	ChainDegradedError               = errors.New("the blockchain is degraded on this node until its backend is in sync: ")
+	InvalidGRPCPayloadError          = errors.New("the payload must be a base64 protobuf message with the GRPC method and the /package.Service/Method path for grpc blockchains")
+	EmptyBatchError                  = errors.New("the batch has no entries")
+	BatchTooLargeError               = errors.New("the batch exceeds the max number of entries")
+	BatchSignerError                 = errors.New("every entry of the batch must be signed by the same servicer")
+	DuplicateBatchEntryError         = errors.New("the batch has more than one entry for the same session and evidence type")
+	BatchNotActiveError              = errors.New("batched claims and proofs are not active at this height")
+	SnapshotVersionError             = errors.New("unsupported evidence snapshot version")
+	SnapshotChecksumError            = errors.New("the checksum of the evidence snapshot does not match its content")
+	SnapshotChainIDError             = errors.New("the evidence snapshot was taken on a different chain")
+	ForeignServicerError             = errors.New("the servicer is not hosted by this node")
+	ClaimMismatchError               = errors.New("the evidence does not match the claim in the world state")
+	EstimateStakeError               = errors.New("a positive stake or the address of a staked node is needed to estimate the earnings")
+	GRPCStatusError                  = errors.New("the grpc blockchain returned the status: ")
 )
 
+func NewWebsocketNotSupportedError(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeWebsocketNotSupportedError, WebsocketNotSupportedError.Error())
+}
+
+func NewWebsocketExecutionError(codespace sdk.CodespaceType, err error) sdk.Error {
+	return sdk.NewError(codespace, CodeWebsocketExecutionError, WebsocketExecutionError.Error()+err.Error())
+}
+
+func NewUnackedStreamLimitError(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeUnackedStreamLimitError, UnackedStreamLimitError.Error())
+}
+
+func NewRateLimitError(codespace sdk.CodespaceType, limit string) sdk.Error {
+	return sdk.NewError(codespace, CodeRateLimitError, RateLimitError.Error()+limit)
+}
+
+func NewRequestTooLargeError(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeRequestTooLargeError, RequestTooLargeError.Error())
+}
+
+func NewResponseTooLargeError(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeResponseTooLargeError, ResponseTooLargeError.Error())
+}
+
+func NewInvalidGRPCPayloadError(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidGRPCPayloadError, InvalidGRPCPayloadError.Error())
+}
+
+func NewInvalidBackendResponseError(codespace sdk.CodespaceType, err error) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidBackendResponseError, InvalidBackendResponseError.Error()+err.Error())
+}
+
+func NewChainDegradedError(codespace sdk.CodespaceType, reason string) sdk.Error {
+	return sdk.NewError(codespace, CodeChainDegradedError, ChainDegradedError.Error()+reason)
+}
+
+func NewRelayLedgerDisabledError(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeRelayLedgerDisabledError, RelayLedgerDisabledError.Error())
+}
+
+func NewEmptyBatchError(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeEmptyBatchError, EmptyBatchError.Error())
+}
+
+func NewBatchTooLargeError(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeBatchTooLargeError, BatchTooLargeError.Error())
+}
+
+func NewBatchSignerError(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeBatchSignerError, BatchSignerError.Error())
+}
+
+func NewDuplicateBatchEntryError(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeDuplicateBatchEntryError, DuplicateBatchEntryError.Error())
+}
+
+func NewBatchNotActiveError(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeBatchNotActiveError, BatchNotActiveError.Error())
+}
+
+func NewSnapshotVersionError(codespace sdk.CodespaceType, version int) sdk.Error {
+	return sdk.NewError(codespace, CodeSnapshotVersionError, fmt.Sprintf("%s: %d", SnapshotVersionError.Error(), version))
+}
+
+func NewSnapshotChecksumError(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeSnapshotChecksumError, SnapshotChecksumError.Error())
+}
+
+func NewSnapshotChainIDError(codespace sdk.CodespaceType, chainID string) sdk.Error {
+	return sdk.NewError(codespace, CodeSnapshotChainIDError, fmt.Sprintf("%s: %s", SnapshotChainIDError.Error(), chainID))
+}
+
+func NewForeignServicerError(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeForeignServicerError, ForeignServicerError.Error())
+}
+
+func NewClaimMismatchError(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeClaimMismatchError, ClaimMismatchError.Error())
+}
+
+func NewGRPCStatusError(codespace sdk.CodespaceType, code, message string) sdk.Error {
+	return sdk.NewError(codespace, CodeGRPCStatusError, GRPCStatusError.Error()+code+": "+message)
+}
+
+func NewEstimateStakeError(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeEstimateStakeError, EstimateStakeError.Error())
+}
+
 func NewSealedEvidenceError(codespace sdk.CodespaceType) sdk.Error {
 	return sdk.NewError(codespace, CodeEvidenceSealed, SealedEvidenceError.Error())
 }
@@ -18,22 +18,10 @@ type Evidence struct {
 	EvidenceType  EvidenceType             `json:"evidence_type"`
 }
 
-func (e Evidence) IsSealed() bool {
-	globalEvidenceCache.l.Lock()
-	defer globalEvidenceCache.l.Unlock()
-	_, ok := globalEvidenceSealedMap.Load(e.HashString())
-	return ok
-}
-
-func (e Evidence) Seal() CacheObject {
-	globalEvidenceSealedMap.Store(e.HashString(), struct{}{})
-	return e
-}
-
-// "GenerateMerkleRoot" - Generates the merkle root for an GOBEvidence object
-func (e *Evidence) GenerateMerkleRoot(height int64, maxRelays int64) (root HashRange) {
+// "GenerateMerkleRoot" - Generates the merkle root for an GOBEvidence object of the servicer
+func (e *Evidence) GenerateMerkleRoot(servicer types.Address, height int64, maxRelays int64) (root HashRange) {
 	// seal the evidence in cache/db
-	ev, ok := SealEvidence(*e)
+	ev, ok := SealEvidence(servicer, *e)
 	if !ok {
 		return HashRange{}
 	}
@@ -5,11 +5,15 @@ import (
 	sdk "github.com/pokt-network/pocket-core/types"
 	appexported "github.com/pokt-network/pocket-core/x/apps/exported"
 	authexported "github.com/pokt-network/pocket-core/x/auth/exported"
+	authTypes "github.com/pokt-network/pocket-core/x/auth/types"
 	nodesexported "github.com/pokt-network/pocket-core/x/nodes/exported"
+	nodesTypes "github.com/pokt-network/pocket-core/x/nodes/types"
 )
 
 type PosKeeper interface {
 	RewardForRelays(ctx sdk.Ctx, relays sdk.BigInt, address sdk.Address) sdk.BigInt
+	EstimateReward(ctx sdk.Ctx, relays sdk.BigInt, stake sdk.BigInt) nodesTypes.RewardEstimate
+	ServicerStakeWeight(ctx sdk.Ctx, stake sdk.BigInt) sdk.BigDec
 	GetStakedTokens(ctx sdk.Ctx) sdk.BigInt
 	Validator(ctx sdk.Ctx, addr sdk.Address) nodesexported.ValidatorI
 	TotalTokens(ctx sdk.Ctx) sdk.BigInt
@@ -38,4 +42,5 @@ type PocketKeeper interface {
 type AuthKeeper interface {
 	GetFee(ctx sdk.Ctx, msg sdk.Msg) sdk.BigInt
 	GetAccount(ctx sdk.Ctx, addr sdk.Address) authexported.Account
+	GetFeeAllowance(ctx sdk.Ctx, granter, grantee sdk.Address) (allowance authTypes.FeeAllowance, found bool)
 }
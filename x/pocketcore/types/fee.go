@@ -1,14 +1,18 @@
 package types
 
 const (
-	ClaimFee = 10000 // fee for claim message (in uPOKT)
-	ProofFee = 10000 // fee for proof message (in uPOKT)
+	ClaimFee      = 10000 // fee for claim message (in uPOKT)
+	ProofFee      = 10000 // fee for proof message (in uPOKT)
+	BatchClaimFee = 10000 // fee for batched claim message, a single fee for every claim of the batch (in uPOKT)
+	BatchProofFee = 10000 // fee for batched proof message, a single fee for every proof of the batch (in uPOKT)
 )
 
 var (
 	// map of message name to fee value
 	PocketFeeMap = map[string]int64{
-		MsgClaimName: ClaimFee,
-		MsgProofName: ProofFee,
+		MsgClaimName:      ClaimFee,
+		MsgProofName:      ProofFee,
+		MsgBatchClaimName: BatchClaimFee,
+		MsgBatchProofName: BatchProofFee,
 	}
 )
@@ -0,0 +1,176 @@
+package types
+
+import (
+	"context"
+	"crypto/tls"
+	"encoding/base64"
+	"fmt"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	sdk "github.com/pokt-network/pocket-core/types"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	HTTPTransport = "http"
+	GRPCTransport = "grpc"
+	// the payload method of a grpc relay, the path holds the full method (/package.Service/Method),
+	// the data holds the base64 encoded protobuf message and the headers are sent as metadata
+	GRPCPayloadMethod = "GRPC"
+)
+
+// the pooled grpc connections used to execute relays, keyed by endpoint url
+var globalGRPCConns = struct {
+	l sync.Mutex
+	m map[string]*grpc.ClientConn
+}{m: make(map[string]*grpc.ClientConn)}
+
+// "IsGRPC" - Returns true if the payload is encoded for a grpc relay
+func (p Payload) IsGRPC() bool {
+	return p.Method == GRPCPayloadMethod
+}
+
+// "ValidateGRPC" - Ensures the payload has a full method and a canonical base64 message, so the request hash
+// of a grpc relay can't be altered without changing the message
+func (p Payload) ValidateGRPC() sdk.Error {
+	if !p.IsGRPC() || !strings.HasPrefix(p.Path, "/") || strings.Count(p.Path, "/") != 2 {
+		return NewInvalidGRPCPayloadError(ModuleName)
+	}
+	message, err := base64.StdEncoding.DecodeString(p.Data)
+	if err != nil || base64.StdEncoding.EncodeToString(message) != p.Data {
+		return NewInvalidGRPCPayloadError(ModuleName)
+	}
+	return nil
+}
+
+// "executeGRPCRequest" - Invokes the method of the payload on the endpoint and returns the base64 encoded response
+func executeGRPCRequest(endpoint HostedEndpoint, p Payload, userAgent string, maxResponse int64) (string, error) {
+	message, err := base64.StdEncoding.DecodeString(p.Data)
+	if err != nil {
+		return "", err
+	}
+	conn, err := getGRPCConn(endpoint.URL, userAgent)
+	if err != nil {
+		return "", err
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), globalRPCTimeout*time.Millisecond)
+	defer cancel()
+	ctx = metadata.NewOutgoingContext(ctx, grpcMetadata(endpoint.BasicAuth, p.Headers))
+	opts := []grpc.CallOption{grpc.ForceCodec(rawCodec{})}
+	if maxResponse > 0 {
+		opts = append(opts, grpc.MaxCallRecvMsgSize(int(maxResponse)))
+	}
+	var response []byte
+	if err := conn.Invoke(ctx, p.Path, &message, &response, opts...); err != nil {
+		// the client rejects messages over the receive limit with resource exhausted
+		s, ok := status.FromError(err)
+		if !ok {
+			return "", err
+		}
+		if s.Code() == codes.ResourceExhausted && strings.Contains(s.Message(), "larger than max") {
+			return "", ResponseTooLargeError
+		}
+		// only an unavailable or timed out endpoint is a failure, the other statuses are the answer of the blockchain
+		if s.Code() == codes.Unavailable || s.Code() == codes.DeadlineExceeded {
+			return "", err
+		}
+		return "", NewGRPCStatusError(ModuleName, s.Code().String(), s.Message())
+	}
+	return base64.StdEncoding.EncodeToString(response), nil
+}
+
+// "checkGRPCEndpoint" - Probes the endpoint with the standard grpc health service, servers without it are healthy
+// as long as they answer
+func checkGRPCEndpoint(endpoint HostedEndpoint) error {
+	conn, err := getGRPCConn(endpoint.URL, GlobalPocketConfig.UserAgent)
+	if err != nil {
+		return err
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), globalRPCTimeout*time.Millisecond)
+	defer cancel()
+	ctx = metadata.NewOutgoingContext(ctx, grpcMetadata(endpoint.BasicAuth, nil))
+	resp, err := grpc_health_v1.NewHealthClient(conn).Check(ctx, &grpc_health_v1.HealthCheckRequest{})
+	if status.Code(err) == codes.Unimplemented {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if resp.Status != grpc_health_v1.HealthCheckResponse_SERVING {
+		return fmt.Errorf("the grpc endpoint is %s", resp.Status.String())
+	}
+	return nil
+}
+
+// "getGRPCConn" - Returns the shared connection to the endpoint, dialed lazily
+// endpoints are of the form grpc://host:port or grpcs://host:port for tls
+func getGRPCConn(endpointURL, userAgent string) (*grpc.ClientConn, error) {
+	globalGRPCConns.l.Lock()
+	defer globalGRPCConns.l.Unlock()
+	if conn, found := globalGRPCConns.m[endpointURL]; found {
+		return conn, nil
+	}
+	u, err := url.Parse(endpointURL)
+	if err != nil {
+		return nil, err
+	}
+	opts := []grpc.DialOption{grpc.WithUserAgent(userAgent)}
+	switch u.Scheme {
+	case "grpc":
+		opts = append(opts, grpc.WithInsecure())
+	case "grpcs":
+		opts = append(opts, grpc.WithTransportCredentials(credentials.NewTLS(&tls.Config{InsecureSkipVerify: GlobalPocketConfig.RelayTLSSkipVerify}))) //nolint:gosec // opt-in for self signed backends
+	default:
+		return nil, fmt.Errorf("unsupported grpc endpoint scheme: %s", u.Scheme)
+	}
+	conn, err := grpc.Dial(u.Host, opts...)
+	if err != nil {
+		return nil, err
+	}
+	globalGRPCConns.m[endpointURL] = conn
+	return conn, nil
+}
+
+// "ResetGRPCConns" - Closes the grpc connections, new connections are dialed on the next relay
+func ResetGRPCConns() {
+	globalGRPCConns.l.Lock()
+	defer globalGRPCConns.l.Unlock()
+	for endpointURL, conn := range globalGRPCConns.m {
+		_ = conn.Close()
+		delete(globalGRPCConns.m, endpointURL)
+	}
+}
+
+// "grpcMetadata" - The headers of the payload and the basic auth of the endpoint as grpc metadata
+func grpcMetadata(basicAuth BasicAuth, headers map[string]string) metadata.MD {
+	md := metadata.New(headers)
+	if basicAuth.Username != "" {
+		auth := base64.StdEncoding.EncodeToString([]byte(basicAuth.Username + ":" + basicAuth.Password))
+		md.Set("authorization", "Basic "+auth)
+	}
+	return md
+}
+
+// "rawCodec" - Passes the already encoded protobuf messages through, so the node doesn't need the service definitions
+type rawCodec struct{}
+
+func (rawCodec) Marshal(v interface{}) ([]byte, error) {
+	return *(v.(*[]byte)), nil
+}
+
+func (rawCodec) Unmarshal(data []byte, v interface{}) error {
+	*(v.(*[]byte)) = append([]byte(nil), data...)
+	return nil
+}
+
+func (rawCodec) Name() string {
+	return "proto"
+}
@@ -0,0 +1,86 @@
+package types
+
+import (
+	"encoding/hex"
+	"net"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/health"
+	"google.golang.org/grpc/health/grpc_health_v1"
+)
+
+func TestPayload_ValidateGRPC(t *testing.T) {
+	valid := Payload{Method: GRPCPayloadMethod, Path: "/grpc.health.v1.Health/Check", Data: "CAE="}
+	assert.Nil(t, valid.ValidateGRPC())
+	for _, p := range []Payload{
+		{Method: DEFAULTHTTPMETHOD, Path: valid.Path, Data: valid.Data},
+		{Method: GRPCPayloadMethod, Path: "grpc.health.v1.Health/Check", Data: valid.Data},
+		{Method: GRPCPayloadMethod, Path: "/grpc.health.v1.Health/Check/", Data: valid.Data},
+		{Method: GRPCPayloadMethod, Path: valid.Path, Data: "foo"},
+		// the message must be canonically encoded to keep the request hash deterministic
+		{Method: GRPCPayloadMethod, Path: valid.Path, Data: "CAE"},
+	} {
+		assert.NotNil(t, p.ValidateGRPC(), p)
+	}
+}
+
+func TestRelay_ExecuteGRPC(t *testing.T) {
+	resetEndpointStates()
+	defer resetEndpointStates()
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	assert.Nil(t, err)
+	srv := grpc.NewServer()
+	healthSrv := health.NewServer()
+	healthSrv.SetServingStatus("", grpc_health_v1.HealthCheckResponse_SERVING)
+	grpc_health_v1.RegisterHealthServer(srv, healthSrv)
+	go func() { _ = srv.Serve(lis) }()
+	defer srv.Stop()
+	defer ResetGRPCConns()
+	cosmos := hex.EncodeToString([]byte{05})
+	endpoint := HostedEndpoint{URL: "grpc://" + lis.Addr().String()}
+	hb := HostedBlockchains{
+		M: map[string]HostedBlockchain{cosmos: {
+			ID:        cosmos,
+			Endpoints: []HostedEndpoint{endpoint},
+			Transport: GRPCTransport,
+		}},
+		L: sync.Mutex{},
+	}
+	assert.Nil(t, hb.Validate())
+	relay := Relay{
+		Payload: Payload{Method: GRPCPayloadMethod, Path: "/grpc.health.v1.Health/Check"},
+		Proof:   RelayProof{Blockchain: cosmos},
+	}
+	assert.Nil(t, relay.Payload.ValidateGRPC())
+	res, er := relay.Execute(&hb)
+	assert.Nil(t, er)
+	// the base64 encoded HealthCheckResponse{Status: SERVING}
+	assert.Equal(t, "CAE=", res)
+	assert.Nil(t, checkGRPCEndpoint(endpoint))
+	// the status of the method is returned to the client and is not a failure of the endpoint
+	relay.Payload.Path = "/foo.Bar/Baz"
+	_, er = relay.Execute(&hb)
+	assert.NotNil(t, er)
+	assert.Equal(t, CodeGRPCStatusError, int(er.Code()))
+	assert.Contains(t, er.Error(), codes.Unimplemented.String())
+	assert.Zero(t, GetEndpointStates(hb.M[cosmos])[0].ConsecutiveFailures)
+	// an unavailable endpoint fails over if the chain allows it
+	deadLis, err := net.Listen("tcp", "127.0.0.1:0")
+	assert.Nil(t, err)
+	deadEndpoint := HostedEndpoint{URL: "grpc://" + deadLis.Addr().String()}
+	_ = deadLis.Close()
+	chain := hb.M[cosmos]
+	chain.Endpoints = []HostedEndpoint{deadEndpoint, endpoint}
+	chain.Selection = LeastLatencySelection
+	chain.Failover = true
+	hb.M[cosmos] = chain
+	relay.Payload.Path = "/grpc.health.v1.Health/Check"
+	res, er = relay.Execute(&hb)
+	assert.Nil(t, er)
+	assert.Equal(t, "CAE=", res)
+	assert.Equal(t, 1, GetEndpointStates(chain)[0].ConsecutiveFailures)
+}
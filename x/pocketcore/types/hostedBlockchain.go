@@ -2,14 +2,64 @@ package types
 
 import (
 	sdk "github.com/pokt-network/pocket-core/types"
+	"strings"
 	"sync"
 )
 
 // HostedBlockchain" - An object that represents a local hosted non-native blockchain
 type HostedBlockchain struct {
-	ID        string    `json:"id"`         // network identifier of the hosted blockchain
-	URL       string    `json:"url"`        // url of the hosted blockchain
-	BasicAuth BasicAuth `json:"basic_auth"` // basic http auth optinal
+	ID           string           `json:"id"`                           // network identifier of the hosted blockchain
+	URL          string           `json:"url"`                          // url of the hosted blockchain
+	WebsocketURL string           `json:"websocket_url,omitempty"`      // websocket url of the hosted blockchain (optional)
+	BasicAuth    BasicAuth        `json:"basic_auth"`                   // basic http auth optinal
+	Endpoints    []HostedEndpoint `json:"endpoints,omitempty"`          // backend urls, used instead of url (optional)
+	Selection    string           `json:"selection,omitempty"`          // endpoint selection strategy: round_robin (default) or least_latency
+	Failover     bool             `json:"failover,omitempty"`           // resend a failed relay to the next endpoint, only for idempotent backends (optional)
+	HealthCheck  *HealthCheck     `json:"health_check,omitempty"`       // request used to probe the endpoints, no probe without it (optional)
+	Cache        *RelayCache      `json:"cache,omitempty"`              // response cache for idempotent json-rpc methods (optional)
+	MaxRequest   int64            `json:"max_request_bytes,omitempty"`  // max size of the relay payload, defaults to relay_max_request_bytes
+	MaxResponse  int64            `json:"max_response_bytes,omitempty"` // max size of the relay response, defaults to relay_max_response_bytes
+	Transport    string           `json:"transport,omitempty"`          // relay transport: http (default) or grpc with grpc(s)://host:port urls
+	Validators   []string         `json:"validators,omitempty"`         // checks a response must pass to be signed (e.g. http_status, jsonrpc)
+	SyncCheck    *SyncCheck       `json:"sync_check,omitempty"`         // probe refusing relays while the backend is out of sync (optional)
+}
+
+// "HostedEndpoint" - A single backend url of a hosted blockchain
+type HostedEndpoint struct {
+	URL       string    `json:"url"`              // url of the backend
+	Weight    int       `json:"weight,omitempty"` // relative share of relays in round robin selection, defaults to 1
+	BasicAuth BasicAuth `json:"basic_auth"`       // basic http auth optional
+}
+
+// "HealthCheck" - The request sent to every endpoint of a hosted blockchain by the health checker
+type HealthCheck struct {
+	Method string `json:"method"` // the http CRUD method, defaults to POST
+	Path   string `json:"path"`   // the REST Path
+	Data   string `json:"data"`   // the payload of the probe
+}
+
+// "GetEndpoints" - Returns the backend urls of the hosted blockchain, a chain with a single url has a single endpoint
+func (c HostedBlockchain) GetEndpoints() []HostedEndpoint {
+	if len(c.Endpoints) == 0 {
+		return []HostedEndpoint{{URL: c.URL, Weight: 1, BasicAuth: c.BasicAuth}}
+	}
+	return c.Endpoints
+}
+
+// "RequestLimit" - Returns the max size in bytes of a relay payload for the hosted blockchain (<= 0 is no limit)
+func (c HostedBlockchain) RequestLimit() int64 {
+	if c.MaxRequest > 0 {
+		return c.MaxRequest
+	}
+	return GlobalPocketConfig.RelayMaxRequestBytes
+}
+
+// "ResponseLimit" - Returns the max size in bytes of a relay response for the hosted blockchain (<= 0 is no limit)
+func (c HostedBlockchain) ResponseLimit() int64 {
+	if c.MaxResponse > 0 {
+		return c.MaxResponse
+	}
+	return GlobalPocketConfig.RelayMaxResponseBytes
 }
 
 type BasicAuth struct {
@@ -50,7 +100,40 @@ func (c *HostedBlockchains) GetChainURL(id string) (url string, err sdk.Error) {
 	if err != nil {
 		return "", err
 	}
-	return chain.URL, nil
+	return chain.GetEndpoints()[0].URL, nil
+}
+
+// "GetChainWebsocketURL" - Returns the websocket url or error of the hosted blockchain using the hex network identifier
+func (c *HostedBlockchains) GetChainWebsocketURL(id string) (url string, err sdk.Error) {
+	chain, err := c.GetChain(id)
+	if err != nil {
+		return "", err
+	}
+	if chain.WebsocketURL == "" {
+		return "", NewWebsocketNotSupportedError(ModuleName)
+	}
+	return chain.WebsocketURL, nil
+}
+
+// "MaxRequestLimit" - Returns the largest request limit of the hosted blockchains (<= 0 is no limit), a relay body is
+// read up to it before its chain is known and the limit of the chain is applied once the relay is decoded
+func (c *HostedBlockchains) MaxRequestLimit() int64 {
+	c.L.Lock()
+	defer c.L.Unlock()
+	max := GlobalPocketConfig.RelayMaxRequestBytes
+	if max <= 0 {
+		return 0
+	}
+	for _, chain := range c.M {
+		limit := chain.RequestLimit()
+		if limit <= 0 {
+			return 0
+		}
+		if limit > max {
+			max = limit
+		}
+	}
+	return max
 }
 
 // "Validate" - Validates the hosted blockchain object
@@ -60,9 +143,48 @@ func (c *HostedBlockchains) Validate() error {
 	// loop through all of the chains
 	for _, chain := range c.M {
 		// validate not empty
-		if chain.ID == "" || chain.URL == "" {
+		if chain.ID == "" || (chain.URL == "" && len(chain.Endpoints) == 0) {
 			return NewInvalidHostedChainError(ModuleName)
 		}
+		for _, endpoint := range chain.Endpoints {
+			if endpoint.URL == "" || endpoint.Weight < 0 {
+				return NewInvalidHostedChainError(ModuleName)
+			}
+		}
+		switch chain.Selection {
+		case "", RoundRobinSelection, LeastLatencySelection:
+		default:
+			return NewInvalidHostedChainError(ModuleName)
+		}
+		if chain.MaxRequest < 0 || chain.MaxResponse < 0 {
+			return NewInvalidHostedChainError(ModuleName)
+		}
+		for _, name := range chain.Validators {
+			if _, found := getResponseValidator(name); !found {
+				return NewInvalidHostedChainError(ModuleName)
+			}
+		}
+		if chain.SyncCheck != nil {
+			if _, found := getSyncProbe(chain.SyncCheck.Type); !found || chain.Transport == GRPCTransport || chain.SyncCheck.Interval < 0 {
+				return NewInvalidHostedChainError(ModuleName)
+			}
+		}
+		switch chain.Transport {
+		case "", HTTPTransport:
+		case GRPCTransport:
+			for _, endpoint := range chain.GetEndpoints() {
+				if !strings.HasPrefix(endpoint.URL, "grpc://") && !strings.HasPrefix(endpoint.URL, "grpcs://") {
+					return NewInvalidHostedChainError(ModuleName)
+				}
+			}
+		default:
+			return NewInvalidHostedChainError(ModuleName)
+		}
+		if chain.Cache != nil {
+			if err := chain.Cache.Validate(); err != nil {
+				return err
+			}
+		}
 		// validate the merkleHash
 		if err := NetworkIdentifierVerification(chain.ID); err != nil {
 			return err
@@ -5,6 +5,7 @@ import (
 	"sync"
 	"testing"
 
+	sdk "github.com/pokt-network/pocket-core/types"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -24,6 +25,47 @@ func TestHostedBlockchains_GetChainURL(t *testing.T) {
 	assert.Equal(t, u, url)
 }
 
+func TestHostedBlockchains_GetChainWebsocketURL(t *testing.T) {
+	url := "wss://www.google.com:443"
+	ethereum := hex.EncodeToString([]byte{01})
+	bitcoin := hex.EncodeToString([]byte{02})
+	hb := HostedBlockchains{
+		M: map[string]HostedBlockchain{
+			ethereum: {ID: ethereum, URL: "https://www.google.com:443", WebsocketURL: url},
+			bitcoin:  {ID: bitcoin, URL: "https://www.google.com:443"},
+		},
+		L: sync.Mutex{},
+	}
+	u, err := hb.GetChainWebsocketURL(ethereum)
+	assert.Nil(t, err)
+	assert.Equal(t, u, url)
+	_, err = hb.GetChainWebsocketURL(bitcoin)
+	assert.NotNil(t, err)
+	assert.Equal(t, err.Code(), sdk.CodeType(CodeWebsocketNotSupportedError))
+}
+
+func TestHostedBlockchains_MaxRequestLimit(t *testing.T) {
+	config := GlobalPocketConfig
+	defer func() { GlobalPocketConfig = config }()
+	GlobalPocketConfig.RelayMaxRequestBytes = 100
+	ethereum := hex.EncodeToString([]byte{01})
+	bitcoin := hex.EncodeToString([]byte{02})
+	hb := HostedBlockchains{
+		M: map[string]HostedBlockchain{
+			ethereum: {ID: ethereum, URL: "https://www.google.com:443"},
+			bitcoin:  {ID: bitcoin, URL: "https://www.google.com:443", MaxRequest: 50},
+		},
+		L: sync.Mutex{},
+	}
+	assert.Equal(t, int64(100), hb.MaxRequestLimit())
+	// a chain allowing larger requests raises the limit of the body
+	hb.M[bitcoin] = HostedBlockchain{ID: bitcoin, URL: "https://www.google.com:443", MaxRequest: 1000}
+	assert.Equal(t, int64(1000), hb.MaxRequestLimit())
+	// a chain without limit
+	GlobalPocketConfig.RelayMaxRequestBytes = 0
+	assert.Equal(t, int64(0), hb.MaxRequestLimit())
+}
+
 func TestHostedBlockchains_ContainsFromString(t *testing.T) {
 	url := "https://www.google.com:443"
 	ethereum := hex.EncodeToString([]byte{01})
@@ -59,11 +101,50 @@ func TestHostedBlockchains_Validate(t *testing.T) {
 		ID:  hex.EncodeToString([]byte("badlksajfljasdfklj")),
 		URL: url,
 	}
+	HCEndpoints := HostedBlockchain{
+		ID:        ethereum,
+		Endpoints: []HostedEndpoint{{URL: url, Weight: 2}, {URL: url}},
+	}
+	HCInvalidSelection := HostedBlockchain{
+		ID:        ethereum,
+		Endpoints: []HostedEndpoint{{URL: url}},
+		Selection: "random",
+	}
+	HCEmptyCache := HostedBlockchain{
+		ID:    ethereum,
+		URL:   url,
+		Cache: &RelayCache{MaxEntries: 0},
+	}
+	HCUnknownValidator := HostedBlockchain{
+		ID:         ethereum,
+		URL:        url,
+		Validators: []string{"unknown"},
+	}
+	HCGRPCWithHTTPURL := HostedBlockchain{
+		ID:        ethereum,
+		URL:       url,
+		Transport: GRPCTransport,
+	}
 	tests := []struct {
 		name     string
 		hc       *HostedBlockchains
 		hasError bool
 	}{
+		{
+			name:     "Invalid HostedBlockchain, cache without entries",
+			hc:       &HostedBlockchains{M: map[string]HostedBlockchain{HCEmptyCache.ID: HCEmptyCache}, L: sync.Mutex{}},
+			hasError: true,
+		},
+		{
+			name:     "Invalid HostedBlockchain, unknown response validator",
+			hc:       &HostedBlockchains{M: map[string]HostedBlockchain{HCUnknownValidator.ID: HCUnknownValidator}, L: sync.Mutex{}},
+			hasError: true,
+		},
+		{
+			name:     "Invalid HostedBlockchain, grpc transport with an http url",
+			hc:       &HostedBlockchains{M: map[string]HostedBlockchain{HCGRPCWithHTTPURL.ID: HCGRPCWithHTTPURL}, L: sync.Mutex{}},
+			hasError: true,
+		},
 		{
 			name:     "Invalid HostedBlockchain, no URL",
 			hc:       &HostedBlockchains{M: map[string]HostedBlockchain{HCNoURL.URL: HCNoURL}, L: sync.Mutex{}},
@@ -79,6 +160,16 @@ func TestHostedBlockchains_Validate(t *testing.T) {
 			hc:       &HostedBlockchains{M: map[string]HostedBlockchain{HCInvalidHash.URL: HCInvalidHash}, L: sync.Mutex{}},
 			hasError: true,
 		},
+		{
+			name:     "Invalid HostedBlockchain, invalid selection",
+			hc:       &HostedBlockchains{M: map[string]HostedBlockchain{HCInvalidSelection.ID: HCInvalidSelection}, L: sync.Mutex{}},
+			hasError: true,
+		},
+		{
+			name:     "Valid HostedBlockchain with endpoints",
+			hc:       &HostedBlockchains{M: map[string]HostedBlockchain{HCEndpoints.ID: HCEndpoints}, L: sync.Mutex{}},
+			hasError: false,
+		},
 		{
 			name:     "Valid HostedBlockchain",
 			hc:       &HostedBlockchains{M: map[string]HostedBlockchain{testHostedBlockchain.ID: testHostedBlockchain}, L: sync.Mutex{}},
@@ -0,0 +1,262 @@
+package types
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/csv"
+	"encoding/json"
+	"strconv"
+	"sync"
+
+	sdk "github.com/pokt-network/pocket-core/types"
+	"github.com/tendermint/tendermint/config"
+	db "github.com/tendermint/tm-db"
+)
+
+const RelayLedgerDBName = "relay_ledger"
+
+var (
+	globalRelayLedger *RelayLedger
+	relayLedgerPrefix = []byte{0x01}
+)
+
+// "RelayLedger" - A local record of the relays served and the rewards earned per session. The changes of a session are
+// appended as records and never updated or pruned, the entries are built from them when queried
+type RelayLedger struct {
+	DB db.DB
+	l  sync.Mutex
+}
+
+// the kinds of ledger record
+const (
+	ClaimLedgerRecord  = "claim"
+	ProofLedgerRecord  = "proof"
+	MintedLedgerRecord = "minted"
+)
+
+// "LedgerRecord" - A change of the relay evidence of a session, in the order it was recorded
+type LedgerRecord struct {
+	Type        string     `json:"type"`
+	Height      int64      `json:"height"`
+	TxHash      string     `json:"tx_hash,omitempty"`
+	RelayCount  int64      `json:"relay_count,omitempty"`
+	MintedUPOKT sdk.BigInt `json:"minted_upokt"`
+}
+
+// "storedLedgerRecord" - A record as stored in the ledger, with the session it belongs to
+type storedLedgerRecord struct {
+	Servicer           string `json:"servicer"`
+	ApplicationPubKey  string `json:"app_public_key"`
+	Chain              string `json:"chain"`
+	SessionBlockHeight int64  `json:"session_height"`
+	LedgerRecord
+}
+
+// "LedgerEntry" - The lifecycle of the relay evidence of a session, the latest record of each type sets its fields
+type LedgerEntry struct {
+	Servicer           string         `json:"servicer"`
+	ApplicationPubKey  string         `json:"app_public_key"`
+	Chain              string         `json:"chain"`
+	SessionBlockHeight int64          `json:"session_height"`
+	RelayCount         int64          `json:"relay_count"`
+	ClaimTxHash        string         `json:"claim_tx_hash"`
+	ClaimHeight        int64          `json:"claim_height"`
+	ProofTxHash        string         `json:"proof_tx_hash"`
+	ProofHeight        int64          `json:"proof_height"`
+	MintedUPOKT        sdk.BigInt     `json:"minted_upokt"`
+	MintedHeight       int64          `json:"minted_height"`
+	Records            []LedgerRecord `json:"records"`
+}
+
+// "LedgerFilter" - Filters the ledger entries, empty fields match everything
+type LedgerFilter struct {
+	Servicer          string `json:"servicer"`
+	ApplicationPubKey string `json:"app_public_key"`
+	Chain             string `json:"chain"`
+	FromHeight        int64  `json:"from_height"` // inclusive session height
+	ToHeight          int64  `json:"to_height"`   // inclusive session height, 0 is no upper bound
+}
+
+// "InitRelayLedger" - Opens the relay ledger in the data directory
+func InitRelayLedger(dir string, options config.LevelDBOptions) {
+	database, err := sdk.NewLevelDB(RelayLedgerDBName, dir, options.ToGoLevelDBOpts())
+	if err != nil {
+		panic(err)
+	}
+	globalRelayLedger = &RelayLedger{DB: database}
+}
+
+// "RecordClaim" - Records the relays claimed by the servicer for the session and the hash of the claim transaction
+func RecordClaim(servicer sdk.Address, header SessionHeader, relayCount int64, txHash string, height int64) {
+	appendLedgerRecord(servicer, header, LedgerRecord{Type: ClaimLedgerRecord, Height: height, TxHash: txHash, RelayCount: relayCount, MintedUPOKT: sdk.ZeroInt()})
+}
+
+// "RecordProof" - Records the hash of the proof transaction of the servicer for the session
+func RecordProof(servicer sdk.Address, header SessionHeader, txHash string, height int64) {
+	appendLedgerRecord(servicer, header, LedgerRecord{Type: ProofLedgerRecord, Height: height, TxHash: txHash, MintedUPOKT: sdk.ZeroInt()})
+}
+
+// "RecordMinted" - Records the uPOKT minted to the servicer for the relays of the session
+func RecordMinted(servicer sdk.Address, header SessionHeader, tokens sdk.BigInt, height int64) {
+	appendLedgerRecord(servicer, header, LedgerRecord{Type: MintedLedgerRecord, Height: height, MintedUPOKT: tokens})
+}
+
+// "appendLedgerRecord" - Appends the record to the records of the session, a no-op if the ledger is disabled.
+// A record equal to the latest one of its type isn't appended, so replaying blocks doesn't duplicate the records
+func appendLedgerRecord(servicer sdk.Address, header SessionHeader, record LedgerRecord) {
+	rl := globalRelayLedger
+	if rl == nil {
+		return
+	}
+	rl.l.Lock()
+	defer rl.l.Unlock()
+	prefix := ledgerKey(servicer, header)
+	it, err := rl.DB.Iterator(prefix, sdk.PrefixEndBytes(prefix))
+	if err != nil {
+		return
+	}
+	var latest *LedgerRecord
+	count := uint64(0)
+	for ; it.Valid(); it.Next() {
+		var r LedgerRecord
+		if err := json.Unmarshal(it.Value(), &r); err == nil && r.Type == record.Type {
+			latest = &r
+		}
+		count++
+	}
+	it.Close()
+	if latest != nil && latest.Height == record.Height && latest.TxHash == record.TxHash &&
+		latest.RelayCount == record.RelayCount && latest.MintedUPOKT.Equal(record.MintedUPOKT) {
+		return
+	}
+	bz, err := json.Marshal(storedLedgerRecord{
+		Servicer:           servicer.String(),
+		ApplicationPubKey:  header.ApplicationPubKey,
+		Chain:              header.Chain,
+		SessionBlockHeight: header.SessionBlockHeight,
+		LedgerRecord:       record,
+	})
+	if err != nil {
+		return
+	}
+	seq := make([]byte, 8)
+	binary.BigEndian.PutUint64(seq, count)
+	_ = rl.DB.SetSync(append(prefix, seq...), bz)
+}
+
+// "QueryRelayLedger" - Returns the entries matching the filter ordered by session height, with their records
+func QueryRelayLedger(filter LedgerFilter) ([]LedgerEntry, error) {
+	entries := make([]LedgerEntry, 0)
+	rl := globalRelayLedger
+	if rl == nil {
+		return entries, NewRelayLedgerDisabledError(ModuleName)
+	}
+	start := append(append([]byte{}, relayLedgerPrefix...), heightKey(filter.FromHeight)...)
+	end := sdk.PrefixEndBytes(relayLedgerPrefix)
+	if filter.ToHeight > 0 {
+		end = append(append([]byte{}, relayLedgerPrefix...), heightKey(filter.ToHeight+1)...)
+	}
+	it, err := rl.DB.Iterator(start, end)
+	if err != nil {
+		return nil, err
+	}
+	defer it.Close()
+	// the records of a session are contiguous, they share the key of the session
+	var sessionKey []byte
+	var entry *LedgerEntry
+	for ; it.Valid(); it.Next() {
+		var record storedLedgerRecord
+		if err := json.Unmarshal(it.Value(), &record); err != nil {
+			return nil, err
+		}
+		key := it.Key()
+		if entry == nil || !bytes.Equal(sessionKey, key[:len(key)-8]) {
+			if entry != nil && filter.match(*entry) {
+				entries = append(entries, *entry)
+			}
+			sessionKey = append([]byte{}, key[:len(key)-8]...)
+			entry = newLedgerEntry(record)
+		}
+		entry.apply(record.LedgerRecord)
+	}
+	if entry != nil && filter.match(*entry) {
+		entries = append(entries, *entry)
+	}
+	return entries, nil
+}
+
+// "newLedgerEntry" - Returns the entry of the session of the record, without records
+func newLedgerEntry(r storedLedgerRecord) *LedgerEntry {
+	return &LedgerEntry{
+		Servicer:           r.Servicer,
+		ApplicationPubKey:  r.ApplicationPubKey,
+		Chain:              r.Chain,
+		SessionBlockHeight: r.SessionBlockHeight,
+		MintedUPOKT:        sdk.ZeroInt(),
+		Records:            make([]LedgerRecord, 0),
+	}
+}
+
+// "apply" - Appends the record to the entry and sets the fields of its type
+func (e *LedgerEntry) apply(r LedgerRecord) {
+	e.Records = append(e.Records, r)
+	switch r.Type {
+	case ClaimLedgerRecord:
+		e.RelayCount, e.ClaimTxHash, e.ClaimHeight = r.RelayCount, r.TxHash, r.Height
+	case ProofLedgerRecord:
+		e.ProofTxHash, e.ProofHeight = r.TxHash, r.Height
+	case MintedLedgerRecord:
+		e.MintedUPOKT, e.MintedHeight = r.MintedUPOKT, r.Height
+	}
+}
+
+// "match" - Returns true if the entry passes the filter
+func (filter LedgerFilter) match(e LedgerEntry) bool {
+	return (filter.Servicer == "" || e.Servicer == filter.Servicer) &&
+		(filter.ApplicationPubKey == "" || e.ApplicationPubKey == filter.ApplicationPubKey) &&
+		(filter.Chain == "" || e.Chain == filter.Chain)
+}
+
+// "LedgerEntriesToCSV" - Exports the entries as csv with a header row
+func LedgerEntriesToCSV(entries []LedgerEntry) (string, error) {
+	buf := new(bytes.Buffer)
+	w := csv.NewWriter(buf)
+	rows := [][]string{{"servicer", "app_public_key", "chain", "session_height", "relay_count", "claim_tx_hash", "claim_height", "proof_tx_hash", "proof_height", "minted_upokt", "minted_height"}}
+	for _, e := range entries {
+		rows = append(rows, []string{
+			e.Servicer,
+			e.ApplicationPubKey,
+			e.Chain,
+			strconv.FormatInt(e.SessionBlockHeight, 10),
+			strconv.FormatInt(e.RelayCount, 10),
+			e.ClaimTxHash,
+			strconv.FormatInt(e.ClaimHeight, 10),
+			e.ProofTxHash,
+			strconv.FormatInt(e.ProofHeight, 10),
+			e.MintedUPOKT.String(),
+			strconv.FormatInt(e.MintedHeight, 10),
+		})
+	}
+	if err := w.WriteAll(rows); err != nil {
+		return "", err
+	}
+	return buf.String(), nil
+}
+
+// "ledgerKey" - The key of the session, its records are stored under it with their sequence number. Sessions are
+// ordered by height so height ranges are a single iteration
+func ledgerKey(servicer sdk.Address, header SessionHeader) []byte {
+	key := append(append([]byte{}, relayLedgerPrefix...), heightKey(header.SessionBlockHeight)...)
+	key = append(key, []byte(header.Chain)...)
+	key = append(key, []byte(header.ApplicationPubKey)...)
+	return append(key, servicer...)
+}
+
+func heightKey(height int64) []byte {
+	if height < 0 {
+		height = 0
+	}
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, uint64(height))
+	return bz
+}
@@ -0,0 +1,97 @@
+package types
+
+import (
+	"strings"
+	"testing"
+
+	sdk "github.com/pokt-network/pocket-core/types"
+	"github.com/stretchr/testify/assert"
+	db "github.com/tendermint/tm-db"
+)
+
+func TestRelayLedger_Disabled(t *testing.T) {
+	servicer := sdk.Address(getRandomPubKey().Address())
+	globalRelayLedger = nil
+	header := SessionHeader{ApplicationPubKey: "aa", Chain: "0001", SessionBlockHeight: 1}
+	RecordClaim(servicer, header, 10, "claim", 2)
+	_, err := QueryRelayLedger(LedgerFilter{})
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), RelayLedgerDisabledError.Error())
+}
+
+func TestRelayLedger_RecordAndQuery(t *testing.T) {
+	servicer := sdk.Address(getRandomPubKey().Address())
+	globalRelayLedger = &RelayLedger{DB: db.NewMemDB()}
+	defer func() { globalRelayLedger = nil }()
+	h1 := SessionHeader{ApplicationPubKey: "aa", Chain: "0001", SessionBlockHeight: 1}
+	h2 := SessionHeader{ApplicationPubKey: "bb", Chain: "0001", SessionBlockHeight: 5}
+	h3 := SessionHeader{ApplicationPubKey: "aa", Chain: "0002", SessionBlockHeight: 9}
+	RecordClaim(servicer, h1, 10, "claim1", 2)
+	RecordProof(servicer, h1, "proof1", 4)
+	RecordMinted(servicer, h1, sdk.NewInt(100), 4)
+	// replaying the block must not accumulate
+	RecordMinted(servicer, h1, sdk.NewInt(100), 4)
+	RecordClaim(servicer, h2, 20, "claim2", 6)
+	RecordClaim(servicer, h3, 30, "claim3", 10)
+	entries, err := QueryRelayLedger(LedgerFilter{})
+	assert.Nil(t, err)
+	assert.Len(t, entries, 3)
+	assert.Equal(t, LedgerEntry{
+		Servicer:           servicer.String(),
+		ApplicationPubKey:  "aa",
+		Chain:              "0001",
+		SessionBlockHeight: 1,
+		RelayCount:         10,
+		ClaimTxHash:        "claim1",
+		ClaimHeight:        2,
+		ProofTxHash:        "proof1",
+		ProofHeight:        4,
+		MintedUPOKT:        sdk.NewInt(100),
+		MintedHeight:       4,
+		Records: []LedgerRecord{
+			{Type: ClaimLedgerRecord, Height: 2, TxHash: "claim1", RelayCount: 10, MintedUPOKT: sdk.ZeroInt()},
+			{Type: ProofLedgerRecord, Height: 4, TxHash: "proof1", MintedUPOKT: sdk.ZeroInt()},
+			{Type: MintedLedgerRecord, Height: 4, MintedUPOKT: sdk.NewInt(100)},
+		},
+	}, entries[0])
+	assert.Equal(t, int64(5), entries[1].SessionBlockHeight)
+	assert.Equal(t, int64(9), entries[2].SessionBlockHeight)
+	entries, err = QueryRelayLedger(LedgerFilter{ApplicationPubKey: "aa"})
+	assert.Nil(t, err)
+	assert.Len(t, entries, 2)
+	entries, err = QueryRelayLedger(LedgerFilter{Chain: "0001"})
+	assert.Nil(t, err)
+	assert.Len(t, entries, 2)
+	entries, err = QueryRelayLedger(LedgerFilter{FromHeight: 2, ToHeight: 5})
+	assert.Nil(t, err)
+	assert.Len(t, entries, 1)
+	assert.Equal(t, "bb", entries[0].ApplicationPubKey)
+	// a new claim of the session is appended, the entry shows the latest one
+	RecordClaim(servicer, h2, 25, "claim2b", 8)
+	entries, err = QueryRelayLedger(LedgerFilter{ApplicationPubKey: "bb"})
+	assert.Nil(t, err)
+	assert.Len(t, entries, 1)
+	assert.Equal(t, int64(25), entries[0].RelayCount)
+	assert.Equal(t, "claim2b", entries[0].ClaimTxHash)
+	assert.Equal(t, int64(8), entries[0].ClaimHeight)
+	assert.Len(t, entries[0].Records, 2)
+	assert.Equal(t, "claim2", entries[0].Records[0].TxHash)
+}
+
+func TestRelayLedger_CSV(t *testing.T) {
+	res, err := LedgerEntriesToCSV([]LedgerEntry{{
+		Servicer:           "ab",
+		ApplicationPubKey:  "aa",
+		Chain:              "0001",
+		SessionBlockHeight: 1,
+		RelayCount:         10,
+		ClaimTxHash:        "claim1",
+		ClaimHeight:        2,
+		MintedUPOKT:        sdk.NewInt(100),
+	}})
+	assert.Nil(t, err)
+	lines := strings.Split(strings.TrimSpace(res), "\n")
+	assert.Len(t, lines, 2)
+	assert.True(t, strings.HasPrefix(lines[0], "servicer,app_public_key,chain,session_height"))
+	assert.Equal(t, "ab,aa,0001,1,10,claim1,2,,0,100,0", lines[1])
+}
@@ -10,6 +10,7 @@ import (
 	"testing"
 	"time"
 
+	sdk "github.com/pokt-network/pocket-core/types"
 	"github.com/stretchr/testify/assert"
 	"github.com/willf/bloom"
 )
@@ -46,6 +47,7 @@ func TestEvidence_GenerateMerkleRoot(t *testing.T) {
 	clientPrivateKey := GetRandomPrivateKey()
 	clientPublicKey := clientPrivateKey.PublicKey().RawString()
 	nodePubKey := getRandomPubKey()
+	servicer := sdk.Address(nodePubKey.Address())
 	ethereum := hex.EncodeToString([]byte{01})
 	validAAT := AAT{
 		Version:              "0.0.1",
@@ -115,7 +117,7 @@ func TestEvidence_GenerateMerkleRoot(t *testing.T) {
 			},
 		},
 	}
-	root := i.GenerateMerkleRoot(0, 5)
+	root := i.GenerateMerkleRoot(servicer, 0, 5)
 	assert.NotNil(t, root.Hash)
 	assert.NotEmpty(t, root.Hash)
 	assert.Nil(t, HashVerification(hex.EncodeToString(root.Hash)))
@@ -123,13 +125,13 @@ func TestEvidence_GenerateMerkleRoot(t *testing.T) {
 	assert.Zero(t, root.Range.Lower)
 	assert.NotZero(t, root.Range.Upper)
 
-	iter := EvidenceIterator()
+	iter := EvidenceIterator(servicer)
 	// Make sure its stored in order!
 	defer iter.Close()
 	for ; iter.Valid(); iter.Next() {
 		e := iter.Value()
 		assert.Equal(t, i, e)
-		newRoot := e.GenerateMerkleRoot(0, 5)
+		newRoot := e.GenerateMerkleRoot(servicer, 0, 5)
 		assert.Equal(t, root, newRoot)
 	}
 }
@@ -222,6 +224,7 @@ func TestEvidence_VerifyMerkleProof(t *testing.T) {
 	clientPrivateKey := GetRandomPrivateKey()
 	clientPublicKey := clientPrivateKey.PublicKey().RawString()
 	nodePubKey := getRandomPubKey()
+	servicer := sdk.Address(nodePubKey.Address())
 	ethereum := hex.EncodeToString([]byte{01})
 	validAAT := AAT{
 		Version:              "0.0.1",
@@ -382,15 +385,16 @@ func TestEvidence_VerifyMerkleProof(t *testing.T) {
 				Signature:          "",
 			},
 		},
+		EvidenceType: RelayEvidence,
 	}
 	index := 4
-	root := i.GenerateMerkleRoot(0, 9)
+	root := i.GenerateMerkleRoot(servicer, 0, 9)
 	proofs, leaf := i.GenerateMerkleProof(0, index, 9)
 	// validate level count on claim by total relays
 	res, _ := proofs.Validate(0, root, leaf, len(proofs.HashRanges))
 	assert.True(t, res)
 	index2 := 0
-	root2 := i2.GenerateMerkleRoot(0, 9)
+	root2 := i2.GenerateMerkleRoot(servicer, 0, 9)
 	proofs2, leaf2 := i2.GenerateMerkleProof(0, index2, 9)
 	res, _ = proofs2.Validate(0, root2, leaf2, len(proofs2.HashRanges))
 	assert.True(t, res)
@@ -8,7 +8,11 @@ import (
 	"github.com/prometheus/client_golang/prometheus/promhttp"
 	"github.com/tendermint/tendermint/libs/log"
 	"net/http"
+	"net/url"
+	"strconv"
 	"sync"
+
+	sdk "github.com/pokt-network/pocket-core/types"
 )
 
 var (
@@ -18,26 +22,68 @@ var (
 const (
 	ServiceMetricsKey       = "service"
 	ServiceMetricsNamespace = ServiceMetricsKey
-	RelayCountName          = "relay_count_for_"
-	RelayCountHelp          = "the number of relays executed against: "
-	ChallengeCountName      = "challenge_count_for_"
-	ChallengeCountHelp      = "the number of challenges executed against: "
-	ErrCountName            = "err_count_for_"
-	ErrCountHelp            = "the number of errors resulting from relays executed against: "
-	AvgRelayHistName        = "avg_relay_time_for_"
-	AvgrelayHistHelp        = "the average relay time in ms executed against: "
-	SessionsCountName       = "sessions_count_for_"
-	SessionsCountHelp       = "the number of unique sessions generated for: "
-	UPOKTCountName          = "tokens_earned_for_"
-	UPOKTCountHelp          = "the number of tokens earned in uPOKT for : "
+	// the legacy metrics, one family per hosted chain, only emitted with prometheus_legacy_metrics
+	RelayCountName     = "relay_count_for_"
+	RelayCountHelp     = "the number of relays executed against: "
+	ChallengeCountName = "challenge_count_for_"
+	ChallengeCountHelp = "the number of challenges executed against: "
+	ErrCountName       = "err_count_for_"
+	ErrCountHelp       = "the number of errors resulting from relays executed against: "
+	AvgRelayHistName   = "avg_relay_time_for_"
+	AvgrelayHistHelp   = "the average relay time in ms executed against: "
+	SessionsCountName  = "sessions_count_for_"
+	SessionsCountHelp  = "the number of unique sessions generated for: "
+	UPOKTCountName     = "tokens_earned_for_"
+	UPOKTCountHelp     = "the number of tokens earned in uPOKT for : "
+	// the labeled metrics
+	RelaysName          = "relays_total"
+	RelaysHelp          = "the number of relays handled, by hosted chain, app and result code (0 is success)"
+	ChallengesName      = "challenges_total"
+	ChallengesHelp      = "the number of challenges handled, by hosted chain"
+	SessionsName        = "sessions_total"
+	SessionsHelp        = "the number of unique sessions generated, by hosted chain and app"
+	RelayLatencyName    = "relay_latency_ms"
+	RelayLatencyHelp    = "the total time in ms to handle a relay, from validation to signing, by hosted chain"
+	BackendLatencyName  = "backend_latency_ms"
+	BackendLatencyHelp  = "the time in ms the backend endpoint of the hosted chain took to answer a relay"
+	TokensEarnedName    = "tokens_earned_upokt"
+	TokensEarnedHelp    = "the tokens in uPOKT minted for the relays of this node since start, by hosted chain and app"
+	EndpointHealthyName = "endpoint_healthy"
+	EndpointHealthyHelp = "1 if the backend endpoint of the hosted chain is healthy, 0 otherwise"
+	EndpointLatencyName = "endpoint_latency"
+	EndpointLatencyHelp = "the moving average response time in ms of the backend endpoint of the hosted chain"
+	ChainDegradedName   = "chain_degraded"
+	ChainDegradedHelp   = "1 if the backend of the hosted chain is out of sync and relays are refused, 0 otherwise"
+	RateLimitedName     = "rate_limited"
+	RateLimitedHelp     = "the number of relay and dispatch requests rejected by the rate limits of the node"
+	ChainLabel          = "chain"
+	AppLabel            = "app"
+	CodeLabel           = "code"
+	EndpointLabel       = "endpoint"
+	LimitLabel          = "limit"
 )
 
+// the buckets of the latency histograms in ms, from a cached response to a slow archival query
+var LatencyBuckets = []float64{5, 10, 25, 50, 100, 250, 500, 1000, 2500, 5000, 10000, 30000}
+
 type ServiceMetrics struct {
-	l               sync.Mutex
-	tmLogger        log.Logger
-	ServiceMetric   `json:"accumulated_service_metrics"` // total metrics
-	NonNativeChains map[string]ServiceMetric             `json:"individual_service_metrics"` // metrics per chain
-	prometheusSrv   *http.Server
+	l                 sync.Mutex
+	tmLogger          log.Logger
+	legacy            bool                                 // emit the legacy per chain metric families
+	hostedBlockchains *HostedBlockchains                   // only hosted chains are used as label values
+	ServiceMetric     `json:"accumulated_service_metrics"` // total metrics (legacy)
+	NonNativeChains   map[string]ServiceMetric             `json:"individual_service_metrics"` // metrics per chain (legacy)
+	Relays            metrics.Counter                      `json:"-"`                          // relays per chain, app and result code
+	Challenges        metrics.Counter                      `json:"-"`                          // challenges per chain
+	Sessions          metrics.Counter                      `json:"-"`                          // sessions per chain and app
+	RelayLatency      metrics.Histogram                    `json:"-"`                          // total relay latency per chain
+	BackendLatency    metrics.Histogram                    `json:"-"`                          // backend latency per chain endpoint
+	TokensEarned      metrics.Gauge                        `json:"-"`                          // tokens earned per chain and app
+	EndpointHealthy   metrics.Gauge                        `json:"-"`                          // health per chain endpoint
+	EndpointLatency   metrics.Gauge                        `json:"-"`                          // latency per chain endpoint
+	RateLimited       metrics.Counter                      `json:"-"`                          // rejected requests per chain and limit
+	ChainDegraded     metrics.Gauge                        `json:"-"`                          // sync state per chain
+	prometheusSrv     *http.Server
 }
 
 type ServiceMetricsEncodable struct {
@@ -49,9 +95,9 @@ func GlobalServiceMetric() *ServiceMetrics {
 	return globalServiceMetrics
 }
 
-func InitGlobalServiceMetric(hostedBlockchains *HostedBlockchains, logger log.Logger, addr string, maxOpenConn int) {
+func InitGlobalServiceMetric(hostedBlockchains *HostedBlockchains, logger log.Logger, addr string, maxOpenConn int, legacy bool) {
 	// create a new service metric
-	serviceMetric := NewServiceMetrics(hostedBlockchains, logger)
+	serviceMetric := NewServiceMetrics(hostedBlockchains, logger, legacy)
 	// set the service metrics
 	globalServiceMetrics = serviceMetric
 	// start metrics server
@@ -85,127 +131,209 @@ func (sm *ServiceMetrics) StartPrometheusServer(addr string, maxOpenConn int) *h
 	return srv
 }
 
-func (sm *ServiceMetrics) AddRelayFor(networkID string) {
-	sm.l.Lock()
-	defer sm.l.Unlock()
-	// attempt to locate nn chain
-	nnc, ok := sm.NonNativeChains[networkID]
-	if !ok {
-		sm.tmLogger.Error("unable to find corresponding networkID in service metrics: ", networkID)
-		sm.NonNativeChains[networkID] = NewServiceMetricsFor(networkID)
-		return
-	}
-	// add relay to accumulated count
-	sm.RelayCount.Add(1)
-	// add to individual relay count
-	nnc.RelayCount.Add(1)
-	// update nnc
-	sm.NonNativeChains[networkID] = nnc
+// "AddRelayFor" - Counts a relay served successfully
+func (sm *ServiceMetrics) AddRelayFor(networkID, appPubKey string) {
+	sm.Relays.With(ChainLabel, sm.chainLabel(networkID), AppLabel, appPubKey, CodeLabel, "0").Add(1)
+	sm.legacyFor(networkID, func(m ServiceMetric) {
+		m.RelayCount.Add(1)
+	})
+}
+
+// "AddErrorFor" - Counts a relay that failed after validation with the code of the error
+func (sm *ServiceMetrics) AddErrorFor(networkID, appPubKey string, err sdk.Error) {
+	sm.Relays.With(ChainLabel, sm.chainLabel(networkID), AppLabel, appPubKey, CodeLabel, codeLabelValue(err)).Add(1)
+	sm.legacyFor(networkID, func(m ServiceMetric) {
+		m.ErrCount.Add(1)
+	})
+}
+
+// "AddRejectedRelayFor" - Counts a relay that failed validation, the app is not labeled as it isn't verified yet
+func (sm *ServiceMetrics) AddRejectedRelayFor(networkID string, err sdk.Error) {
+	sm.Relays.With(ChainLabel, sm.chainLabel(networkID), AppLabel, "", CodeLabel, codeLabelValue(err)).Add(1)
 }
 
 func (sm *ServiceMetrics) AddChallengeFor(networkID string) {
+	sm.Challenges.With(ChainLabel, sm.chainLabel(networkID)).Add(1)
+	sm.legacyFor(networkID, func(m ServiceMetric) {
+		m.ChallengeCount.Add(1)
+	})
+}
+
+// "AddRelayTimingFor" - Observes the total time in ms to handle a relay
+func (sm *ServiceMetrics) AddRelayTimingFor(networkID string, relayTime float64) {
+	sm.RelayLatency.With(ChainLabel, sm.chainLabel(networkID)).Observe(relayTime)
+	sm.legacyFor(networkID, func(m ServiceMetric) {
+		m.AverageRelayTime.Observe(relayTime)
+	})
+}
+
+// "AddBackendTimingFor" - Observes the time in ms the endpoint of the hosted chain took to answer
+func (sm *ServiceMetrics) AddBackendTimingFor(networkID, endpointURL string, backendTime float64) {
+	sm.BackendLatency.With(ChainLabel, sm.chainLabel(networkID), EndpointLabel, endpointLabelValue(endpointURL)).Observe(backendTime)
+}
+
+func (sm *ServiceMetrics) AddSessionFor(networkID, appPubKey string) {
+	sm.Sessions.With(ChainLabel, sm.chainLabel(networkID), AppLabel, appPubKey).Add(1)
+	sm.legacyFor(networkID, func(m ServiceMetric) {
+		m.TotalSessions.Add(1)
+	})
+}
+
+// "AddUPOKTEarnedFor" - Adds the tokens minted for the relays of the session
+func (sm *ServiceMetrics) AddUPOKTEarnedFor(networkID, appPubKey string, upoktEarned float64) {
+	sm.TokensEarned.With(ChainLabel, sm.chainLabel(networkID), AppLabel, appPubKey).Add(upoktEarned)
+	sm.legacyFor(networkID, func(m ServiceMetric) {
+		m.UPOKTEarned.Add(upoktEarned)
+	})
+}
+
+// "legacyFor" - Applies f to the accumulated and the per chain legacy metrics of a hosted chain
+func (sm *ServiceMetrics) legacyFor(networkID string, f func(m ServiceMetric)) {
+	// every legacy chain registers new metric families, so unknown chains are never added
+	if !sm.legacy || sm.chainLabel(networkID) == "" {
+		return
+	}
 	sm.l.Lock()
 	defer sm.l.Unlock()
-	// attempt to locate nn chain
 	nnc, ok := sm.NonNativeChains[networkID]
 	if !ok {
-		sm.tmLogger.Error("unable to find corresponding networkID in service metrics: ", networkID)
-		sm.NonNativeChains[networkID] = NewServiceMetricsFor(networkID)
-		return
+		// the chain was hosted after start
+		nnc = NewServiceMetricsFor(networkID)
+		sm.NonNativeChains[networkID] = nnc
 	}
-	// add to accumulated count
-	sm.ChallengeCount.Add(1)
-	// add to individual count
-	nnc.ChallengeCount.Add(1)
-	// update nnc
-	sm.NonNativeChains[networkID] = nnc
+	f(sm.ServiceMetric)
+	f(nnc)
 }
 
-func (sm *ServiceMetrics) AddErrorFor(networkID string) {
-	sm.l.Lock()
-	defer sm.l.Unlock()
-	// attempt to locate nn chain
-	nnc, ok := sm.NonNativeChains[networkID]
-	if !ok {
-		sm.tmLogger.Error("unable to find corresponding networkID in service metrics: ", networkID)
-		sm.NonNativeChains[networkID] = NewServiceMetricsFor(networkID)
-		return
+// "chainLabel" - Only hosted chains are used as label values, as the chain of a request is not always validated
+func (sm *ServiceMetrics) chainLabel(networkID string) string {
+	if sm.hostedBlockchains == nil || !sm.hostedBlockchains.Contains(networkID) {
+		return ""
 	}
-	// add to accumulated count
-	sm.ErrCount.Add(1)
-	// add to individual count
-	nnc.ErrCount.Add(1)
-	// update nnc
-	sm.NonNativeChains[networkID] = nnc
+	return networkID
 }
 
-func (sm *ServiceMetrics) AddRelayTimingFor(networkID string, relayTime float64) {
-	sm.l.Lock()
-	defer sm.l.Unlock()
-	// attempt to locate nn chain
-	nnc, ok := sm.NonNativeChains[networkID]
-	if !ok {
-		sm.tmLogger.Error("unable to find corresponding networkID in service metrics: ", networkID)
-		sm.NonNativeChains[networkID] = NewServiceMetricsFor(networkID)
-		return
+func (sm *ServiceMetrics) SetEndpointStateFor(networkID string, state EndpointState) {
+	labels := []string{ChainLabel, networkID, EndpointLabel, endpointLabelValue(state.URL)}
+	healthy := 0.0
+	if state.Healthy {
+		healthy = 1
 	}
-	// add to accumulated hist
-	sm.AverageRelayTime.Observe(relayTime)
-	// add to individual hist
-	nnc.AverageRelayTime.Observe(relayTime)
-	// update nnc
-	sm.NonNativeChains[networkID] = nnc
+	sm.EndpointHealthy.With(labels...).Set(healthy)
+	sm.EndpointLatency.With(labels...).Set(float64(state.LatencyMs))
 }
 
-func (sm *ServiceMetrics) AddSessionFor(networkID string) {
-	sm.l.Lock()
-	defer sm.l.Unlock()
-	// attempt to locate nn chain
-	nnc, ok := sm.NonNativeChains[networkID]
-	if !ok {
-		sm.tmLogger.Error("unable to find corresponding networkID in service metrics: ", networkID)
-		sm.NonNativeChains[networkID] = NewServiceMetricsFor(networkID)
+func (sm *ServiceMetrics) SetChainDegradedFor(networkID string, degraded bool) {
+	value := 0.0
+	if degraded {
+		value = 1
+	}
+	sm.ChainDegraded.With(ChainLabel, networkID).Set(value)
+}
 
-		return
+func (sm *ServiceMetrics) AddRateLimitedFor(networkID string, limit string) {
+	sm.RateLimited.With(ChainLabel, sm.chainLabel(networkID), LimitLabel, limit).Add(1)
+}
+
+// "codeLabelValue" - The code of the error, prefixed by the codespace unless it is the pocketcore module
+func codeLabelValue(err sdk.Error) string {
+	if err == nil {
+		return "0"
 	}
-	// add to accumulated count
-	sm.TotalSessions.Add(1)
-	// add to individual count
-	nnc.TotalSessions.Add(1)
-	// update nnc
-	sm.NonNativeChains[networkID] = nnc
+	code := strconv.FormatUint(uint64(err.Code()), 10)
+	if err.Codespace() != ModuleName {
+		return string(err.Codespace()) + "/" + code
+	}
+	return code
 }
 
-func (sm *ServiceMetrics) AddUPOKTEarnedFor(networkID string, upoktEarned float64) {
-	sm.l.Lock()
-	defer sm.l.Unlock()
-	// attempt to locate nn chain
-	nnc, ok := sm.NonNativeChains[networkID]
-	if !ok {
-		sm.tmLogger.Error("unable to find corresponding networkID in service metrics: ", networkID)
-		sm.NonNativeChains[networkID] = NewServiceMetricsFor(networkID)
-		return
+// "endpointLabelValue" - Only the scheme and host of the endpoint are exposed, as the path may hold api keys
+func endpointLabelValue(rawURL string) string {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return ""
 	}
-	// add to accumulated count
-	sm.UPOKTEarned.Add(1)
-	// add to individual count
-	nnc.UPOKTEarned.Add(1)
-	// update nnc
-	sm.NonNativeChains[networkID] = nnc
+	return u.Scheme + "://" + u.Host
 }
 
 func KeyForServiceMetrics() []byte {
 	return []byte(ServiceMetricsKey)
 }
 
-func NewServiceMetrics(hostedBlockchains *HostedBlockchains, logger log.Logger) *ServiceMetrics {
+func NewServiceMetrics(hostedBlockchains *HostedBlockchains, logger log.Logger, legacy bool) *ServiceMetrics {
 	serviceMetrics := ServiceMetrics{
-		ServiceMetric:   NewServiceMetricsFor("all"),
-		NonNativeChains: make(map[string]ServiceMetric),
+		legacy:            legacy,
+		hostedBlockchains: hostedBlockchains,
+		NonNativeChains:   make(map[string]ServiceMetric),
+		Relays: prometheus.NewCounterFrom(stdPrometheus.CounterOpts{
+			Namespace: ModuleName,
+			Subsystem: ServiceMetricsNamespace,
+			Name:      RelaysName,
+			Help:      RelaysHelp,
+		}, []string{ChainLabel, AppLabel, CodeLabel}),
+		Challenges: prometheus.NewCounterFrom(stdPrometheus.CounterOpts{
+			Namespace: ModuleName,
+			Subsystem: ServiceMetricsNamespace,
+			Name:      ChallengesName,
+			Help:      ChallengesHelp,
+		}, []string{ChainLabel}),
+		Sessions: prometheus.NewCounterFrom(stdPrometheus.CounterOpts{
+			Namespace: ModuleName,
+			Subsystem: ServiceMetricsNamespace,
+			Name:      SessionsName,
+			Help:      SessionsHelp,
+		}, []string{ChainLabel, AppLabel}),
+		RelayLatency: prometheus.NewHistogramFrom(stdPrometheus.HistogramOpts{
+			Namespace: ModuleName,
+			Subsystem: ServiceMetricsNamespace,
+			Name:      RelayLatencyName,
+			Help:      RelayLatencyHelp,
+			Buckets:   LatencyBuckets,
+		}, []string{ChainLabel}),
+		BackendLatency: prometheus.NewHistogramFrom(stdPrometheus.HistogramOpts{
+			Namespace: ModuleName,
+			Subsystem: ServiceMetricsNamespace,
+			Name:      BackendLatencyName,
+			Help:      BackendLatencyHelp,
+			Buckets:   LatencyBuckets,
+		}, []string{ChainLabel, EndpointLabel}),
+		TokensEarned: prometheus.NewGaugeFrom(stdPrometheus.GaugeOpts{
+			Namespace: ModuleName,
+			Subsystem: ServiceMetricsNamespace,
+			Name:      TokensEarnedName,
+			Help:      TokensEarnedHelp,
+		}, []string{ChainLabel, AppLabel}),
+		EndpointHealthy: prometheus.NewGaugeFrom(stdPrometheus.GaugeOpts{
+			Namespace: ModuleName,
+			Subsystem: ServiceMetricsNamespace,
+			Name:      EndpointHealthyName,
+			Help:      EndpointHealthyHelp,
+		}, []string{ChainLabel, EndpointLabel}),
+		EndpointLatency: prometheus.NewGaugeFrom(stdPrometheus.GaugeOpts{
+			Namespace: ModuleName,
+			Subsystem: ServiceMetricsNamespace,
+			Name:      EndpointLatencyName,
+			Help:      EndpointLatencyHelp,
+		}, []string{ChainLabel, EndpointLabel}),
+		ChainDegraded: prometheus.NewGaugeFrom(stdPrometheus.GaugeOpts{
+			Namespace: ModuleName,
+			Subsystem: ServiceMetricsNamespace,
+			Name:      ChainDegradedName,
+			Help:      ChainDegradedHelp,
+		}, []string{ChainLabel}),
+		RateLimited: prometheus.NewCounterFrom(stdPrometheus.CounterOpts{
+			Namespace: ModuleName,
+			Subsystem: ServiceMetricsNamespace,
+			Name:      RateLimitedName,
+			Help:      RateLimitedHelp,
+		}, []string{ChainLabel, LimitLabel}),
 	}
-	if hostedBlockchains != nil {
-		for _, hb := range hostedBlockchains.M {
-			serviceMetrics.NonNativeChains[hb.ID] = NewServiceMetricsFor(hb.ID)
+	if legacy {
+		serviceMetrics.ServiceMetric = NewServiceMetricsFor("all")
+		if hostedBlockchains != nil {
+			for _, hb := range hostedBlockchains.M {
+				serviceMetrics.NonNativeChains[hb.ID] = NewServiceMetricsFor(hb.ID)
+			}
 		}
 	}
 	// add the logger
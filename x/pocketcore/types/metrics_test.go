@@ -0,0 +1,70 @@
+package types
+
+import (
+	"testing"
+
+	sdk "github.com/pokt-network/pocket-core/types"
+	stdPrometheus "github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
+	"github.com/stretchr/testify/assert"
+)
+
+// "gatherMetric" - Returns the value of the metric family with the labels, nil if it was never emitted
+func gatherMetric(t *testing.T, name string, labels map[string]string) *dto.Metric {
+	families, err := stdPrometheus.DefaultGatherer.Gather()
+	assert.Nil(t, err)
+	for _, family := range families {
+		if family.GetName() != ModuleName+"_"+ServiceMetricsNamespace+"_"+name {
+			continue
+		}
+	metrics:
+		for _, m := range family.GetMetric() {
+			if len(m.GetLabel()) != len(labels) {
+				continue
+			}
+			for _, l := range m.GetLabel() {
+				if labels[l.GetName()] != l.GetValue() {
+					continue metrics
+				}
+			}
+			return m
+		}
+	}
+	return nil
+}
+
+func TestServiceMetrics_Labels(t *testing.T) {
+	sm := GlobalServiceMetric()
+	sm.hostedBlockchains.L.Lock()
+	sm.hostedBlockchains.M["0021"] = HostedBlockchain{ID: "0021", URL: "https://www.google.com:443"}
+	sm.hostedBlockchains.L.Unlock()
+	defer func() {
+		sm.hostedBlockchains.L.Lock()
+		delete(sm.hostedBlockchains.M, "0021")
+		sm.hostedBlockchains.L.Unlock()
+	}()
+	// the amount is added, not the number of calls
+	sm.AddUPOKTEarnedFor("0021", "aa", 250)
+	sm.AddUPOKTEarnedFor("0021", "aa", 250)
+	m := gatherMetric(t, TokensEarnedName, map[string]string{ChainLabel: "0021", AppLabel: "aa"})
+	assert.NotNil(t, m)
+	assert.Equal(t, float64(500), m.GetGauge().GetValue())
+	// the legacy family of the chain is created lazily
+	m = gatherMetric(t, UPOKTCountName+"0021", map[string]string{})
+	assert.NotNil(t, m)
+	assert.Equal(t, float64(500), m.GetCounter().GetValue())
+	// results are labeled by code
+	sm.AddRelayFor("0021", "aa")
+	sm.AddErrorFor("0021", "aa", NewResponseTooLargeError(ModuleName))
+	m = gatherMetric(t, RelaysName, map[string]string{ChainLabel: "0021", AppLabel: "aa", CodeLabel: "0"})
+	assert.NotNil(t, m)
+	assert.Equal(t, float64(1), m.GetCounter().GetValue())
+	m = gatherMetric(t, RelaysName, map[string]string{ChainLabel: "0021", AppLabel: "aa", CodeLabel: "96"})
+	assert.NotNil(t, m)
+	assert.Equal(t, float64(1), m.GetCounter().GetValue())
+	// chains that are not hosted are not used as label values
+	sm.AddRejectedRelayFor("not_hosted", sdk.ErrInternal("error"))
+	m = gatherMetric(t, RelaysName, map[string]string{ChainLabel: "", AppLabel: "", CodeLabel: "sdk/1"})
+	assert.NotNil(t, m)
+	assert.Nil(t, gatherMetric(t, RelayCountName+"not_hosted", map[string]string{}))
+}
@@ -10,9 +10,12 @@ import (
 
 // RouterKey is the module name router key
 const (
-	RouterKey    = ModuleName // router name is module name
-	MsgClaimName = "claim"    // name for the claim message
-	MsgProofName = "proof"    // name for the proof message
+	RouterKey         = ModuleName    // router name is module name
+	MsgClaimName      = "claim"       // name for the claim message
+	MsgProofName      = "proof"       // name for the proof message
+	MsgBatchClaimName = "batch_claim" // name for the batched claim message
+	MsgBatchProofName = "batch_proof" // name for the batched proof message
+	MaxBatchEntries   = 100           // max number of claims or proofs in a batch
 )
 
 // "GetFee" - Returns the fee (sdk.BigInt) of the messgae type
@@ -207,3 +210,152 @@ func (msg MsgProof) GetRecipient() sdk.Address {
 func (msg MsgProof) GetLeaf() Proof {
 	return msg.Leaf
 }
+
+// ---------------------------------------------------------------------------------------------------------------------
+
+// "GetFee" - Returns the fee (sdk.BigInt) of the messgae type
+func (msg MsgBatchClaim) GetFee() sdk.BigInt {
+	return sdk.NewInt(PocketFeeMap[msg.Type()])
+}
+
+// "Route" - Returns module router key
+func (msg MsgBatchClaim) Route() string { return RouterKey }
+
+// "Type" - Returns message name
+func (msg MsgBatchClaim) Type() string { return MsgBatchClaimName }
+
+// "ValidateBasic" - Storeless validity check for the batched claim message, every claim must be valid on its own,
+// signed by the same servicer and for a different session
+func (msg MsgBatchClaim) ValidateBasic() sdk.Error {
+	if len(msg.Claims) == 0 {
+		return NewEmptyBatchError(ModuleName)
+	}
+	if len(msg.Claims) > MaxBatchEntries {
+		return NewBatchTooLargeError(ModuleName)
+	}
+	seen := make(map[string]struct{}, len(msg.Claims))
+	for _, claim := range msg.Claims {
+		if err := claim.ValidateBasic(); err != nil {
+			return err
+		}
+		if !claim.FromAddress.Equals(msg.Claims[0].FromAddress) {
+			return NewBatchSignerError(ModuleName)
+		}
+		key := batchEntryKey(claim.SessionHeader, claim.EvidenceType)
+		if _, found := seen[key]; found {
+			return NewDuplicateBatchEntryError(ModuleName)
+		}
+		seen[key] = struct{}{}
+	}
+	return nil
+}
+
+// "GetSignBytes" - Encodes the message for signing
+func (msg MsgBatchClaim) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// "GetSigners" - Defines whose signature is required
+func (msg MsgBatchClaim) GetSigners() []sdk.Address {
+	if len(msg.Claims) == 0 {
+		return []sdk.Address{}
+	}
+	return []sdk.Address{msg.Claims[0].FromAddress}
+}
+
+// "GetRecipient" - Returns the recipient of the message
+func (msg MsgBatchClaim) GetRecipient() sdk.Address {
+	return nil
+}
+
+// ---------------------------------------------------------------------------------------------------------------------
+
+// "NewMsgBatchProof" - Returns the batched proof message of the proofs
+func NewMsgBatchProof(proofs []MsgProof) MsgBatchProof {
+	msg := MsgBatchProof{Proofs: make([]MsgProtoProof, 0, len(proofs))}
+	for _, proof := range proofs {
+		msg.Proofs = append(msg.Proofs, proof.ToProto())
+	}
+	return msg
+}
+
+// "ProofMsgs" - Returns every proof of the batch as a proof message
+func (msg MsgBatchProof) ProofMsgs() []MsgProof {
+	res := make([]MsgProof, 0, len(msg.Proofs))
+	for _, p := range msg.Proofs {
+		res = append(res, MsgProof{
+			MerkleProof:  p.MerkleProof,
+			Leaf:         p.Leaf.FromProto(),
+			EvidenceType: p.EvidenceType,
+		})
+	}
+	return res
+}
+
+// "GetFee" - Returns the fee (sdk.BigInt) of the messgae type
+func (msg MsgBatchProof) GetFee() sdk.BigInt {
+	return sdk.NewInt(PocketFeeMap[msg.Type()])
+}
+
+// "Route" - Returns module router key
+func (msg MsgBatchProof) Route() string { return RouterKey }
+
+// "Type" - Returns message name
+func (msg MsgBatchProof) Type() string { return MsgBatchProofName }
+
+// "ValidateBasic" - Storeless validity check for the batched proof message, every proof must be valid on its own,
+// signed by the same servicer and for a different claim
+func (msg MsgBatchProof) ValidateBasic() sdk.Error {
+	if len(msg.Proofs) == 0 {
+		return NewEmptyBatchError(ModuleName)
+	}
+	if len(msg.Proofs) > MaxBatchEntries {
+		return NewBatchTooLargeError(ModuleName)
+	}
+	proofs := msg.ProofMsgs()
+	seen := make(map[string]struct{}, len(proofs))
+	for _, proof := range proofs {
+		if proof.Leaf == nil {
+			return NewInvalidProofsError(ModuleName)
+		}
+		if err := proof.ValidateBasic(); err != nil {
+			return err
+		}
+		if !proof.Leaf.GetSigner().Equals(proofs[0].Leaf.GetSigner()) {
+			return NewBatchSignerError(ModuleName)
+		}
+		key := batchEntryKey(proof.Leaf.SessionHeader(), proof.EvidenceType)
+		if _, found := seen[key]; found {
+			return NewDuplicateBatchEntryError(ModuleName)
+		}
+		seen[key] = struct{}{}
+	}
+	return nil
+}
+
+// "GetSignBytes" - Encodes the message for signing
+func (msg MsgBatchProof) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// "GetSigners" - Defines whose signature is required
+func (msg MsgBatchProof) GetSigners() []sdk.Address {
+	if len(msg.Proofs) == 0 {
+		return []sdk.Address{}
+	}
+	leaf := msg.Proofs[0].Leaf.FromProto()
+	if leaf == nil {
+		return []sdk.Address{}
+	}
+	return []sdk.Address{leaf.GetSigner()}
+}
+
+// "GetRecipient" - Returns the recipient of the message
+func (msg MsgBatchProof) GetRecipient() sdk.Address {
+	return nil
+}
+
+// "batchEntryKey" - Identifies the session and evidence type of a batch entry
+func batchEntryKey(header SessionHeader, evidenceType EvidenceType) string {
+	return fmt.Sprintf("%s/%d", header.HashString(), evidenceType)
+}
@@ -317,3 +317,105 @@ func TestMsgProof_GetSignBytes(t *testing.T) {
 		MsgProof{}.GetSignBytes()
 	})
 }
+
+func TestMsgBatchClaim_ValidateBasic(t *testing.T) {
+	nodeAddress := getRandomValidatorAddress()
+	newClaim := func(from types.Address) MsgClaim {
+		return MsgClaim{
+			SessionHeader: SessionHeader{
+				ApplicationPubKey:  getRandomPubKey().RawString(),
+				Chain:              hex.EncodeToString([]byte{01}),
+				SessionBlockHeight: 1,
+			},
+			MerkleRoot:   HashRange{Hash: Hash([]byte("fakeRoot")), Range: Range{Upper: 100}},
+			TotalProofs:  100,
+			FromAddress:  from,
+			EvidenceType: RelayEvidence,
+		}
+	}
+	claim1, claim2 := newClaim(nodeAddress), newClaim(nodeAddress)
+	invalidClaim := newClaim(nodeAddress)
+	invalidClaim.TotalProofs = -1
+	tooLarge := make([]MsgClaim, MaxBatchEntries+1)
+	for i := range tooLarge {
+		tooLarge[i] = newClaim(nodeAddress)
+	}
+	tests := []struct {
+		name string
+		msg  MsgBatchClaim
+		err  types.Error
+	}{
+		{"Invalid Batch Claim Message, empty", MsgBatchClaim{}, NewEmptyBatchError(ModuleName)},
+		{"Invalid Batch Claim Message, too large", MsgBatchClaim{Claims: tooLarge}, NewBatchTooLargeError(ModuleName)},
+		{"Invalid Batch Claim Message, invalid claim", MsgBatchClaim{Claims: []MsgClaim{claim1, invalidClaim}}, NewEmptyProofsError(ModuleName)},
+		{"Invalid Batch Claim Message, signer", MsgBatchClaim{Claims: []MsgClaim{claim1, newClaim(getRandomValidatorAddress())}}, NewBatchSignerError(ModuleName)},
+		{"Invalid Batch Claim Message, duplicate", MsgBatchClaim{Claims: []MsgClaim{claim1, claim2, claim1}}, NewDuplicateBatchEntryError(ModuleName)},
+		{"Valid Batch Claim Message", MsgBatchClaim{Claims: []MsgClaim{claim1, claim2}}, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err == nil {
+				assert.Nil(t, err)
+				return
+			}
+			assert.NotNil(t, err)
+			assert.Equal(t, tt.err.Code(), err.Code())
+		})
+	}
+	assert.Equal(t, []types.Address{nodeAddress}, MsgBatchClaim{Claims: []MsgClaim{claim1, claim2}}.GetSigners())
+}
+
+func TestMsgBatchProof_ProofMsgs(t *testing.T) {
+	servicerPubKey := getRandomPubKey()
+	clientPrivKey := GetRandomPrivateKey()
+	appPrivKey := GetRandomPrivateKey()
+	leaf := RelayProof{
+		Entropy:            1,
+		RequestHash:        servicerPubKey.RawString(), // fake
+		SessionBlockHeight: 1,
+		ServicerPubKey:     servicerPubKey.RawString(),
+		Blockchain:         hex.EncodeToString([]byte{01}),
+		Token: AAT{
+			Version:              "0.0.1",
+			ApplicationPublicKey: appPrivKey.PublicKey().RawString(),
+			ClientPublicKey:      clientPrivKey.PublicKey().RawString(),
+		},
+	}
+	appSig, er := appPrivKey.Sign(leaf.Token.Hash())
+	if er != nil {
+		t.Fatalf(er.Error())
+	}
+	leaf.Token.ApplicationSignature = hex.EncodeToString(appSig)
+	clientSig, er := clientPrivKey.Sign(leaf.Hash())
+	if er != nil {
+		t.Fatalf(er.Error())
+	}
+	leaf.Signature = hex.EncodeToString(clientSig)
+	proof := MsgProof{
+		MerkleProof: MerkleProof{
+			TargetIndex: 0,
+			HashRanges: []HashRange{
+				{Hash: merkleHash([]byte("fake1")), Range: Range{0, 1}},
+				{Hash: merkleHash([]byte("fake2")), Range: Range{1, 2}},
+				{Hash: merkleHash([]byte("fake3")), Range: Range{2, 3}},
+			},
+			Target: HashRange{Hash: merkleHash([]byte("fake4")), Range: Range{3, 4}},
+		},
+		Leaf:         leaf,
+		EvidenceType: RelayEvidence,
+	}
+	assert.Nil(t, NewMsgBatchProof([]MsgProof{proof}).ValidateBasic())
+	msg := NewMsgBatchProof([]MsgProof{proof, proof})
+	proofs := msg.ProofMsgs()
+	assert.Len(t, proofs, 2)
+	for _, p := range proofs {
+		assert.Equal(t, proof.MerkleProof, p.MerkleProof)
+		assert.Equal(t, proof.EvidenceType, p.EvidenceType)
+		assert.Equal(t, leaf.Hash(), p.Leaf.Hash())
+	}
+	assert.Equal(t, []types.Address{types.Address(servicerPubKey.Address())}, msg.GetSigners())
+	// the same proof twice is refused
+	assert.Equal(t, NewDuplicateBatchEntryError(ModuleName).Code(), msg.ValidateBasic().Code())
+	assert.Equal(t, NewEmptyBatchError(ModuleName).Code(), MsgBatchProof{}.ValidateBasic().Code())
+}
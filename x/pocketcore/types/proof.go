@@ -19,7 +19,7 @@ type Proof interface {
 	GetSigner() sdk.Address                                                                              // returns the main signer(s) for the proof (used in messages)
 	SessionHeader() SessionHeader                                                                        // returns the session header
 	Validate(appSupportedBlockchains []string, sessionNodeCount int, sessionBlockHeight int64) sdk.Error // validate the object
-	Store(max sdk.BigInt)                                                                                // handle the proof after validation
+	Store(servicer sdk.Address, max sdk.BigInt)                                                          // handle the proof after validation
 	ToProto() ProofI                                                                                     // convert to protobuf
 }
 
@@ -210,10 +210,10 @@ func (rp RelayProof) HashStringWithSignature() string {
 	return hex.EncodeToString(rp.HashWithSignature())
 }
 
-// "Store" - Handles the relay proof object by adding it to the cache
-func (rp RelayProof) Store(maxRelays sdk.BigInt) {
+// "Store" - Handles the relay proof object by adding it to the cache of the servicer
+func (rp RelayProof) Store(servicer sdk.Address, maxRelays sdk.BigInt) {
 	// add the Proof to the global (in memory) collection of proofs
-	SetProof(rp.SessionHeader(), RelayEvidence, rp, maxRelays)
+	SetProof(servicer, rp.SessionHeader(), RelayEvidence, rp, maxRelays)
 }
 
 func (rp RelayProof) GetSigner() sdk.Address {
@@ -245,7 +245,7 @@ func (c ChallengeProofInvalidData) ValidateLocal(h SessionHeader, maxRelays sdk.
 	// calculate the maximum possible challenges
 	maxPossibleChallenges := maxRelays.ToDec().Quo(sdk.NewDec(int64(len(supportedBlockchains)))).Quo(sdk.NewDec(int64(sessionNodeCount))).RoundInt()
 	// check for overflow on # of proofs
-	evidence, er := GetEvidence(h, ChallengeEvidence, maxPossibleChallenges)
+	evidence, er := GetEvidence(selfAddr, h, ChallengeEvidence, maxPossibleChallenges)
 	if er != nil {
 		return sdk.ErrInternal(er.Error())
 	}
@@ -447,10 +447,10 @@ func (c ChallengeProofInvalidData) GetSigner() sdk.Address {
 	return c.ReporterAddress
 }
 
-// "Store" - Stores the challenge proof (stores in cache)
-func (c ChallengeProofInvalidData) Store(maxChallenges sdk.BigInt) {
+// "Store" - Stores the challenge proof (stores in the cache of the servicer)
+func (c ChallengeProofInvalidData) Store(servicer sdk.Address, maxChallenges sdk.BigInt) {
 	// add the Proof to the global (in memory) collection of proofs
-	SetProof(c.SessionHeader(), ChallengeEvidence, c, maxChallenges)
+	SetProof(servicer, c.SessionHeader(), ChallengeEvidence, c, maxChallenges)
 }
 
 func (c ChallengeProofInvalidData) ToProto() ProofI {
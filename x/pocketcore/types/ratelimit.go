@@ -0,0 +1,109 @@
+package types
+
+import (
+	"math"
+	"sync"
+	"time"
+
+	sdk "github.com/pokt-network/pocket-core/types"
+)
+
+const (
+	AppRateLimit    = "app"
+	ClientRateLimit = "client"
+	IPRateLimit     = "ip"
+)
+
+// the token buckets of the rate limits, bounded by an lru per limit so unique keys can't exhaust memory
+var globalRateLimits = struct {
+	l sync.Mutex
+	m map[string]*sdk.Cache
+}{m: make(map[string]*sdk.Cache)}
+
+type tokenBucket struct {
+	tokens float64
+	last   time.Time
+}
+
+// "rateLimit" - The bucket of the key under the limit, refilled at rate tokens per second
+type rateLimit struct {
+	name string
+	key  string
+	rate int
+}
+
+// "CheckIPRateLimit" - Takes a token from the bucket of the source ip, the only limit checked before a relay is validated
+func CheckIPRateLimit(networkID, ip string) sdk.Error {
+	return checkRateLimits(networkID, time.Now(), rateLimit{IPRateLimit, ip, GlobalPocketConfig.RelayRateLimitIP})
+}
+
+// "CheckRelayRateLimits" - Takes a token from the buckets of the app and the client of a relay, the keys are only
+// trusted once the relay is validated, so a client can't exhaust the limits of another app
+func CheckRelayRateLimits(networkID, appPubKey, clientPubKey string) sdk.Error {
+	return checkRateLimits(networkID, time.Now(),
+		rateLimit{AppRateLimit, appPubKey, GlobalPocketConfig.RelayRateLimitApp},
+		rateLimit{ClientRateLimit, clientPubKey, GlobalPocketConfig.RelayRateLimitClient},
+	)
+}
+
+// "ResetRateLimits" - Drops the token buckets, new buckets are created with the current settings
+func ResetRateLimits() {
+	globalRateLimits.l.Lock()
+	defer globalRateLimits.l.Unlock()
+	globalRateLimits.m = make(map[string]*sdk.Cache)
+}
+
+// "checkRateLimits" - Takes a token from the bucket of every enabled limit with a non empty key, or none of them and
+// returns an error on the first exhausted limit
+func checkRateLimits(networkID string, now time.Time, limits ...rateLimit) sdk.Error {
+	exhausted := func() string {
+		globalRateLimits.l.Lock()
+		defer globalRateLimits.l.Unlock()
+		buckets := make([]*tokenBucket, 0, len(limits))
+		for _, limit := range limits {
+			if limit.rate <= 0 || limit.key == "" {
+				continue
+			}
+			b := refillBucket(limit, now)
+			if b.tokens < 1 {
+				return limit.name
+			}
+			buckets = append(buckets, b)
+		}
+		for _, b := range buckets {
+			b.tokens--
+		}
+		return ""
+	}()
+	if exhausted != "" {
+		GlobalServiceMetric().AddRateLimitedFor(networkID, exhausted)
+		return NewRateLimitError(ModuleName, exhausted)
+	}
+	return nil
+}
+
+// "refillBucket" - Returns the bucket of the key refilled up to now, a new bucket starts full (must hold the lock)
+func refillBucket(limit rateLimit, now time.Time) *tokenBucket {
+	burst := float64(GlobalPocketConfig.RelayRateLimitBurst)
+	if burst <= 0 {
+		burst = float64(limit.rate)
+	}
+	buckets, found := globalRateLimits.m[limit.name]
+	if !found {
+		size := GlobalPocketConfig.RelayRateLimitMaxKeys
+		if size <= 0 {
+			size = sdk.DefaultRelayRateLimitMaxKeys
+		}
+		buckets = sdk.NewCache(size)
+		globalRateLimits.m[limit.name] = buckets
+	}
+	b := &tokenBucket{tokens: burst, last: now}
+	if v, found := buckets.Get(limit.key); found {
+		b = v.(*tokenBucket)
+	} else {
+		buckets.Add(limit.key, b)
+	}
+	b.tokens = math.Min(burst, b.tokens+now.Sub(b.last).Seconds()*float64(limit.rate))
+	b.last = now
+	return b
+}
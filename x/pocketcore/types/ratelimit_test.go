@@ -0,0 +1,50 @@
+package types
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCheckRateLimits_Buckets(t *testing.T) {
+	defer ResetRateLimits()
+	now := time.Now()
+	foo, bar := rateLimit{AppRateLimit, "foo", 2}, rateLimit{AppRateLimit, "bar", 2}
+	// a new bucket starts full
+	for i := 0; i < 2; i++ {
+		assert.Nil(t, checkRateLimits("0001", now, foo))
+	}
+	assert.NotNil(t, checkRateLimits("0001", now, foo))
+	// other keys have their own bucket
+	assert.Nil(t, checkRateLimits("0001", now, bar))
+	// the bucket refills at the rate
+	assert.Nil(t, checkRateLimits("0001", now.Add(500*time.Millisecond), foo))
+	assert.NotNil(t, checkRateLimits("0001", now.Add(500*time.Millisecond), foo))
+	// no token is taken when one of the limits is exhausted
+	baz := rateLimit{ClientRateLimit, "baz", 2}
+	assert.NotNil(t, checkRateLimits("0001", now.Add(500*time.Millisecond), baz, foo))
+	assert.Nil(t, checkRateLimits("0001", now.Add(500*time.Millisecond), baz))
+	assert.Nil(t, checkRateLimits("0001", now.Add(500*time.Millisecond), baz))
+}
+
+func TestCheckRateLimits(t *testing.T) {
+	defer ResetRateLimits()
+	config := GlobalPocketConfig
+	defer func() { GlobalPocketConfig = config }()
+	GlobalPocketConfig.RelayRateLimitIP = 1
+	GlobalPocketConfig.RelayRateLimitClient = 1
+	// limits set to 0 are disabled
+	assert.Nil(t, CheckRelayRateLimits("0001", "app", "client"))
+	for i := 0; i < 5; i++ {
+		assert.Nil(t, CheckRelayRateLimits("0001", "app", ""))
+	}
+	err := CheckRelayRateLimits("0001", "app", "client")
+	assert.NotNil(t, err)
+	assert.Equal(t, CodeRateLimitError, int(err.Code()))
+	assert.Nil(t, CheckIPRateLimit("0001", "127.0.0.1"))
+	err = CheckIPRateLimit("0001", "127.0.0.1")
+	assert.NotNil(t, err)
+	assert.Equal(t, CodeRateLimitError, int(err.Code()))
+	assert.Nil(t, CheckIPRateLimit("0001", "127.0.0.2"))
+}
@@ -0,0 +1,168 @@
+package types
+
+import (
+	"bytes"
+	"encoding/json"
+	"strconv"
+	"sync"
+	"time"
+
+	sdk "github.com/pokt-network/pocket-core/types"
+)
+
+// the response caches of the hosted chains that enable caching
+var globalResponseCaches = struct {
+	l sync.Mutex
+	m map[string]*responseCache
+}{m: make(map[string]*responseCache)}
+
+// "RelayCache" - The opt-in response cache of a hosted blockchain
+type RelayCache struct {
+	MaxEntries int              `json:"max_entries"` // the lru bound of the cache
+	Rules      []RelayCacheRule `json:"rules"`       // the json-rpc methods that may be cached
+}
+
+// "RelayCacheRule" - Describes a cacheable json-rpc method
+type RelayCacheRule struct {
+	Method     string   `json:"method"`                // the json-rpc method (e.g. eth_chainId)
+	TTL        int64    `json:"ttl"`                   // ms the response is served from the cache, 0 means no expiry
+	SkipParams []string `json:"skip_params,omitempty"` // requests with any of these params are not cached (e.g. latest)
+}
+
+// "Validate" - Validates the response cache config
+func (c RelayCache) Validate() sdk.Error {
+	if c.MaxEntries <= 0 {
+		return NewInvalidHostedChainError(ModuleName)
+	}
+	for _, rule := range c.Rules {
+		if rule.Method == "" || rule.TTL < 0 {
+			return NewInvalidHostedChainError(ModuleName)
+		}
+	}
+	return nil
+}
+
+type responseCache struct {
+	config RelayCache
+	lru    *sdk.Cache
+}
+
+type cachedResponse struct {
+	response string
+	expires  time.Time // zero if the response never expires
+}
+
+type jsonRPCRequest struct {
+	ID     json.RawMessage `json:"id"`
+	Method string          `json:"method"`
+	Params json.RawMessage `json:"params"`
+}
+
+// "GetCachedResponse" - Returns the cached response of the relay if the hosted chain caches the request
+func GetCachedResponse(hostedBlockchains *HostedBlockchains, r Relay) (string, bool) {
+	cache, key, req, ok := cacheFor(hostedBlockchains, r)
+	if !ok {
+		return "", false
+	}
+	v, found := cache.lru.Get(key)
+	if !found {
+		return "", false
+	}
+	res := v.(cachedResponse)
+	if !res.expires.IsZero() && time.Now().After(res.expires) {
+		cache.lru.Remove(key)
+		return "", false
+	}
+	return withResponseID(res.response, req.ID), true
+}
+
+// "CacheResponse" - Stores the response of the relay if the hosted chain caches the request
+func CacheResponse(hostedBlockchains *HostedBlockchains, r Relay, response string) {
+	cache, key, req, ok := cacheFor(hostedBlockchains, r)
+	if !ok {
+		return
+	}
+	// only successful responses are cached
+	var res map[string]json.RawMessage
+	if err := json.Unmarshal([]byte(response), &res); err != nil {
+		return
+	}
+	if _, found := res["result"]; !found {
+		return
+	}
+	if _, found := res["error"]; found {
+		return
+	}
+	var expires time.Time
+	for _, rule := range cache.config.Rules {
+		if rule.Method == req.Method && rule.TTL > 0 {
+			expires = time.Now().Add(time.Duration(rule.TTL) * time.Millisecond)
+		}
+	}
+	cache.lru.Add(key, cachedResponse{response: response, expires: expires})
+}
+
+// "cacheFor" - Returns the cache and the key of the relay, ok is false if the request is not cacheable
+func cacheFor(hostedBlockchains *HostedBlockchains, r Relay) (cache *responseCache, key string, req jsonRPCRequest, ok bool) {
+	chain, err := hostedBlockchains.GetChain(r.Proof.Blockchain)
+	if err != nil || chain.Cache == nil {
+		return nil, "", req, false
+	}
+	// batches and non json-rpc payloads are never cached
+	if err := json.Unmarshal([]byte(r.Payload.Data), &req); err != nil || req.Method == "" {
+		return nil, "", req, false
+	}
+	cacheable := false
+	for _, rule := range chain.Cache.Rules {
+		if rule.Method != req.Method {
+			continue
+		}
+		cacheable = true
+		for _, p := range rule.SkipParams {
+			if bytes.Contains(req.Params, []byte(strconv.Quote(p))) {
+				cacheable = false
+			}
+		}
+	}
+	if !cacheable {
+		return nil, "", req, false
+	}
+	params := new(bytes.Buffer)
+	if len(req.Params) != 0 {
+		if err := json.Compact(params, req.Params); err != nil {
+			return nil, "", req, false
+		}
+	}
+	key = r.Payload.Method + "|" + r.Payload.Path + "|" + req.Method + "|" + params.String()
+	return getResponseCache(chain), key, req, true
+}
+
+// "getResponseCache" - Returns the cache of the hosted chain, rebuilt if the config of the chain changed
+func getResponseCache(chain HostedBlockchain) *responseCache {
+	globalResponseCaches.l.Lock()
+	defer globalResponseCaches.l.Unlock()
+	cache, found := globalResponseCaches.m[chain.ID]
+	if !found || cache.config.MaxEntries != chain.Cache.MaxEntries {
+		cache = &responseCache{lru: sdk.NewCache(chain.Cache.MaxEntries)}
+		globalResponseCaches.m[chain.ID] = cache
+	}
+	cache.config = *chain.Cache
+	return cache
+}
+
+// "withResponseID" - Replaces the json-rpc id of the cached response with the id of the request
+func withResponseID(response string, id json.RawMessage) string {
+	if len(id) == 0 {
+		return response
+	}
+	var res map[string]json.RawMessage
+	if err := json.Unmarshal([]byte(response), &res); err != nil {
+		return response
+	}
+	res["id"] = id
+	bz, err := json.Marshal(res)
+	if err != nil {
+		return response
+	}
+	return string(bz)
+}
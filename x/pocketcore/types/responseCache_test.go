@@ -0,0 +1,61 @@
+package types
+
+import (
+	"encoding/hex"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestResponseCache(t *testing.T) {
+	ethereum := hex.EncodeToString([]byte{03})
+	hb := HostedBlockchains{
+		M: map[string]HostedBlockchain{ethereum: {
+			ID:  ethereum,
+			URL: "https://www.google.com:443",
+			Cache: &RelayCache{
+				MaxEntries: 2,
+				Rules: []RelayCacheRule{
+					{Method: "eth_chainId"},
+					{Method: "eth_getBlockByNumber", TTL: 50, SkipParams: []string{"latest"}},
+				},
+			},
+		}},
+		L: sync.Mutex{},
+	}
+	relay := func(data string) Relay {
+		return Relay{Payload: Payload{Data: data}, Proof: RelayProof{Blockchain: ethereum}}
+	}
+	chainID := relay(`{"jsonrpc":"2.0","method":"eth_chainId","params":[],"id":1}`)
+	_, found := GetCachedResponse(&hb, chainID)
+	assert.False(t, found)
+	CacheResponse(&hb, chainID, `{"jsonrpc":"2.0","id":1,"result":"0x1"}`)
+	// the id of the cached response matches the request
+	res, found := GetCachedResponse(&hb, relay(`{"jsonrpc":"2.0","method":"eth_chainId","params":[], "id":2}`))
+	assert.True(t, found)
+	assert.Equal(t, `{"id":2,"jsonrpc":"2.0","result":"0x1"}`, res)
+	// errors are not cached
+	block := relay(`{"jsonrpc":"2.0","method":"eth_getBlockByNumber","params":["0x1",false],"id":1}`)
+	CacheResponse(&hb, block, `{"jsonrpc":"2.0","id":1,"error":{"code":-32000,"message":"foo"}}`)
+	_, found = GetCachedResponse(&hb, block)
+	assert.False(t, found)
+	// responses expire after the ttl
+	CacheResponse(&hb, block, `{"jsonrpc":"2.0","id":1,"result":{}}`)
+	_, found = GetCachedResponse(&hb, block)
+	assert.True(t, found)
+	time.Sleep(60 * time.Millisecond)
+	_, found = GetCachedResponse(&hb, block)
+	assert.False(t, found)
+	// skipped params, unlisted methods and batches are never cached
+	for _, data := range []string{
+		`{"jsonrpc":"2.0","method":"eth_getBlockByNumber","params":["latest",false],"id":1}`,
+		`{"jsonrpc":"2.0","method":"eth_blockNumber","params":[],"id":1}`,
+		`[{"jsonrpc":"2.0","method":"eth_chainId","params":[],"id":1}]`,
+	} {
+		CacheResponse(&hb, relay(data), `{"jsonrpc":"2.0","id":1,"result":"0x1"}`)
+		_, found = GetCachedResponse(&hb, relay(data))
+		assert.False(t, found)
+	}
+}
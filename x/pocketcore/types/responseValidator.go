@@ -0,0 +1,152 @@
+package types
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync"
+)
+
+const (
+	HTTPStatusValidator     = "http_status"      // rejects server errors (5xx) of the blockchain
+	JSONRPCValidator        = "jsonrpc"          // rejects responses that are not a json-rpc 2.0 envelope answering the request
+	JSONRPCNoErrorValidator = "jsonrpc_no_error" // like jsonrpc, also rejecting json-rpc error objects
+)
+
+// "BackendResponse" - The response of the blockchain handed to the response validators before signing
+type BackendResponse struct {
+	StatusCode int
+	Body       string
+}
+
+// "ResponseValidator" - Returns an error if the response of the blockchain must not be signed
+type ResponseValidator func(request Payload, response BackendResponse) error
+
+// the response validators that hosted chains can enable by name
+var globalResponseValidators = struct {
+	l sync.RWMutex
+	m map[string]ResponseValidator
+}{m: map[string]ResponseValidator{
+	HTTPStatusValidator: validateHTTPStatus,
+	JSONRPCValidator: func(request Payload, response BackendResponse) error {
+		return validateJSONRPC(request, response, true)
+	},
+	JSONRPCNoErrorValidator: func(request Payload, response BackendResponse) error {
+		return validateJSONRPC(request, response, false)
+	},
+}}
+
+// "RegisterResponseValidator" - Makes the validator available to the hosted chains under the name
+func RegisterResponseValidator(name string, validator ResponseValidator) {
+	globalResponseValidators.l.Lock()
+	defer globalResponseValidators.l.Unlock()
+	globalResponseValidators.m[name] = validator
+}
+
+// "getResponseValidator" - Returns the validator registered under the name
+func getResponseValidator(name string) (ResponseValidator, bool) {
+	globalResponseValidators.l.RLock()
+	defer globalResponseValidators.l.RUnlock()
+	validator, found := globalResponseValidators.m[name]
+	return validator, found
+}
+
+// "ValidateResponse" - Runs the validators of the hosted chain against the response
+func (c HostedBlockchain) ValidateResponse(request Payload, response BackendResponse) error {
+	for _, name := range c.Validators {
+		validator, found := getResponseValidator(name)
+		if !found {
+			return fmt.Errorf("unknown response validator: %s", name)
+		}
+		if err := validator(request, response); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// "validateHTTPStatus" - Server errors are failures of the blockchain, not answers to the request
+func validateHTTPStatus(_ Payload, response BackendResponse) error {
+	if response.StatusCode >= http.StatusInternalServerError {
+		return fmt.Errorf("%s%d", HttpStatusCodeError.Error(), response.StatusCode)
+	}
+	return nil
+}
+
+// "jsonRPCResponse" - The json-rpc 2.0 response envelope
+type jsonRPCResponse struct {
+	JSONRPC string          `json:"jsonrpc"`
+	ID      json.RawMessage `json:"id"`
+	Result  json.RawMessage `json:"result"`
+	Error   *struct {
+		Code    *int    `json:"code"`
+		Message *string `json:"message"`
+	} `json:"error"`
+}
+
+// "validateJSONRPC" - Ensures every request of the payload (single or batch) is answered by a json-rpc 2.0 envelope
+func validateJSONRPC(request Payload, response BackendResponse, allowErrors bool) error {
+	var reqs []jsonRPCRequest
+	var resps []jsonRPCResponse
+	if data := bytes.TrimSpace([]byte(request.Data)); len(data) != 0 && data[0] == '[' {
+		if err := json.Unmarshal(data, &reqs); err != nil {
+			// not a json-rpc request, nothing to validate against
+			return nil
+		}
+		if err := json.Unmarshal([]byte(response.Body), &resps); err != nil {
+			return fmt.Errorf("the response is not a json-rpc batch: %s", err.Error())
+		}
+		// notifications are not answered, so a batch may have less responses than requests
+		if len(resps) == 0 || len(resps) > len(reqs) {
+			return fmt.Errorf("the json-rpc batch has %d responses for %d requests", len(resps), len(reqs))
+		}
+	} else {
+		var req jsonRPCRequest
+		if err := json.Unmarshal(data, &req); err != nil || req.Method == "" {
+			return nil
+		}
+		var resp jsonRPCResponse
+		if err := json.Unmarshal([]byte(response.Body), &resp); err != nil {
+			return fmt.Errorf("the response is not a json-rpc object: %s", err.Error())
+		}
+		reqs, resps = []jsonRPCRequest{req}, []jsonRPCResponse{resp}
+	}
+	ids := make(map[string]bool, len(reqs))
+	for _, req := range reqs {
+		ids[string(compactJSON(req.ID))] = true
+	}
+	for _, resp := range resps {
+		if resp.JSONRPC != "2.0" {
+			return fmt.Errorf("the response is not a json-rpc 2.0 object")
+		}
+		if !ids[string(compactJSON(resp.ID))] {
+			return fmt.Errorf("the json-rpc response id %s does not match the request", string(resp.ID))
+		}
+		if (resp.Result == nil) == (resp.Error == nil) {
+			return fmt.Errorf("the json-rpc response must have either a result or an error")
+		}
+		if resp.Error != nil {
+			if resp.Error.Code == nil || resp.Error.Message == nil {
+				return fmt.Errorf("the json-rpc error object is malformed")
+			}
+			if !allowErrors {
+				return fmt.Errorf("the json-rpc response is an error: %d %s", *resp.Error.Code, *resp.Error.Message)
+			}
+		}
+	}
+	return nil
+}
+
+// "compactJSON" - Returns the compacted json so ids are compared regardless of whitespace
+func compactJSON(raw json.RawMessage) []byte {
+	// a request without an id is answered with a null id
+	if len(raw) == 0 {
+		return []byte("null")
+	}
+	buf := new(bytes.Buffer)
+	if err := json.Compact(buf, raw); err != nil {
+		return raw
+	}
+	return buf.Bytes()
+}
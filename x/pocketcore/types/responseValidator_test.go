@@ -0,0 +1,113 @@
+package types
+
+import (
+	"encoding/hex"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/h2non/gock.v1"
+)
+
+func TestValidateJSONRPC(t *testing.T) {
+	request := Payload{Data: `{"jsonrpc":"2.0","method":"eth_blockNumber","params":[],"id":1}`}
+	batch := Payload{Data: `[{"jsonrpc":"2.0","method":"eth_blockNumber","id":1},{"jsonrpc":"2.0","method":"eth_chainId","id":"a"}]`}
+	tests := []struct {
+		name        string
+		request     Payload
+		body        string
+		allowErrors bool
+		valid       bool
+	}{
+		{"result", request, `{"jsonrpc":"2.0","id":1,"result":"0x1"}`, false, true},
+		{"null result", request, `{"jsonrpc":"2.0","id":1,"result":null}`, false, true},
+		{"error allowed", request, `{"jsonrpc":"2.0","id":1,"error":{"code":-32000,"message":"foo"}}`, true, true},
+		{"error rejected", request, `{"jsonrpc":"2.0","id":1,"error":{"code":-32000,"message":"foo"}}`, false, false},
+		{"malformed error", request, `{"jsonrpc":"2.0","id":1,"error":"foo"}`, true, false},
+		{"html page", request, `<html>502 Bad Gateway</html>`, true, false},
+		{"wrong version", request, `{"jsonrpc":"1.0","id":1,"result":"0x1"}`, true, false},
+		{"wrong id", request, `{"jsonrpc":"2.0","id":2,"result":"0x1"}`, true, false},
+		{"result and error", request, `{"jsonrpc":"2.0","id":1,"result":"0x1","error":{"code":1,"message":"foo"}}`, true, false},
+		{"batch", batch, `[{"jsonrpc":"2.0","id":"a","result":"0x1"},{"jsonrpc":"2.0","id":1,"result":"0x2"}]`, true, true},
+		{"batch too long", batch, `[{"jsonrpc":"2.0","id":"a","result":"0x1"},{"jsonrpc":"2.0","id":1,"result":"0x2"},{"jsonrpc":"2.0","id":1,"result":"0x2"}]`, true, false},
+		{"batch object", batch, `{"jsonrpc":"2.0","id":1,"result":"0x1"}`, true, false},
+		{"not json-rpc", Payload{Data: "foo"}, `bar`, true, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateJSONRPC(tt.request, BackendResponse{StatusCode: 200, Body: tt.body}, tt.allowErrors)
+			assert.Equal(t, tt.valid, err == nil, err)
+		})
+	}
+}
+
+func TestRelay_ExecuteResponseValidation(t *testing.T) {
+	resetEndpointStates()
+	defer resetEndpointStates()
+	ethereum := hex.EncodeToString([]byte{06})
+	validRelay := Relay{
+		Payload: Payload{Data: `{"jsonrpc":"2.0","method":"eth_blockNumber","params":[],"id":1}`, Method: "POST"},
+		Proof:   RelayProof{Blockchain: ethereum},
+	}
+	defer gock.Off()
+	gock.InterceptClient(GetRelayClient(ethereum))
+	gock.New("https://a.com").
+		Post("/").
+		Times(2).
+		Reply(502).
+		BodyString("<html>502 Bad Gateway</html>")
+	gock.New("https://b.com").
+		Post("/").
+		Reply(200).
+		BodyString(`{"jsonrpc":"2.0","id":1,"result":"0x1"}`)
+	hb := HostedBlockchains{
+		M: map[string]HostedBlockchain{ethereum: {
+			ID:         ethereum,
+			Endpoints:  []HostedEndpoint{{URL: "https://a.com"}, {URL: "https://b.com"}},
+			Selection:  LeastLatencySelection,
+			Failover:   true,
+			Validators: []string{HTTPStatusValidator, JSONRPCValidator},
+		}},
+		L: sync.Mutex{},
+	}
+	assert.Nil(t, hb.Validate())
+	// the server error fails over to the next endpoint
+	res, err := validRelay.Execute(&hb)
+	assert.Nil(t, err)
+	assert.Equal(t, `{"id":1,"jsonrpc":"2.0","result":"0x1"}`, res)
+	// an invalid response of the only endpoint is an error
+	chain := hb.M[ethereum]
+	chain.Endpoints = chain.Endpoints[:1]
+	hb.M[ethereum] = chain
+	_, err = validRelay.Execute(&hb)
+	assert.NotNil(t, err)
+	assert.Equal(t, CodeInvalidBackendResponseError, int(err.Code()))
+	// a rejected answer is not a failure of the endpoint and is not sent to the other endpoints
+	polygon := hex.EncodeToString([]byte{07})
+	validRelay.Proof.Blockchain = polygon
+	gock.InterceptClient(GetRelayClient(polygon))
+	gock.New("https://c.com").
+		Post("/").
+		Reply(200).
+		BodyString(`{"jsonrpc":"2.0","id":2,"result":"0x1"}`)
+	gock.New("https://d.com").
+		Post("/").
+		Reply(200).
+		BodyString(`{"jsonrpc":"2.0","id":1,"result":"0x1"}`)
+	hb.M[polygon] = HostedBlockchain{
+		ID:         polygon,
+		Endpoints:  []HostedEndpoint{{URL: "https://c.com"}, {URL: "https://d.com"}},
+		Selection:  LeastLatencySelection,
+		Validators: []string{HTTPStatusValidator, JSONRPCValidator},
+	}
+	_, err = validRelay.Execute(&hb)
+	assert.NotNil(t, err)
+	assert.Equal(t, CodeInvalidBackendResponseError, int(err.Code()))
+	assert.Zero(t, GetEndpointStates(hb.M[polygon])[0].ConsecutiveFailures)
+	// the mock of the second endpoint is not consumed
+	assert.True(t, gock.IsPending())
+	// unknown validators are rejected by the config
+	chain.Validators = []string{"foo"}
+	hb.M[ethereum] = chain
+	assert.NotNil(t, hb.Validate())
+}
@@ -4,12 +4,15 @@ import (
 	"bytes"
 	"encoding/hex"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"github.com/pokt-network/pocket-core/crypto"
 	sdk "github.com/pokt-network/pocket-core/types"
 	"github.com/pokt-network/pocket-core/x/nodes/exported"
+	"io"
 	"io/ioutil"
 	"log"
+	"net"
 	"net/http"
 	"strings"
 	"time"
@@ -39,9 +42,26 @@ func (r *Relay) Validate(ctx sdk.Ctx, posKeeper PosKeeper, appsKeeper AppsKeeper
 		return sdk.ZeroInt(), NewRequestHashError(ModuleName)
 	}
 	// ensure the blockchain is supported locally
-	if !hb.Contains(r.Proof.Blockchain) {
+	chain, err := hb.GetChain(r.Proof.Blockchain)
+	if err != nil {
 		return sdk.ZeroInt(), NewUnsupportedBlockchainNodeError(ModuleName)
 	}
+	// ensure the backend of the blockchain is in sync
+	if err := ValidateChainSynced(chain.ID); err != nil {
+		return sdk.ZeroInt(), err
+	}
+	// ensure the payload is encoded for the transport of the blockchain
+	if chain.Transport == GRPCTransport {
+		if err := r.Payload.ValidateGRPC(); err != nil {
+			return sdk.ZeroInt(), err
+		}
+	} else if r.Payload.IsGRPC() {
+		return sdk.ZeroInt(), NewInvalidGRPCPayloadError(ModuleName)
+	}
+	// ensure the payload is within the size limit of the blockchain
+	if limit := chain.RequestLimit(); limit > 0 && int64(len(r.Payload.Data)) > limit {
+		return sdk.ZeroInt(), NewRequestTooLargeError(ModuleName)
+	}
 	// ensure session block height == one in the relay proof
 	if r.Proof.SessionBlockHeight != sessionBlockHeight {
 		return sdk.ZeroInt(), NewInvalidBlockHeightError(ModuleName)
@@ -67,8 +87,8 @@ func (r *Relay) Validate(ctx sdk.Ctx, posKeeper PosKeeper, appsKeeper AppsKeeper
 		SessionBlockHeight: r.Proof.SessionBlockHeight,
 	}
 	// validate unique relay
-	evidence, totalRelays := GetTotalProofs(header, RelayEvidence, maxPossibleRelays)
-	if evidence.IsSealed() {
+	evidence, totalRelays := GetTotalProofs(node, header, RelayEvidence, maxPossibleRelays)
+	if IsEvidenceSealed(node, evidence) {
 		return sdk.ZeroInt(), NewSealedEvidenceError(ModuleName)
 	}
 	// get evidence key by proof
@@ -117,21 +137,79 @@ func (r Relay) Execute(hostedBlockchains *HostedBlockchains) (string, sdk.Error)
 	chain, err := hostedBlockchains.GetChain(r.Proof.Blockchain)
 	if err != nil {
 		// metric track
-		GlobalServiceMetric().AddErrorFor(r.Proof.Blockchain)
+		GlobalServiceMetric().AddErrorFor(r.Proof.Blockchain, r.Proof.Token.ApplicationPublicKey, err)
 		return "", err
 	}
-	url := strings.Trim(chain.URL, `/`)
-	if len(r.Payload.Path) > 0 {
-		url = url + "/" + strings.Trim(r.Payload.Path, `/`)
-	}
-	// do basic http request on the relay
-	res, er := executeHTTPRequest(r.Payload.Data, url, GlobalPocketConfig.UserAgent, chain.BasicAuth, r.Payload.Method, r.Payload.Headers)
-	if er != nil {
-		// metric track
-		GlobalServiceMetric().AddErrorFor(r.Proof.Blockchain)
-		return res, NewHTTPExecutionError(ModuleName, er)
-	}
-	return res, nil
+	var res string
+	var er error
+	// try the endpoints in order until one of them answers
+	for _, endpoint := range OrderEndpoints(chain) {
+		start := time.Now()
+		statusCode := http.StatusOK
+		if chain.Transport == GRPCTransport {
+			// invoke the grpc method of the relay
+			res, er = executeGRPCRequest(endpoint, r.Payload, GlobalPocketConfig.UserAgent, chain.ResponseLimit())
+		} else {
+			url := strings.Trim(endpoint.URL, `/`)
+			if len(r.Payload.Path) > 0 {
+				url = url + "/" + strings.Trim(r.Payload.Path, `/`)
+			}
+			// do basic http request on the relay
+			res, statusCode, er = executeHTTPRequest(GetRelayClient(chain.ID), r.Payload.Data, url, GlobalPocketConfig.UserAgent, endpoint.BasicAuth, r.Payload.Method, r.Payload.Headers, chain.ResponseLimit())
+		}
+		// the other endpoints serve the same response, so an oversized response is not retried
+		if er == ResponseTooLargeError {
+			ReportEndpointSuccess(chain.ID, endpoint.URL, time.Since(start))
+			err := NewResponseTooLargeError(ModuleName)
+			GlobalServiceMetric().AddErrorFor(r.Proof.Blockchain, r.Proof.Token.ApplicationPublicKey, err)
+			return "", err
+		}
+		// a grpc status is the answer of the blockchain, the other endpoints would answer the same
+		if e, ok := er.(sdk.Error); ok && e.Code() == CodeGRPCStatusError {
+			ReportEndpointSuccess(chain.ID, endpoint.URL, time.Since(start))
+			GlobalServiceMetric().AddErrorFor(r.Proof.Blockchain, r.Proof.Token.ApplicationPublicKey, e)
+			return "", e
+		}
+		// responses failing the validators of the chain are not signed. A server error is a failure of the endpoint and
+		// another endpoint may answer properly, any other rejected answer is returned without failing over
+		if er == nil {
+			if e := chain.ValidateResponse(r.Payload, BackendResponse{StatusCode: statusCode, Body: res}); e != nil {
+				err := NewInvalidBackendResponseError(ModuleName, e)
+				if statusCode < http.StatusInternalServerError {
+					ReportEndpointSuccess(chain.ID, endpoint.URL, time.Since(start))
+					GlobalServiceMetric().AddErrorFor(r.Proof.Blockchain, r.Proof.Token.ApplicationPublicKey, err)
+					return "", err
+				}
+				er = err
+			}
+		}
+		if er != nil {
+			ReportEndpointFailure(chain.ID, endpoint.URL)
+			// a relay may not be idempotent, so it's only sent to the next endpoint if the chain allows failover or if it
+			// never reached the endpoint
+			if chain.Failover || isDialError(er) {
+				continue
+			}
+			break
+		}
+		backendTime := time.Since(start)
+		ReportEndpointSuccess(chain.ID, endpoint.URL, backendTime)
+		GlobalServiceMetric().AddBackendTimingFor(chain.ID, endpoint.URL, float64(backendTime.Milliseconds()))
+		return res, nil
+	}
+	var ok bool
+	if err, ok = er.(sdk.Error); !ok {
+		err = NewHTTPExecutionError(ModuleName, er)
+	}
+	// metric track
+	GlobalServiceMetric().AddErrorFor(r.Proof.Blockchain, r.Proof.Token.ApplicationPublicKey, err)
+	return "", err
+}
+
+// "isDialError" - Returns true if the connection to the endpoint failed, so the request was never sent
+func isDialError(err error) bool {
+	var opErr *net.OpError
+	return errors.As(err, &opErr) && opErr.Op == "dial"
 }
 
 // "Bytes" - Returns the bytes representation of the Relay
@@ -286,11 +364,11 @@ type DispatchSession struct {
 }
 
 // "executeHTTPRequest" takes in the raw json string and forwards it to the RPC endpoint
-func executeHTTPRequest(payload, url, userAgent string, basicAuth BasicAuth, method string, headers map[string]string) (string, error) {
+func executeHTTPRequest(client *http.Client, payload, url, userAgent string, basicAuth BasicAuth, method string, headers map[string]string, maxResponse int64) (string, int, error) {
 	// generate an http request
 	req, err := http.NewRequest(method, url, bytes.NewBuffer([]byte(payload)))
 	if err != nil {
-		return "", err
+		return "", 0, err
 	}
 	if basicAuth.Username != "" {
 		req.SetBasicAuth(basicAuth.Username, basicAuth.Password)
@@ -307,21 +385,41 @@ func executeHTTPRequest(payload, url, userAgent string, basicAuth BasicAuth, met
 		}
 	}
 	// execute the request
-	resp, err := (&http.Client{Timeout: globalRPCTimeout * time.Millisecond}).Do(req)
+	resp, err := client.Do(req)
 	if err != nil {
-		return "", err
+		return "", 0, err
+	}
+	defer resp.Body.Close()
+	// fail before reading when the backend announces an oversized body
+	if maxResponse > 0 && resp.ContentLength > maxResponse {
+		return "", resp.StatusCode, ResponseTooLargeError
 	}
-	// read all bz
-	body, err := ioutil.ReadAll(resp.Body)
+	// read the bz up to the limit of the chain
+	body, err := readResponseBody(resp.Body, maxResponse)
 	if err != nil {
-		return "", err
+		return "", 0, err
 	}
-	defer resp.Body.Close()
 	if GlobalPocketConfig.JSONSortRelayResponses {
 		body = []byte(sortJSONResponse(string(body)))
 	}
 	// return
-	return string(body), nil
+	return string(body), resp.StatusCode, nil
+}
+
+// "readResponseBody" - Streams the body into memory, failing once more than max bytes are read (max <= 0 is no limit)
+func readResponseBody(body io.Reader, max int64) ([]byte, error) {
+	if max <= 0 {
+		return ioutil.ReadAll(body)
+	}
+	var buf bytes.Buffer
+	n, err := io.Copy(&buf, io.LimitReader(body, max+1))
+	if err != nil {
+		return nil, err
+	}
+	if n > max {
+		return nil, ResponseTooLargeError
+	}
+	return buf.Bytes(), nil
 }
 
 // "sortJSONResponse" - sorts json from a relay response
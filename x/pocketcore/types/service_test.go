@@ -8,7 +8,13 @@ import (
 	exported2 "github.com/pokt-network/pocket-core/x/apps/exported"
 	"github.com/pokt-network/pocket-core/x/auth"
 	"github.com/pokt-network/pocket-core/x/gov"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
 	"reflect"
+	"strings"
+	"sync"
 	"testing"
 	"time"
 
@@ -172,6 +178,7 @@ func TestRelay_Execute(t *testing.T) {
 	}
 	validRelay.Proof.RequestHash = validRelay.RequestHashString()
 	defer gock.Off() // Flush pending mocks after test execution
+	gock.InterceptClient(GetRelayClient(ethereum))
 
 	gock.New("https://server.com").
 		Post("/relay").
@@ -220,8 +227,8 @@ func TestRelay_HandleProof(t *testing.T) {
 		},
 	}
 	validRelay.Proof.RequestHash = validRelay.RequestHashString()
-	validRelay.Proof.Store(sdk.NewInt(100000))
-	res := GetProof(SessionHeader{
+	validRelay.Proof.Store(sdk.Address(npk.Address()), sdk.NewInt(100000))
+	res := GetProof(sdk.Address(npk.Address()), SessionHeader{
 		ApplicationPubKey:  appPubKey,
 		Chain:              ethereum,
 		SessionBlockHeight: 1,
@@ -355,6 +362,14 @@ func (m MockPosKeeper) RewardForRelays(ctx sdk.Ctx, relays sdk.BigInt, address s
 	panic("implement me")
 }
 
+func (m MockPosKeeper) EstimateReward(ctx sdk.Ctx, relays sdk.BigInt, stake sdk.BigInt) nodesTypes.RewardEstimate {
+	panic("implement me")
+}
+
+func (m MockPosKeeper) ServicerStakeWeight(ctx sdk.Ctx, stake sdk.BigInt) sdk.BigDec {
+	panic("implement me")
+}
+
 func (m MockPosKeeper) GetStakedTokens(ctx sdk.Ctx) sdk.BigInt {
 	panic("implement me")
 }
@@ -404,3 +419,72 @@ func makeTestCodec() *codec.Codec {
 	crypto.RegisterAmino(cdc.AminoCodec().Amino)
 	return cdc
 }
+
+func TestReadResponseBody(t *testing.T) {
+	body, err := readResponseBody(strings.NewReader("foobar"), 6)
+	assert.Nil(t, err)
+	assert.Equal(t, "foobar", string(body))
+	_, err = readResponseBody(strings.NewReader("foobar"), 5)
+	assert.Equal(t, ResponseTooLargeError, err)
+	body, err = readResponseBody(strings.NewReader("foobar"), 0)
+	assert.Nil(t, err)
+	assert.Equal(t, "foobar", string(body))
+}
+
+func TestRelay_ExecuteResponseTooLarge(t *testing.T) {
+	resetEndpointStates()
+	defer resetEndpointStates()
+	ethereum := hex.EncodeToString([]byte{04})
+	validRelay := Relay{
+		Payload: Payload{Data: "foo", Method: "POST"},
+		Proof:   RelayProof{Blockchain: ethereum},
+	}
+	defer gock.Off()
+	gock.InterceptClient(GetRelayClient(ethereum))
+	gock.New("https://a.com").
+		Post("/").
+		Reply(200).
+		BodyString("foobar")
+	hb := HostedBlockchains{
+		M: map[string]HostedBlockchain{ethereum: {
+			ID:          ethereum,
+			Endpoints:   []HostedEndpoint{{URL: "https://a.com"}, {URL: "https://b.com"}},
+			Selection:   LeastLatencySelection,
+			MaxResponse: 5,
+		}},
+		L: sync.Mutex{},
+	}
+	_, err := validRelay.Execute(&hb)
+	assert.NotNil(t, err)
+	assert.Equal(t, CodeResponseTooLargeError, int(err.Code()))
+	// the endpoint is not failed over nor marked unhealthy
+	assert.Equal(t, 0, GetEndpointStates(hb.M[ethereum])[0].ConsecutiveFailures)
+}
+
+func BenchmarkRelay_Execute(b *testing.B) {
+	chain := hex.EncodeToString([]byte{0x0b})
+	backend := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = io.Copy(ioutil.Discard, r.Body)
+		_, _ = w.Write([]byte(`{"jsonrpc":"2.0","id":67,"result":"0x1"}`))
+	}))
+	defer backend.Close()
+	hb := HostedBlockchains{
+		M: map[string]HostedBlockchain{chain: {ID: chain, URL: backend.URL}},
+	}
+	relay := Relay{
+		Payload: Payload{
+			Data:   `{"jsonrpc":"2.0","method":"eth_blockNumber","params":[],"id":67}`,
+			Method: "POST",
+		},
+		Proof: RelayProof{Blockchain: chain},
+	}
+	b.ReportAllocs()
+	b.ResetTimer()
+	b.RunParallel(func(pb *testing.PB) {
+		for pb.Next() {
+			if _, err := relay.Execute(&hb); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+}
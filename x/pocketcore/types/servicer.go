@@ -0,0 +1,150 @@
+package types
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/pokt-network/pocket-core/crypto"
+	sdk "github.com/pokt-network/pocket-core/types"
+	"github.com/tendermint/tendermint/config"
+	"github.com/tendermint/tendermint/privval"
+)
+
+// the additional servicer identities hosted by this node, the private validator key is the only one acting as the
+// tendermint validator and keeps the main evidence cache
+var globalServicers = struct {
+	l         sync.RWMutex
+	keys      []crypto.PrivateKey
+	cache     map[string]CacheStorage // the evidence cache of every additional servicer by address
+	self      crypto.PrivateKey       // the private validator key, converted when the key file is loaded
+	selfErr   error                   // the error converting the private validator key
+	hosted    []crypto.PrivateKey     // the private validator key (if loaded) followed by the additional servicer keys
+	addresses map[string]bool         // the addresses of the hosted keys
+}{cache: make(map[string]CacheStorage), selfErr: NewInvalidPKError(ModuleName)}
+
+// "InitServicerKeyFiles" - Loads the additional servicer identities from their private key files
+func InitServicerKeyFiles(files []privval.FilePVKey) error {
+	keys := make([]crypto.PrivateKey, 0, len(files))
+	seen := make(map[string]bool)
+	if globalPVKeyFile.PrivKey != nil {
+		seen[sdk.Address(globalPVKeyFile.Address).String()] = true
+	}
+	for _, file := range files {
+		pk, err := crypto.PrivKeyToPrivateKey(file.PrivKey)
+		if err != nil {
+			return err
+		}
+		address := sdk.Address(pk.PublicKey().Address()).String()
+		if seen[address] {
+			return fmt.Errorf("the servicer key %s is loaded more than once", address)
+		}
+		seen[address] = true
+		keys = append(keys, pk)
+	}
+	globalServicers.l.Lock()
+	defer globalServicers.l.Unlock()
+	globalServicers.keys = keys
+	setHostedServicersWithoutLock()
+	return nil
+}
+
+// "setPVKey" - Converts the private validator key once, so it isn't converted for every transaction
+func setPVKey(filePVKey privval.FilePVKey) {
+	globalServicers.l.Lock()
+	defer globalServicers.l.Unlock()
+	globalServicers.self, globalServicers.selfErr = nil, NewInvalidPKError(ModuleName)
+	if filePVKey.PrivKey != nil {
+		globalServicers.self, globalServicers.selfErr = crypto.PrivKeyToPrivateKey(filePVKey.PrivKey)
+	}
+	setHostedServicersWithoutLock()
+}
+
+// "setHostedServicersWithoutLock" - CONTRACT: used in a function with the lock of the servicers
+func setHostedServicersWithoutLock() {
+	hosted := make([]crypto.PrivateKey, 0, len(globalServicers.keys)+1)
+	if globalServicers.selfErr == nil {
+		hosted = append(hosted, globalServicers.self)
+	}
+	hosted = append(hosted, globalServicers.keys...)
+	addresses := make(map[string]bool, len(hosted))
+	for _, pk := range hosted {
+		addresses[sdk.Address(pk.PublicKey().Address()).String()] = true
+	}
+	globalServicers.hosted, globalServicers.addresses = hosted, addresses
+}
+
+// "GetPVKey" - Returns the private validator key
+func GetPVKey() (crypto.PrivateKey, error) {
+	globalServicers.l.RLock()
+	defer globalServicers.l.RUnlock()
+	return globalServicers.self, globalServicers.selfErr
+}
+
+// "GetHostedServicerKeys" - Returns the private keys of every servicer hosted by this node, the validator key first.
+// The error is the one of the private validator key, the slice is shared and must not be modified
+func GetHostedServicerKeys() ([]crypto.PrivateKey, error) {
+	globalServicers.l.RLock()
+	defer globalServicers.l.RUnlock()
+	return globalServicers.hosted, globalServicers.selfErr
+}
+
+// "IsHostedServicer" - Returns true if the address belongs to a servicer hosted by this node
+func IsHostedServicer(address sdk.Address) bool {
+	globalServicers.l.RLock()
+	defer globalServicers.l.RUnlock()
+	return globalServicers.addresses[address.String()]
+}
+
+// "GetServicerKeys" - Returns the private keys of the additional servicers in the order they were loaded
+func GetServicerKeys() []crypto.PrivateKey {
+	globalServicers.l.RLock()
+	defer globalServicers.l.RUnlock()
+	return append([]crypto.PrivateKey{}, globalServicers.keys...)
+}
+
+// "GetServicerKey" - Returns the private key of the additional servicer with the public key (hex)
+func GetServicerKey(servicerPubKey string) (crypto.PrivateKey, bool) {
+	globalServicers.l.RLock()
+	defer globalServicers.l.RUnlock()
+	for _, pk := range globalServicers.keys {
+		if pk.PublicKey().RawString() == servicerPubKey {
+			return pk, true
+		}
+	}
+	return nil, false
+}
+
+// "initServicerEvidenceCaches" - Opens an evidence cache for every additional servicer, so the evidence of servicers
+// in the same session doesn't collide
+func initServicerEvidenceCaches(engine, dir, name string, options config.LevelDBOptions, maxEntries int) {
+	globalServicers.l.Lock()
+	defer globalServicers.l.Unlock()
+	for _, pk := range globalServicers.keys {
+		address := sdk.Address(pk.PublicKey().Address()).String()
+		if _, found := globalServicers.cache[address]; found {
+			continue
+		}
+		globalServicers.cache[address] = mustNewCacheStorage(engine, dir, name+"_"+address, options, maxEntries)
+	}
+}
+
+// "getEvidenceCache" - Returns the evidence cache of the servicer, the main cache unless it is an additional servicer
+func getEvidenceCache(servicer sdk.Address) CacheStorage {
+	globalServicers.l.RLock()
+	defer globalServicers.l.RUnlock()
+	if cache, found := globalServicers.cache[servicer.String()]; found {
+		return cache
+	}
+	return globalEvidenceCache
+}
+
+// "servicerEvidenceCaches" - Returns the evidence caches of the additional servicers
+func servicerEvidenceCaches() []CacheStorage {
+	globalServicers.l.RLock()
+	defer globalServicers.l.RUnlock()
+	caches := make([]CacheStorage, 0, len(globalServicers.cache))
+	for _, cache := range globalServicers.cache {
+		caches = append(caches, cache)
+	}
+	return caches
+}
@@ -0,0 +1,79 @@
+package types
+
+import (
+	"encoding/hex"
+	"testing"
+
+	sdk "github.com/pokt-network/pocket-core/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/tendermint/tendermint/privval"
+)
+
+func TestServicers_SeparateEvidence(t *testing.T) {
+	pk1, pk2 := GetRandomPrivateKey(), GetRandomPrivateKey()
+	defer func() {
+		globalServicers.l.Lock()
+		for _, cache := range globalServicers.cache {
+			cache.Clear()
+			_ = cache.Close()
+		}
+		globalServicers.keys, globalServicers.cache = nil, make(map[string]CacheStorage)
+		setHostedServicersWithoutLock()
+		globalServicers.l.Unlock()
+	}()
+	// a key loaded twice is refused
+	err := InitServicerKeyFiles([]privval.FilePVKey{{PrivKey: pk1.PrivKey()}, {PrivKey: pk1.PrivKey()}})
+	assert.NotNil(t, err)
+	err = InitServicerKeyFiles([]privval.FilePVKey{{PrivKey: pk1.PrivKey()}, {PrivKey: pk2.PrivKey()}})
+	assert.Nil(t, err)
+	assert.Len(t, GetServicerKeys(), 2)
+	pk, found := GetServicerKey(pk2.PublicKey().RawString())
+	assert.True(t, found)
+	assert.Equal(t, pk2.PublicKey().RawString(), pk.PublicKey().RawString())
+	_, found = GetServicerKey(getRandomPubKey().RawString())
+	assert.False(t, found)
+	// the validator key is hosted first
+	self := GetRandomPrivateKey()
+	prev := globalPVKeyFile
+	defer InitPVKeyFile(prev)
+	InitPVKeyFile(privval.FilePVKey{Address: self.PubKey().Address(), PrivKey: self.PrivKey()})
+	pk, err = GetPVKey()
+	assert.Nil(t, err)
+	assert.Equal(t, self.PublicKey().RawString(), pk.PublicKey().RawString())
+	hosted, err := GetHostedServicerKeys()
+	assert.Nil(t, err)
+	assert.Len(t, hosted, 3)
+	assert.Equal(t, self.PublicKey().RawString(), hosted[0].PublicKey().RawString())
+	assert.True(t, IsHostedServicer(sdk.Address(self.PublicKey().Address())))
+	assert.True(t, IsHostedServicer(sdk.Address(pk2.PublicKey().Address())))
+	assert.False(t, IsHostedServicer(sdk.Address(getRandomPubKey().Address())))
+	c := sdk.DefaultTestingPocketConfig()
+	initServicerEvidenceCaches(c.PocketConfig.EvidenceCacheEngine, c.PocketConfig.DataDir, c.PocketConfig.EvidenceDBName, c.TendermintConfig.LevelDBOptions, c.PocketConfig.MaxEvidenceCacheEntires)
+	s1, s2 := sdk.Address(pk1.PublicKey().Address()), sdk.Address(pk2.PublicKey().Address())
+	// both servicers are in the same session
+	header := SessionHeader{
+		ApplicationPubKey:  getRandomPubKey().RawString(),
+		Chain:              hex.EncodeToString([]byte{01}),
+		SessionBlockHeight: 1,
+	}
+	SetProof(s1, header, RelayEvidence, RelayProof{Entropy: 1}, sdk.NewInt(100000))
+	SetProof(s1, header, RelayEvidence, RelayProof{Entropy: 2}, sdk.NewInt(100000))
+	SetProof(s2, header, RelayEvidence, RelayProof{Entropy: 1}, sdk.NewInt(100000))
+	e1, total1 := GetTotalProofs(s1, header, RelayEvidence, sdk.NewInt(100000))
+	_, total2 := GetTotalProofs(s2, header, RelayEvidence, sdk.NewInt(100000))
+	assert.Equal(t, int64(2), total1)
+	assert.Equal(t, int64(1), total2)
+	// sealing the evidence of one servicer doesn't seal the evidence of the other
+	e1, ok := SealEvidence(s1, e1)
+	assert.True(t, ok)
+	assert.True(t, IsEvidenceSealed(s1, e1))
+	e2, err := GetEvidence(s2, header, RelayEvidence, sdk.NewInt(100000))
+	assert.Nil(t, err)
+	assert.False(t, IsEvidenceSealed(s2, e2))
+	// deleting the evidence of one servicer keeps the evidence of the other
+	assert.Nil(t, DeleteEvidence(s1, header, RelayEvidence))
+	_, total1 = GetTotalProofs(s1, header, RelayEvidence, sdk.NewInt(100000))
+	_, total2 = GetTotalProofs(s2, header, RelayEvidence, sdk.NewInt(100000))
+	assert.Zero(t, total1)
+	assert.Equal(t, int64(1), total2)
+}
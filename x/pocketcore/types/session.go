@@ -10,16 +10,6 @@ import (
 	"log"
 )
 
-// "Session" - The relationship between an application and the pocket network
-
-func (s Session) IsSealed() bool {
-	return false
-}
-
-func (s Session) Seal() CacheObject {
-	return s
-}
-
 // "NewSession" - create a new session from seed data
 func NewSession(sessionCtx, ctx sdk.Ctx, keeper PosKeeper, sessionHeader SessionHeader, blockHash string, sessionNodesCount int) (Session, sdk.Error) {
 	// first generate session key
@@ -0,0 +1,151 @@
+package types
+
+import (
+	"encoding/hex"
+	"fmt"
+	"hash/fnv"
+	"sync"
+
+	db "github.com/tendermint/tm-db"
+)
+
+// the number of maps the objects of a sharded cache are spread over
+const cacheShards = 64
+
+// "ShardedCache" - Keeps the decoded cache objects in memory, spread over maps with their own lock. Nothing is
+// persisted and the objects are never evicted, so it's meant for nodes with enough memory for all of their evidence
+type ShardedCache struct {
+	shards [cacheShards]cacheShard
+	keys   keyLocks // guards the updates of a key
+}
+
+type cacheShard struct {
+	l       sync.RWMutex
+	objects map[string]shardedCacheEntry
+}
+
+type shardedCacheEntry struct {
+	object CacheObject
+	sealed bool
+}
+
+var _ CacheStorage = &ShardedCache{}
+
+// "NewShardedCache" - Returns an empty sharded cache storage
+func NewShardedCache() *ShardedCache {
+	cs := &ShardedCache{}
+	for i := range cs.shards {
+		cs.shards[i].objects = make(map[string]shardedCacheEntry)
+	}
+	return cs
+}
+
+func (cs *ShardedCache) shard(key string) *cacheShard {
+	h := fnv.New32a()
+	_, _ = h.Write([]byte(key))
+	return &cs.shards[h.Sum32()%cacheShards]
+}
+
+func (cs *ShardedCache) load(key string) (shardedCacheEntry, bool) {
+	s := cs.shard(key)
+	s.l.RLock()
+	defer s.l.RUnlock()
+	e, found := s.objects[key]
+	return e, found
+}
+
+// "Get" - Returns the value from a key
+func (cs *ShardedCache) Get(key []byte, _ CacheObject) (interface{}, bool) {
+	e, found := cs.load(hex.EncodeToString(key))
+	if !found {
+		return nil, false
+	}
+	return e.object, true
+}
+
+// "Update" - Reads, modifies and writes the object of the key while holding the lock of the key
+func (cs *ShardedCache) Update(key []byte, _ CacheObject, fn CacheUpdateFn) error {
+	defer cs.keys.lock(key).Unlock()
+	keyString := hex.EncodeToString(key)
+	e, found := cs.load(keyString)
+	newVal, seal, err := fn(e.object, found, e.sealed)
+	if err != nil || newVal == nil {
+		return err
+	}
+	s := cs.shard(keyString)
+	s.l.Lock()
+	defer s.l.Unlock()
+	s.objects[keyString] = shardedCacheEntry{object: newVal, sealed: seal}
+	return nil
+}
+
+// "Set" - Sets the KV pair, unless the object is sealed
+func (cs *ShardedCache) Set(key []byte, val CacheObject) {
+	_ = cs.Update(key, val, setObject(val))
+}
+
+// "Delete" - Deletes the item from the store
+func (cs *ShardedCache) Delete(key []byte) {
+	defer cs.keys.lock(key).Unlock()
+	keyString := hex.EncodeToString(key)
+	s := cs.shard(keyString)
+	s.l.Lock()
+	defer s.l.Unlock()
+	delete(s.objects, keyString)
+}
+
+// "Seal" - Seals the cache object so it is no longer writable in the cache store
+func (cs *ShardedCache) Seal(object CacheObject) (cacheObject CacheObject, isOK bool) {
+	return sealObject(cs, object)
+}
+
+// "IsSealed" - Returns true if the cache object is no longer writable in the cache store
+func (cs *ShardedCache) IsSealed(object CacheObject) bool {
+	k, err := object.Key()
+	if err != nil {
+		return false
+	}
+	e, found := cs.load(hex.EncodeToString(k))
+	return found && e.sealed
+}
+
+// "Iterator" - Returns an iterator over a copy of the store, ordered by key
+func (cs *ShardedCache) Iterator() (db.Iterator, error) {
+	snapshot := db.NewMemDB()
+	for i := range cs.shards {
+		s := &cs.shards[i]
+		s.l.RLock()
+		for key, e := range s.objects {
+			bz, err := e.object.MarshalObject()
+			if err != nil {
+				s.l.RUnlock()
+				return nil, fmt.Errorf("error iterating the sharded cache, marshalling value: %s", err.Error())
+			}
+			kBz, _ := hex.DecodeString(key)
+			_ = snapshot.Set(kBz, bz)
+		}
+		s.l.RUnlock()
+	}
+	return snapshot.Iterator(nil, nil)
+}
+
+// "FlushToDB" - Nothing is persisted by the sharded cache
+func (cs *ShardedCache) FlushToDB() error {
+	return nil
+}
+
+// "Clear" - Deletes all items from the store
+func (cs *ShardedCache) Clear() {
+	for i := range cs.shards {
+		s := &cs.shards[i]
+		s.l.Lock()
+		s.objects = make(map[string]shardedCacheEntry)
+		s.l.Unlock()
+	}
+}
+
+// "Close" - Releases the objects of the store
+func (cs *ShardedCache) Close() error {
+	cs.Clear()
+	return nil
+}
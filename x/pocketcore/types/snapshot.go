@@ -0,0 +1,150 @@
+package types
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+
+	sdk "github.com/pokt-network/pocket-core/types"
+)
+
+// the version of the evidence snapshot format
+const EvidenceSnapshotVersion = 1
+
+// "EvidenceSnapshot" - A portable copy of the evidence and the session cache of the servicers hosted by a node, used to
+// move a servicer to another node without losing its unclaimed relays
+type EvidenceSnapshot struct {
+	Version  int                `json:"version"`
+	ChainID  string             `json:"chain_id"`
+	Height   int64              `json:"height"` // the height the snapshot was taken at
+	Evidence []SnapshotEvidence `json:"evidence"`
+	Sessions [][]byte           `json:"sessions"` // the encoded sessions of the session cache
+	Checksum string             `json:"checksum"` // sha256 (hex) of the snapshot without the checksum
+}
+
+// "SnapshotEvidence" - A piece of evidence of a servicer in the snapshot
+type SnapshotEvidence struct {
+	Servicer string `json:"servicer"`
+	Sealed   bool   `json:"sealed"`   // sealed evidence was already claimed, or hit the relay limit
+	Evidence []byte `json:"evidence"` // the evidence as encoded in the evidence cache
+}
+
+// "EvidenceImportResult" - The outcome of an evidence snapshot import
+type EvidenceImportResult struct {
+	Imported int64                `json:"imported"`      // pieces of evidence added to the node
+	Merged   int64                `json:"merged"`        // pieces of evidence merged into the evidence already on the node
+	Proofs   int64                `json:"proofs"`        // the number of proofs added
+	Sessions int64                `json:"sessions"`      // the sessions added to the session cache
+	Skipped  []EvidenceImportSkip `json:"skipped"`       // the evidence left out and why
+	Height   int64                `json:"import_height"` // the height the evidence was validated at
+}
+
+// "EvidenceImportSkip" - A piece of evidence left out of the import
+type EvidenceImportSkip struct {
+	Servicer      string        `json:"servicer"`
+	SessionHeader SessionHeader `json:"session_header"`
+	EvidenceType  EvidenceType  `json:"evidence_type"`
+	Reason        string        `json:"reason"`
+}
+
+// "NewEvidenceSnapshot" - Returns a checksummed snapshot of the evidence of the servicers and of the session cache
+func NewEvidenceSnapshot(chainID string, height int64, servicers []sdk.Address) (EvidenceSnapshot, error) {
+	s := EvidenceSnapshot{
+		Version:  EvidenceSnapshotVersion,
+		ChainID:  chainID,
+		Height:   height,
+		Evidence: make([]SnapshotEvidence, 0),
+		Sessions: make([][]byte, 0),
+	}
+	for _, servicer := range servicers {
+		iter := EvidenceIterator(servicer)
+		for ; iter.Valid(); iter.Next() {
+			evidence := iter.Value()
+			bz, err := evidence.MarshalObject()
+			if err != nil {
+				iter.Close()
+				return EvidenceSnapshot{}, err
+			}
+			s.Evidence = append(s.Evidence, SnapshotEvidence{
+				Servicer: servicer.String(),
+				Sealed:   IsEvidenceSealed(servicer, evidence),
+				Evidence: bz,
+			})
+		}
+		iter.Close()
+	}
+	if globalSessionCache != nil {
+		iter := SessionIterator()
+		for ; iter.Valid(); iter.Next() {
+			s.Sessions = append(s.Sessions, append([]byte{}, iter.Iterator.Value()...))
+		}
+		iter.Close()
+	}
+	checksum, err := s.ComputeChecksum()
+	if err != nil {
+		return EvidenceSnapshot{}, err
+	}
+	s.Checksum = checksum
+	return s, nil
+}
+
+// "ComputeChecksum" - Returns the sha256 (hex) of the snapshot without its checksum
+func (s EvidenceSnapshot) ComputeChecksum() (string, error) {
+	s.Checksum = ""
+	bz, err := json.Marshal(s)
+	if err != nil {
+		return "", err
+	}
+	hash := sha256.Sum256(bz)
+	return hex.EncodeToString(hash[:]), nil
+}
+
+// "ValidateBasic" - Checks the version and the checksum of the snapshot
+func (s EvidenceSnapshot) ValidateBasic() sdk.Error {
+	if s.Version != EvidenceSnapshotVersion {
+		return NewSnapshotVersionError(ModuleName, s.Version)
+	}
+	checksum, err := s.ComputeChecksum()
+	if err != nil || checksum != s.Checksum {
+		return NewSnapshotChecksumError(ModuleName)
+	}
+	return nil
+}
+
+// "ImportEvidence" - Adds the imported evidence to the evidence cache of the servicer. The proofs are merged into the
+// evidence the servicer already has on this node, unless it is sealed. If replace is set, the evidence on this node is
+// replaced instead (used when the evidence was claimed and has to match the claim). Returns the number of proofs added
+// and whether the evidence was merged
+func ImportEvidence(servicer sdk.Address, imported Evidence, sealed, replace bool) (proofs int64, merged bool, err error) {
+	key, err := imported.Key()
+	if err != nil {
+		return 0, false, err
+	}
+	err = getEvidenceCache(servicer).Update(key, Evidence{}, func(val CacheObject, found, isSealed bool) (CacheObject, bool, error) {
+		if !found || replace {
+			proofs, merged = imported.NumOfProofs, false
+			return imported, sealed, nil
+		}
+		local, ok := val.(Evidence)
+		if !ok {
+			return nil, false, fmt.Errorf("could not unmarshal into evidence from cache with header %v", imported.SessionHeader)
+		}
+		if isSealed {
+			return nil, false, fmt.Errorf("the evidence on this node is sealed")
+		}
+		proofs = 0
+		for _, p := range imported.Proofs {
+			if IsUniqueProof(p, local) {
+				local.AddProof(p)
+				proofs++
+			}
+		}
+		merged = true
+		return local, sealed, nil
+	})
+	if err != nil {
+		return 0, false, err
+	}
+	return proofs, merged, nil
+}
@@ -0,0 +1,61 @@
+package types
+
+import (
+	"encoding/hex"
+	"testing"
+
+	sdk "github.com/pokt-network/pocket-core/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEvidenceSnapshot_ImportEvidence(t *testing.T) {
+	ClearEvidence()
+	defer ClearEvidence()
+	servicer := sdk.Address(getRandomPubKey().Address())
+	header := SessionHeader{
+		ApplicationPubKey:  getRandomPubKey().RawString(),
+		Chain:              hex.EncodeToString([]byte{01}),
+		SessionBlockHeight: 1,
+	}
+	for i := int64(1); i <= 3; i++ {
+		SetProof(servicer, header, RelayEvidence, RelayProof{Entropy: i}, sdk.NewInt(100000))
+	}
+	snapshot, err := NewEvidenceSnapshot("test-chain", 10, []sdk.Address{servicer})
+	assert.Nil(t, err)
+	assert.Nil(t, snapshot.ValidateBasic())
+	assert.Len(t, snapshot.Evidence, 1)
+	assert.False(t, snapshot.Evidence[0].Sealed)
+	o, err := Evidence{}.UnmarshalObject(snapshot.Evidence[0].Evidence)
+	assert.Nil(t, err)
+	imported := o.(Evidence)
+	// the proofs are merged into the evidence of the node, without duplicates
+	assert.Nil(t, DeleteEvidence(servicer, header, RelayEvidence))
+	SetProof(servicer, header, RelayEvidence, RelayProof{Entropy: 1}, sdk.NewInt(100000))
+	SetProof(servicer, header, RelayEvidence, RelayProof{Entropy: 4}, sdk.NewInt(100000))
+	proofs, merged, err := ImportEvidence(servicer, imported, false, false)
+	assert.Nil(t, err)
+	assert.True(t, merged)
+	assert.Equal(t, int64(2), proofs)
+	_, total := GetTotalProofs(servicer, header, RelayEvidence, sdk.NewInt(100000))
+	assert.Equal(t, int64(4), total)
+	// sealed evidence is never merged into, but can be replaced
+	local, err := GetEvidence(servicer, header, RelayEvidence, sdk.ZeroInt())
+	assert.Nil(t, err)
+	_, ok := SealEvidence(servicer, local)
+	assert.True(t, ok)
+	_, _, err = ImportEvidence(servicer, imported, false, false)
+	assert.NotNil(t, err)
+	proofs, merged, err = ImportEvidence(servicer, imported, true, true)
+	assert.Nil(t, err)
+	assert.False(t, merged)
+	assert.Equal(t, int64(3), proofs)
+	local, err = GetEvidence(servicer, header, RelayEvidence, sdk.ZeroInt())
+	assert.Nil(t, err)
+	assert.Equal(t, int64(3), local.NumOfProofs)
+	assert.True(t, IsEvidenceSealed(servicer, local))
+	// a changed snapshot doesn't match its checksum
+	snapshot.Evidence[0].Sealed = true
+	assert.NotNil(t, snapshot.ValidateBasic())
+	snapshot.Version = 2
+	assert.Equal(t, NewSnapshotVersionError(ModuleName, 2).Code(), snapshot.ValidateBasic().Code())
+}
@@ -0,0 +1,275 @@
+package types
+
+import (
+	"fmt"
+	"hash/fnv"
+	"sort"
+	"sync"
+
+	sdk "github.com/pokt-network/pocket-core/types"
+)
+
+// the number of blocks a finished submission is kept after its deadline, so it can still be queried
+const submissionRetentionBlocks = 100
+
+// "SubmissionType" - The kind of transaction submitted for a piece of evidence
+type SubmissionType string
+
+const (
+	ClaimSubmission SubmissionType = "claim"
+	ProofSubmission SubmissionType = "proof"
+)
+
+// "SubmissionStatus" - The state of a submission in the queue
+type SubmissionStatus string
+
+const (
+	SubmissionPending   SubmissionStatus = "pending"   // waiting for the next attempt
+	SubmissionBroadcast SubmissionStatus = "broadcast" // accepted by the mempool, waiting for inclusion
+	SubmissionIncluded  SubmissionStatus = "included"  // included in a block without error
+	SubmissionExpired   SubmissionStatus = "expired"   // the submission window closed before it was included
+)
+
+// "Submission" - A claim or proof transaction tracked from scheduling to inclusion
+type Submission struct {
+	Servicer          string           `json:"servicer"`
+	Type              SubmissionType   `json:"type"`
+	SessionHeader     SessionHeader    `json:"session_header"`
+	EvidenceType      EvidenceType     `json:"evidence_type"`
+	Status            SubmissionStatus `json:"status"`
+	TxHash            string           `json:"tx_hash"`
+	Attempts          int64            `json:"attempts"`
+	LastAttemptHeight int64            `json:"last_attempt_height"`
+	NextAttemptHeight int64            `json:"next_attempt_height"`
+	IncludedHeight    int64            `json:"included_height"`
+	DeadlineHeight    int64            `json:"deadline_height"` // the last height the transaction is sent at
+	Error             string           `json:"error"`
+}
+
+// "TxLookupFn" - Looks up a transaction by hash in the tx indexer, found is false while it isn't included
+type TxLookupFn func(txHash string) (height int64, code uint32, log string, found bool)
+
+// the claims and proofs sent by this node, kept in memory: after a restart the evidence and the world state are enough
+// to schedule them again
+var globalSubmissionQueue = struct {
+	l          sync.Mutex
+	m          map[string]*Submission
+	sentHeight int64 // the height of the last transaction sent
+	sentCount  int   // the number of transactions sent at sentHeight
+}{m: make(map[string]*Submission)}
+
+// "NewSubmission" - Returns the submission identified by the servicer, the session header, the evidence and tx type
+func NewSubmission(servicer sdk.Address, header SessionHeader, evidenceType EvidenceType, submissionType SubmissionType) Submission {
+	return Submission{
+		Servicer:      servicer.String(),
+		Type:          submissionType,
+		SessionHeader: header,
+		EvidenceType:  evidenceType,
+	}
+}
+
+// "Key" - The key of the submission in the queue
+func (s Submission) Key() string {
+	return fmt.Sprintf("%s/%s/%s/%d", s.Servicer, s.Type, s.SessionHeader.HashString(), s.EvidenceType)
+}
+
+// "IsFinished" - Returns true if no more attempts will be made for the submission
+func (s Submission) IsFinished() bool {
+	return s.Status == SubmissionIncluded || s.Status == SubmissionExpired
+}
+
+// "ScheduleSubmission" - Adds the submission to the queue if it isn't tracked yet, the first attempt is spread over
+// the next blocks (within the deadline) so the transactions of a node don't all land in the same block.
+// Returns the submission as tracked in the queue
+func ScheduleSubmission(s Submission, height, deadline int64) Submission {
+	globalSubmissionQueue.l.Lock()
+	defer globalSubmissionQueue.l.Unlock()
+	key := s.Key()
+	if tracked, found := globalSubmissionQueue.m[key]; found {
+		return *tracked
+	}
+	s.Status = SubmissionPending
+	s.DeadlineHeight = deadline
+	s.NextAttemptHeight = height
+	if spread := GlobalPocketConfig.SubmissionSpreadBlocks; spread > 1 {
+		h := fnv.New64a()
+		_, _ = h.Write([]byte(key))
+		s.NextAttemptHeight += int64(h.Sum64() % uint64(spread))
+	}
+	if s.NextAttemptHeight > deadline {
+		s.NextAttemptHeight = deadline
+	}
+	globalSubmissionQueue.m[key] = &s
+	return s
+}
+
+// "GetSubmission" - Returns the submission as tracked in the queue
+func GetSubmission(s Submission) (Submission, bool) {
+	globalSubmissionQueue.l.Lock()
+	defer globalSubmissionQueue.l.Unlock()
+	tracked, found := globalSubmissionQueue.m[s.Key()]
+	if !found {
+		return Submission{}, false
+	}
+	return *tracked, true
+}
+
+// "IsSubmissionDue" - Returns true if the submission is pending and its next attempt is at or before the height
+func IsSubmissionDue(s Submission, height int64) bool {
+	tracked, found := GetSubmission(s)
+	if !found || tracked.Status != SubmissionPending {
+		return false
+	}
+	return height >= tracked.NextAttemptHeight && height <= tracked.DeadlineHeight
+}
+
+// "HasDueSubmissions" - Returns true if any submission in the queue is due at the height
+func HasDueSubmissions(height int64) bool {
+	globalSubmissionQueue.l.Lock()
+	defer globalSubmissionQueue.l.Unlock()
+	for _, s := range globalSubmissionQueue.m {
+		if s.Status == SubmissionPending && height >= s.NextAttemptHeight && height <= s.DeadlineHeight {
+			return true
+		}
+	}
+	return false
+}
+
+// "ReserveSubmission" - Reserves one of the transactions a node is allowed to send at the height (submission max per
+// block), returns false if there's none left and the submission must wait for the next block
+func ReserveSubmission(height int64) bool {
+	globalSubmissionQueue.l.Lock()
+	defer globalSubmissionQueue.l.Unlock()
+	if globalSubmissionQueue.sentHeight != height {
+		globalSubmissionQueue.sentHeight = height
+		globalSubmissionQueue.sentCount = 0
+	}
+	if max := GlobalPocketConfig.SubmissionMaxPerBlock; max > 0 && globalSubmissionQueue.sentCount >= max {
+		return false
+	}
+	globalSubmissionQueue.sentCount++
+	return true
+}
+
+// "MarkSubmissionSent" - Records the result of an attempt, a failed attempt is retried with an exponential backoff
+func MarkSubmissionSent(s Submission, height int64, res *sdk.TxResponse, err error) {
+	if err == nil && res != nil && res.Code != 0 {
+		err = fmt.Errorf("the transaction was rejected with code %d: %s", res.Code, res.RawLog)
+	}
+	if err == nil && res == nil {
+		err = fmt.Errorf("no response was returned for the transaction")
+	}
+	globalSubmissionQueue.l.Lock()
+	defer globalSubmissionQueue.l.Unlock()
+	tracked, found := globalSubmissionQueue.m[s.Key()]
+	if !found {
+		return
+	}
+	tracked.Attempts++
+	tracked.LastAttemptHeight = height
+	if err != nil {
+		tracked.failWithoutLock(height, err.Error())
+		return
+	}
+	tracked.Status = SubmissionBroadcast
+	tracked.TxHash = res.TxHash
+	tracked.Error = ""
+}
+
+// "MarkSubmissionIncluded" - Marks the submission as included, does nothing if it isn't tracked
+func MarkSubmissionIncluded(s Submission, height int64) {
+	globalSubmissionQueue.l.Lock()
+	defer globalSubmissionQueue.l.Unlock()
+	if tracked, found := globalSubmissionQueue.m[s.Key()]; found && tracked.Status != SubmissionIncluded {
+		tracked.Status = SubmissionIncluded
+		tracked.IncludedHeight = height
+		tracked.Error = ""
+	}
+}
+
+// "UpdateSubmissions" - Tracks the broadcast transactions through inclusion with the tx indexer, schedules a retry for
+// the ones that failed or were not included within the submission timeout, expires the ones past their deadline and
+// prunes the finished ones
+func UpdateSubmissions(height int64, lookup TxLookupFn) {
+	type txResult struct {
+		height int64
+		code   uint32
+		log    string
+		found  bool
+	}
+	// look up the broadcast transactions without holding the queue
+	globalSubmissionQueue.l.Lock()
+	hashes := make(map[string]string)
+	for key, s := range globalSubmissionQueue.m {
+		if s.Status == SubmissionBroadcast {
+			hashes[key] = s.TxHash
+		}
+	}
+	globalSubmissionQueue.l.Unlock()
+	results := make(map[string]txResult, len(hashes))
+	for key, txHash := range hashes {
+		var r txResult
+		r.height, r.code, r.log, r.found = lookup(txHash)
+		results[key] = r
+	}
+	globalSubmissionQueue.l.Lock()
+	defer globalSubmissionQueue.l.Unlock()
+	for key, s := range globalSubmissionQueue.m {
+		if r, looked := results[key]; looked && s.Status == SubmissionBroadcast && s.TxHash == hashes[key] {
+			switch {
+			case r.found && r.code == 0:
+				s.Status = SubmissionIncluded
+				s.IncludedHeight = r.height
+			case r.found:
+				s.failWithoutLock(height, fmt.Sprintf("the transaction failed with code %d: %s", r.code, r.log))
+			case height-s.LastAttemptHeight >= GlobalPocketConfig.SubmissionTimeoutBlocks:
+				s.failWithoutLock(height, fmt.Sprintf("the transaction was not included after %d blocks", height-s.LastAttemptHeight))
+			}
+		}
+		if !s.IsFinished() && height > s.DeadlineHeight {
+			s.Status = SubmissionExpired
+		}
+		if s.IsFinished() && height > s.DeadlineHeight+submissionRetentionBlocks {
+			delete(globalSubmissionQueue.m, key)
+		}
+	}
+}
+
+// "GetSubmissions" - Returns every submission in the queue ordered by deadline
+func GetSubmissions() []Submission {
+	globalSubmissionQueue.l.Lock()
+	defer globalSubmissionQueue.l.Unlock()
+	res := make([]Submission, 0, len(globalSubmissionQueue.m))
+	for _, s := range globalSubmissionQueue.m {
+		res = append(res, *s)
+	}
+	sort.Slice(res, func(i, j int) bool {
+		if res[i].DeadlineHeight != res[j].DeadlineHeight {
+			return res[i].DeadlineHeight < res[j].DeadlineHeight
+		}
+		return res[i].Key() < res[j].Key()
+	})
+	return res
+}
+
+// "ClearSubmissions" - Removes every submission from the queue
+func ClearSubmissions() {
+	globalSubmissionQueue.l.Lock()
+	defer globalSubmissionQueue.l.Unlock()
+	globalSubmissionQueue.m = make(map[string]*Submission)
+	globalSubmissionQueue.sentHeight, globalSubmissionQueue.sentCount = 0, 0
+}
+
+// "failWithoutLock" - Schedules the next attempt after 1, 2, 4, 8... blocks, never past the deadline
+func (s *Submission) failWithoutLock(height int64, err string) {
+	s.Status = SubmissionPending
+	s.Error = err
+	backoff := int64(1)
+	for i := int64(1); i < s.Attempts && backoff < 64; i++ {
+		backoff *= 2
+	}
+	s.NextAttemptHeight = height + backoff
+	if s.NextAttemptHeight > s.DeadlineHeight {
+		s.NextAttemptHeight = s.DeadlineHeight
+	}
+}
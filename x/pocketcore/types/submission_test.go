@@ -0,0 +1,95 @@
+package types
+
+import (
+	"errors"
+	"testing"
+
+	sdk "github.com/pokt-network/pocket-core/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSubmissions_RetryAndInclusion(t *testing.T) {
+	ClearSubmissions()
+	defer ClearSubmissions()
+	servicer := sdk.Address(getRandomPubKey().Address())
+	header := SessionHeader{ApplicationPubKey: getRandomPubKey().RawString(), Chain: "0001", SessionBlockHeight: 1}
+	s := NewSubmission(servicer, header, RelayEvidence, ClaimSubmission)
+	tracked := ScheduleSubmission(s, 5, 20)
+	assert.Equal(t, SubmissionPending, tracked.Status)
+	// the first attempt is spread over the next blocks
+	assert.True(t, tracked.NextAttemptHeight >= 5 && tracked.NextAttemptHeight < 5+GlobalPocketConfig.SubmissionSpreadBlocks)
+	// scheduling again keeps the tracked submission
+	assert.Equal(t, tracked, ScheduleSubmission(s, 6, 30))
+	height := tracked.NextAttemptHeight
+	assert.False(t, IsSubmissionDue(s, height-1))
+	assert.True(t, IsSubmissionDue(s, height))
+	assert.False(t, HasDueSubmissions(height-1))
+	assert.True(t, HasDueSubmissions(height))
+	// a rejected tx is retried after a backoff
+	MarkSubmissionSent(s, height, nil, errors.New("mempool is full"))
+	tracked, _ = GetSubmission(s)
+	assert.Equal(t, SubmissionPending, tracked.Status)
+	assert.Equal(t, "mempool is full", tracked.Error)
+	assert.Equal(t, height+1, tracked.NextAttemptHeight)
+	assert.False(t, IsSubmissionDue(s, height))
+	height++
+	MarkSubmissionSent(s, height, &sdk.TxResponse{TxHash: "aa"}, nil)
+	tracked, _ = GetSubmission(s)
+	assert.Equal(t, SubmissionBroadcast, tracked.Status)
+	assert.Equal(t, int64(2), tracked.Attempts)
+	assert.False(t, IsSubmissionDue(s, height+1))
+	assert.False(t, HasDueSubmissions(height+1))
+	// not found in the indexer within the timeout, so it's retried with a longer backoff
+	notFound := func(txHash string) (int64, uint32, string, bool) { return 0, 0, "", false }
+	UpdateSubmissions(height+1, notFound)
+	tracked, _ = GetSubmission(s)
+	assert.Equal(t, SubmissionBroadcast, tracked.Status)
+	height += GlobalPocketConfig.SubmissionTimeoutBlocks
+	UpdateSubmissions(height, notFound)
+	tracked, _ = GetSubmission(s)
+	assert.Equal(t, SubmissionPending, tracked.Status)
+	assert.Equal(t, height+2, tracked.NextAttemptHeight)
+	height += 2
+	MarkSubmissionSent(s, height, &sdk.TxResponse{TxHash: "bb"}, nil)
+	UpdateSubmissions(height+1, func(txHash string) (int64, uint32, string, bool) {
+		assert.Equal(t, "bb", txHash)
+		return height + 1, 0, "", true
+	})
+	tracked, _ = GetSubmission(s)
+	assert.Equal(t, SubmissionIncluded, tracked.Status)
+	assert.Equal(t, height+1, tracked.IncludedHeight)
+	// finished submissions are pruned after their deadline
+	UpdateSubmissions(20+submissionRetentionBlocks, notFound)
+	assert.Len(t, GetSubmissions(), 1)
+	UpdateSubmissions(21+submissionRetentionBlocks, notFound)
+	assert.Len(t, GetSubmissions(), 0)
+}
+
+func TestSubmissions_Expire(t *testing.T) {
+	ClearSubmissions()
+	defer ClearSubmissions()
+	servicer := sdk.Address(getRandomPubKey().Address())
+	header := SessionHeader{ApplicationPubKey: getRandomPubKey().RawString(), Chain: "0001", SessionBlockHeight: 1}
+	s := NewSubmission(servicer, header, RelayEvidence, ProofSubmission)
+	tracked := ScheduleSubmission(s, 10, 10)
+	// the spread never goes past the deadline
+	assert.Equal(t, int64(10), tracked.NextAttemptHeight)
+	MarkSubmissionSent(s, 10, &sdk.TxResponse{TxHash: "aa", Code: 5, RawLog: "insufficient fee"}, nil)
+	tracked, _ = GetSubmission(s)
+	assert.Equal(t, SubmissionPending, tracked.Status)
+	assert.Contains(t, tracked.Error, "insufficient fee")
+	assert.False(t, IsSubmissionDue(s, 11))
+	UpdateSubmissions(11, func(txHash string) (int64, uint32, string, bool) { return 0, 0, "", false })
+	tracked, _ = GetSubmission(s)
+	assert.Equal(t, SubmissionExpired, tracked.Status)
+}
+
+func TestSubmissions_MaxPerBlock(t *testing.T) {
+	ClearSubmissions()
+	defer ClearSubmissions()
+	for i := 0; i < GlobalPocketConfig.SubmissionMaxPerBlock; i++ {
+		assert.True(t, ReserveSubmission(1))
+	}
+	assert.False(t, ReserveSubmission(1))
+	assert.True(t, ReserveSubmission(2))
+}
@@ -0,0 +1,237 @@
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	sdk "github.com/pokt-network/pocket-core/types"
+)
+
+const (
+	EVMSyncProbe        = "evm"        // eth_syncing and the age of the latest block
+	TendermintSyncProbe = "tendermint" // catching_up and the age of the latest block of the status rpc
+	// the interval (ms) of the sync probes of a chain that doesn't set one
+	DefaultSyncCheckInterval = 30000
+)
+
+// "SyncCheck" - The probe used to verify the backends of a hosted blockchain are in sync
+type SyncCheck struct {
+	Type        string `json:"type"`               // the chain family of the probe (evm, tendermint)
+	MaxBlockAge int64  `json:"max_block_age"`      // seconds after which the latest block is stale, 0 disables the check
+	Interval    int64  `json:"interval,omitempty"` // ms between two probes of the endpoints, defaults to 30000
+}
+
+// "ChainSyncState" - The result of the last sync probe of a hosted blockchain, it is degraded when none of its
+// endpoints is in sync
+type ChainSyncState struct {
+	Degraded  bool      `json:"degraded"`
+	Reason    string    `json:"reason,omitempty"`
+	LastCheck time.Time `json:"last_check"`
+}
+
+// "SyncProbe" - Returns an error if the endpoint of the hosted blockchain is not in sync
+type SyncProbe func(chain HostedBlockchain, endpoint HostedEndpoint, check SyncCheck) error
+
+// the sync probes that hosted chains can enable by type
+var globalSyncProbes = struct {
+	l sync.RWMutex
+	m map[string]SyncProbe
+}{m: map[string]SyncProbe{
+	EVMSyncProbe:        probeEVMSync,
+	TendermintSyncProbe: probeTendermintSync,
+}}
+
+// the sync state of the hosted blockchains that enable a sync check
+var globalChainSyncStates = struct {
+	l sync.RWMutex
+	m map[string]ChainSyncState
+}{m: make(map[string]ChainSyncState)}
+
+// "RegisterSyncProbe" - Makes the probe available to the hosted chains under the type
+func RegisterSyncProbe(probeType string, probe SyncProbe) {
+	globalSyncProbes.l.Lock()
+	defer globalSyncProbes.l.Unlock()
+	globalSyncProbes.m[probeType] = probe
+}
+
+// "getSyncProbe" - Returns the probe registered under the type
+func getSyncProbe(probeType string) (SyncProbe, bool) {
+	globalSyncProbes.l.RLock()
+	defer globalSyncProbes.l.RUnlock()
+	probe, found := globalSyncProbes.m[probeType]
+	return probe, found
+}
+
+// "GetChainSyncState" - Returns the sync state of the hosted blockchain, nil if it was never probed
+func GetChainSyncState(chainID string) *ChainSyncState {
+	globalChainSyncStates.l.RLock()
+	defer globalChainSyncStates.l.RUnlock()
+	state, found := globalChainSyncStates.m[chainID]
+	if !found {
+		return nil
+	}
+	return &state
+}
+
+// "ValidateChainSynced" - Refuses relays for a hosted blockchain whose backend is out of sync
+func ValidateChainSynced(chainID string) sdk.Error {
+	if state := GetChainSyncState(chainID); state != nil && state.Degraded {
+		return NewChainDegradedError(ModuleName, state.Reason)
+	}
+	return nil
+}
+
+// "StartChainSyncChecks" - Probes the endpoints of the hosted blockchains with a sync check, each chain at its interval
+func StartChainSyncChecks(hostedBlockchains *HostedBlockchains) {
+	go func() {
+		for {
+			hostedBlockchains.L.Lock()
+			chains := make(map[string]HostedBlockchain, len(hostedBlockchains.M))
+			for id, chain := range hostedBlockchains.M {
+				chains[id] = chain
+			}
+			hostedBlockchains.L.Unlock()
+			pruneChainSyncStates(chains)
+			for _, chain := range chains {
+				if chain.SyncCheck == nil {
+					continue
+				}
+				interval := chain.SyncCheck.Interval
+				if interval <= 0 {
+					interval = DefaultSyncCheckInterval
+				}
+				if state := GetChainSyncState(chain.ID); state == nil || time.Since(state.LastCheck) >= time.Duration(interval)*time.Millisecond {
+					checkChainSync(chain)
+				}
+			}
+			time.Sleep(time.Second)
+		}
+	}()
+}
+
+// "checkChainSync" - Probes every endpoint of the hosted blockchain, an endpoint out of sync is unhealthy and the
+// chain is degraded when none of its endpoints is in sync
+func checkChainSync(chain HostedBlockchain) {
+	if chain.SyncCheck == nil {
+		return
+	}
+	state := ChainSyncState{LastCheck: time.Now()}
+	probe, found := getSyncProbe(chain.SyncCheck.Type)
+	if !found {
+		state.Degraded, state.Reason = true, "unknown sync probe: "+chain.SyncCheck.Type
+		setChainSyncState(chain.ID, state)
+		return
+	}
+	var reasons []string
+	for _, endpoint := range chain.GetEndpoints() {
+		start := time.Now()
+		if err := probe(chain, endpoint, *chain.SyncCheck); err != nil {
+			markEndpointUnhealthy(chain.ID, endpoint.URL)
+			reasons = append(reasons, endpoint.URL+": "+err.Error())
+			continue
+		}
+		ReportEndpointSuccess(chain.ID, endpoint.URL, time.Since(start))
+	}
+	if len(reasons) == len(chain.GetEndpoints()) {
+		state.Degraded = true
+	}
+	state.Reason = strings.Join(reasons, "; ")
+	setChainSyncState(chain.ID, state)
+}
+
+func setChainSyncState(chainID string, state ChainSyncState) {
+	globalChainSyncStates.l.Lock()
+	globalChainSyncStates.m[chainID] = state
+	globalChainSyncStates.l.Unlock()
+	GlobalServiceMetric().SetChainDegradedFor(chainID, state.Degraded)
+}
+
+// "pruneChainSyncStates" - Removes the state of the chains that are no longer hosted or probed
+func pruneChainSyncStates(chains map[string]HostedBlockchain) {
+	globalChainSyncStates.l.Lock()
+	defer globalChainSyncStates.l.Unlock()
+	for id := range globalChainSyncStates.m {
+		if chain, found := chains[id]; !found || chain.SyncCheck == nil {
+			delete(globalChainSyncStates.m, id)
+		}
+	}
+}
+
+// "probeEVMSync" - The backend is degraded while eth_syncing reports progress or the latest block is too old
+func probeEVMSync(chain HostedBlockchain, endpoint HostedEndpoint, check SyncCheck) error {
+	var syncing json.RawMessage
+	if err := probeJSONRPC(chain, endpoint, "eth_syncing", `[]`, &syncing); err != nil {
+		return err
+	}
+	if strings.TrimSpace(string(syncing)) != "false" {
+		return fmt.Errorf("the backend is syncing")
+	}
+	if check.MaxBlockAge <= 0 {
+		return nil
+	}
+	var block struct {
+		Timestamp string `json:"timestamp"`
+	}
+	if err := probeJSONRPC(chain, endpoint, "eth_getBlockByNumber", `["latest",false]`, &block); err != nil {
+		return err
+	}
+	timestamp, err := strconv.ParseInt(strings.TrimPrefix(block.Timestamp, "0x"), 16, 64)
+	if err != nil {
+		return fmt.Errorf("invalid latest block timestamp: %s", block.Timestamp)
+	}
+	return checkBlockAge(time.Unix(timestamp, 0), check.MaxBlockAge)
+}
+
+// "probeTendermintSync" - The backend is degraded while it is catching up or the latest block is too old
+func probeTendermintSync(chain HostedBlockchain, endpoint HostedEndpoint, check SyncCheck) error {
+	var status struct {
+		SyncInfo struct {
+			LatestBlockTime time.Time `json:"latest_block_time"`
+			CatchingUp      bool      `json:"catching_up"`
+		} `json:"sync_info"`
+	}
+	if err := probeJSONRPC(chain, endpoint, "status", `[]`, &status); err != nil {
+		return err
+	}
+	if status.SyncInfo.CatchingUp {
+		return fmt.Errorf("the backend is catching up")
+	}
+	if check.MaxBlockAge <= 0 {
+		return nil
+	}
+	return checkBlockAge(status.SyncInfo.LatestBlockTime, check.MaxBlockAge)
+}
+
+func checkBlockAge(blockTime time.Time, maxBlockAge int64) error {
+	if age := time.Since(blockTime); age > time.Duration(maxBlockAge)*time.Second {
+		return fmt.Errorf("the latest block is %d seconds old", int64(age.Seconds()))
+	}
+	return nil
+}
+
+// "probeJSONRPC" - Sends the json-rpc request to the endpoint of the chain and decodes the result
+func probeJSONRPC(chain HostedBlockchain, endpoint HostedEndpoint, method, params string, result interface{}) error {
+	payload := fmt.Sprintf(`{"jsonrpc":"2.0","method":"%s","params":%s,"id":1}`, method, params)
+	body, _, err := executeHTTPRequest(GetRelayClient(chain.ID), payload, strings.Trim(endpoint.URL, `/`), GlobalPocketConfig.UserAgent, endpoint.BasicAuth, DEFAULTHTTPMETHOD, nil, chain.ResponseLimit())
+	if err != nil {
+		return err
+	}
+	var resp struct {
+		Result json.RawMessage `json:"result"`
+		Error  json.RawMessage `json:"error"`
+	}
+	if err := json.Unmarshal([]byte(body), &resp); err != nil {
+		return fmt.Errorf("invalid %s response: %s", method, err.Error())
+	}
+	if len(resp.Error) != 0 && string(resp.Error) != "null" {
+		return fmt.Errorf("%s returned an error: %s", method, string(resp.Error))
+	}
+	if err := json.Unmarshal(resp.Result, result); err != nil {
+		return fmt.Errorf("invalid %s result: %s", method, err.Error())
+	}
+	return nil
+}
@@ -0,0 +1,106 @@
+package types
+
+import (
+	"encoding/hex"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/h2non/gock.v1"
+)
+
+func TestCheckChainSync_EVM(t *testing.T) {
+	resetEndpointStates()
+	defer resetEndpointStates()
+	ethereum := hex.EncodeToString([]byte{07})
+	chain := HostedBlockchain{
+		ID:        ethereum,
+		URL:       "https://eth.com",
+		SyncCheck: &SyncCheck{Type: EVMSyncProbe, MaxBlockAge: 60},
+	}
+	defer gock.Off()
+	gock.InterceptClient(GetRelayClient(ethereum))
+	// the backend is syncing
+	gock.New("https://eth.com").Post("/").BodyString("eth_syncing").
+		Reply(200).BodyString(`{"jsonrpc":"2.0","id":1,"result":{"currentBlock":"0x1","highestBlock":"0x2"}}`)
+	checkChainSync(chain)
+	assert.NotNil(t, ValidateChainSynced(ethereum))
+	assert.Equal(t, CodeChainDegradedError, int(ValidateChainSynced(ethereum).Code()))
+	// the latest block is stale
+	gock.New("https://eth.com").Post("/").BodyString("eth_syncing").
+		Times(2).Reply(200).BodyString(`{"jsonrpc":"2.0","id":1,"result":false}`)
+	gock.New("https://eth.com").Post("/").BodyString("eth_getBlockByNumber").
+		Reply(200).BodyString(fmt.Sprintf(`{"jsonrpc":"2.0","id":1,"result":{"timestamp":"0x%x"}}`, time.Now().Add(-time.Hour).Unix()))
+	checkChainSync(chain)
+	state := GetChainSyncState(ethereum)
+	assert.True(t, state.Degraded)
+	assert.Contains(t, state.Reason, "seconds old")
+	// the backend recovered
+	gock.New("https://eth.com").Post("/").BodyString("eth_getBlockByNumber").
+		Reply(200).BodyString(fmt.Sprintf(`{"jsonrpc":"2.0","id":1,"result":{"timestamp":"0x%x"}}`, time.Now().Unix()))
+	checkChainSync(chain)
+	assert.False(t, GetChainSyncState(ethereum).Degraded)
+	assert.Nil(t, ValidateChainSynced(ethereum))
+	// chains that are no longer probed are forgotten
+	chain.SyncCheck = nil
+	pruneChainSyncStates(map[string]HostedBlockchain{ethereum: chain})
+	assert.Nil(t, GetChainSyncState(ethereum))
+}
+
+func TestCheckChainSync_Tendermint(t *testing.T) {
+	resetEndpointStates()
+	defer resetEndpointStates()
+	cosmos := hex.EncodeToString([]byte{8})
+	chain := HostedBlockchain{
+		ID:        cosmos,
+		URL:       "https://cosmos.com",
+		SyncCheck: &SyncCheck{Type: TendermintSyncProbe},
+	}
+	defer gock.Off()
+	gock.InterceptClient(GetRelayClient(cosmos))
+	gock.New("https://cosmos.com").Post("/").
+		Reply(200).BodyString(`{"jsonrpc":"2.0","id":1,"result":{"sync_info":{"catching_up":true,"latest_block_time":"2021-01-01T00:00:00Z"}}}`)
+	checkChainSync(chain)
+	assert.True(t, GetChainSyncState(cosmos).Degraded)
+	// an unreachable backend is degraded too
+	checkChainSync(chain)
+	assert.True(t, GetChainSyncState(cosmos).Degraded)
+	gock.New("https://cosmos.com").Post("/").
+		Reply(200).BodyString(`{"jsonrpc":"2.0","id":1,"result":{"sync_info":{"catching_up":false,"latest_block_time":"2021-01-01T00:00:00Z"}}}`)
+	checkChainSync(chain)
+	assert.False(t, GetChainSyncState(cosmos).Degraded)
+}
+
+func TestCheckChainSync_Endpoints(t *testing.T) {
+	resetEndpointStates()
+	defer resetEndpointStates()
+	ethereum := hex.EncodeToString([]byte{9})
+	chain := HostedBlockchain{
+		ID:        ethereum,
+		Endpoints: []HostedEndpoint{{URL: "https://a.com"}, {URL: "https://b.com"}},
+		SyncCheck: &SyncCheck{Type: EVMSyncProbe},
+	}
+	defer gock.Off()
+	gock.InterceptClient(GetRelayClient(ethereum))
+	// every endpoint is probed, the one out of sync is unhealthy and the chain still serves relays
+	gock.New("https://a.com").Post("/").
+		Reply(200).BodyString(`{"jsonrpc":"2.0","id":1,"result":{"currentBlock":"0x1","highestBlock":"0x2"}}`)
+	gock.New("https://b.com").Post("/").
+		Reply(200).BodyString(`{"jsonrpc":"2.0","id":1,"result":false}`)
+	checkChainSync(chain)
+	assert.False(t, gock.IsPending())
+	assert.Nil(t, ValidateChainSynced(ethereum))
+	assert.Contains(t, GetChainSyncState(ethereum).Reason, "https://a.com")
+	states := GetEndpointStates(chain)
+	assert.False(t, states[0].Healthy)
+	assert.True(t, states[1].Healthy)
+	assert.Equal(t, "https://b.com", OrderEndpoints(chain)[0].URL)
+	// the chain is degraded once none of its endpoints is in sync
+	gock.New("https://a.com").Post("/").
+		Reply(200).BodyString(`{"jsonrpc":"2.0","id":1,"result":{"currentBlock":"0x1","highestBlock":"0x2"}}`)
+	gock.New("https://b.com").Post("/").
+		Reply(200).BodyString(`{"jsonrpc":"2.0","id":1,"result":{"currentBlock":"0x1","highestBlock":"0x2"}}`)
+	checkChainSync(chain)
+	assert.NotNil(t, ValidateChainSynced(ethereum))
+}
@@ -0,0 +1,61 @@
+package types
+
+import (
+	"crypto/tls"
+	"net"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/pokt-network/pocket-core/types"
+)
+
+// the pooled http clients used to execute relays, one per hosted chain so a slow backend can't starve the others
+var globalRelayClients = struct {
+	l sync.Mutex
+	m map[string]*http.Client
+}{m: make(map[string]*http.Client)}
+
+// "GetRelayClient" - Returns the shared http client used to execute relays against the hosted chain
+func GetRelayClient(chainID string) *http.Client {
+	globalRelayClients.l.Lock()
+	defer globalRelayClients.l.Unlock()
+	client, found := globalRelayClients.m[chainID]
+	if !found {
+		client = &http.Client{
+			Transport: NewRelayTransport(GlobalPocketConfig),
+			Timeout:   globalRPCTimeout * time.Millisecond,
+		}
+		globalRelayClients.m[chainID] = client
+	}
+	return client
+}
+
+// "ResetRelayClients" - Closes the idle connections of the relay clients, new clients are created on the next relay
+func ResetRelayClients() {
+	globalRelayClients.l.Lock()
+	defer globalRelayClients.l.Unlock()
+	for chainID, client := range globalRelayClients.m {
+		client.CloseIdleConnections()
+		delete(globalRelayClients.m, chainID)
+	}
+}
+
+// "NewRelayTransport" - Returns a pooled http transport built from the relay settings of the config
+func NewRelayTransport(c types.PocketConfig) *http.Transport {
+	return &http.Transport{
+		Proxy: http.ProxyFromEnvironment,
+		DialContext: (&net.Dialer{
+			Timeout:   30 * time.Second,
+			KeepAlive: time.Duration(c.RelayKeepAlive) * time.Millisecond,
+		}).DialContext,
+		MaxIdleConns:          c.RelayMaxIdleConns,
+		MaxIdleConnsPerHost:   c.RelayMaxIdleConnsPerHost,
+		MaxConnsPerHost:       c.RelayMaxConnsPerHost,
+		IdleConnTimeout:       time.Duration(c.RelayIdleConnTimeout) * time.Millisecond,
+		TLSHandshakeTimeout:   time.Duration(c.RelayTLSHandshakeTimeout) * time.Millisecond,
+		TLSClientConfig:       &tls.Config{InsecureSkipVerify: c.RelayTLSSkipVerify}, //nolint:gosec // opt-in for self signed backends
+		ForceAttemptHTTP2:     c.RelayHTTP2,
+		ExpectContinueTimeout: 1 * time.Second,
+	}
+}
@@ -0,0 +1,61 @@
+package types
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/gorilla/websocket"
+	sdk "github.com/pokt-network/pocket-core/types"
+)
+
+const (
+	// the payload method a client uses to pay for a streamed message with a relay proof
+	WebsocketAckMethod = "WS_ACK"
+)
+
+// "WebsocketRelayResponse" - A signed message sent to the client over a websocket relay connection
+type WebsocketRelayResponse struct {
+	Signature   string `json:"signature"`    // the servicer signature of the response
+	Response    string `json:"response"`     // the message received from the hosted blockchain
+	RequestHash string `json:"request_hash"` // the request hash of the proof the response is signed with
+	Streamed    bool   `json:"streamed"`     // true if pushed by the hosted blockchain (e.g. a subscription) and must be acknowledged
+}
+
+// "IsWebsocketAck" - Returns true if the relay acknowledges a streamed message rather than carrying a request
+func (r Relay) IsWebsocketAck() bool {
+	return r.Payload.Method == WebsocketAckMethod
+}
+
+// "DialWebsocket" - Opens a websocket connection to the non-native blockchain specified for a validated relay of the app
+func DialWebsocket(hostedBlockchains *HostedBlockchains, id, appPubKey string) (*websocket.Conn, sdk.Error) {
+	// retrieve the hosted blockchain requested
+	chain, err := hostedBlockchains.GetChain(id)
+	if err != nil {
+		// metric track
+		GlobalServiceMetric().AddRejectedRelayFor(id, err)
+		return nil, err
+	}
+	if chain.WebsocketURL == "" {
+		return nil, NewWebsocketNotSupportedError(ModuleName)
+	}
+	header := http.Header{}
+	if chain.BasicAuth.Username != "" {
+		req := http.Request{Header: header}
+		req.SetBasicAuth(chain.BasicAuth.Username, chain.BasicAuth.Password)
+	}
+	if GlobalPocketConfig.UserAgent != "" {
+		header.Set("User-Agent", GlobalPocketConfig.UserAgent)
+	}
+	dialer := websocket.Dialer{
+		Proxy:            http.ProxyFromEnvironment,
+		HandshakeTimeout: globalRPCTimeout * time.Millisecond,
+	}
+	conn, _, er := dialer.Dial(chain.WebsocketURL, header)
+	if er != nil {
+		err := NewWebsocketExecutionError(ModuleName, er)
+		GlobalServiceMetric().AddErrorFor(id, appPubKey, err)
+		return nil, err
+	}
+	conn.SetReadLimit(chain.ResponseLimit())
+	return conn, nil
+}
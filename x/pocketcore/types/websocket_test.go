@@ -0,0 +1,51 @@
+package types
+
+import (
+	"encoding/hex"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDialWebsocket(t *testing.T) {
+	ethereum := hex.EncodeToString([]byte{01})
+	upgrader := websocket.Upgrader{}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		conn, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		_, msg, err := conn.ReadMessage()
+		if err != nil {
+			return
+		}
+		_ = conn.WriteMessage(websocket.TextMessage, append([]byte("echo "), msg...))
+	}))
+	defer srv.Close()
+	hb := HostedBlockchains{
+		M: map[string]HostedBlockchain{ethereum: {
+			ID:           ethereum,
+			URL:          srv.URL,
+			WebsocketURL: "ws" + strings.TrimPrefix(srv.URL, "http"),
+		}},
+		L: sync.Mutex{},
+	}
+	conn, err := DialWebsocket(&hb, ethereum, "")
+	assert.Nil(t, err)
+	defer conn.Close()
+	assert.Nil(t, conn.WriteMessage(websocket.TextMessage, []byte("foo")))
+	_, msg, er := conn.ReadMessage()
+	assert.Nil(t, er)
+	assert.Equal(t, "echo foo", string(msg))
+	// no websocket url configured
+	hb.M[ethereum] = HostedBlockchain{ID: ethereum, URL: srv.URL}
+	_, err = DialWebsocket(&hb, ethereum, "")
+	assert.NotNil(t, err)
+	assert.Equal(t, CodeWebsocketNotSupportedError, int(err.Code()))
+}